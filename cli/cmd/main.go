@@ -1,11 +1,20 @@
 package main
 
 import (
+	"context"
 	"fmt"
+	"io"
 	"os"
+	"path/filepath"
+	"runtime"
 
 	"github.com/rezenkai/multi-saas-crm/tenant-orchestrator/cli/internal/commands"
+	"github.com/rezenkai/multi-saas-crm/tenant-orchestrator/cli/internal/completion"
 	"github.com/rezenkai/multi-saas-crm/tenant-orchestrator/cli/internal/config"
+	"github.com/rezenkai/multi-saas-crm/tenant-orchestrator/cli/internal/i18n"
+	"github.com/rezenkai/multi-saas-crm/tenant-orchestrator/cli/internal/log"
+	"github.com/rezenkai/multi-saas-crm/tenant-orchestrator/cli/internal/plugin"
+	"github.com/rezenkai/multi-saas-crm/tenant-orchestrator/cli/internal/tracing"
 	"github.com/spf13/cobra"
 )
 
@@ -17,6 +26,45 @@ var (
 
 func main() {
 	cfg := config.New()
+
+	ctx := context.Background()
+	shutdownTracing, err := tracing.Init(ctx)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error initializing tracing: %v\n", err)
+		os.Exit(1)
+	}
+	defer shutdownTracing(ctx)
+
+	rootCmd := NewDefaultTenantctlCommand(cfg)
+
+	// If the first non-flag arg doesn't resolve to a known subcommand, see
+	// if it's a "tenantctl-foo-bar" plugin executable on PATH before letting
+	// cobra fail with "unknown command". rootCmd.ParseFlags is best-effort so
+	// KUBECONFIG/TENANTCTL_NAMESPACE/TENANTCTL_CONTEXT reflect any global
+	// flags typed before the plugin name.
+	if len(os.Args) > 1 {
+		cmdPathPieces := os.Args[1:]
+		if _, _, err := rootCmd.Find(cmdPathPieces); err != nil {
+			_ = rootCmd.ParseFlags(cmdPathPieces)
+			pluginHandler := plugin.NewDefaultPluginHandler([]string{commands.PluginPrefix})
+			if err := plugin.HandlePluginCommand(pluginHandler, cmdPathPieces, 1, pluginEnviron(cfg)); err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				os.Exit(1)
+			}
+		}
+	}
+
+	if err := rootCmd.Execute(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// NewDefaultTenantctlCommand builds the tenantctl root command and its full
+// subcommand tree. It's separated from main so the plugin dispatch above can
+// use rootCmd.Find to decide whether a given invocation belongs to a plugin
+// before calling Execute.
+func NewDefaultTenantctlCommand(cfg *config.Config) *cobra.Command {
 	rootCmd := &cobra.Command{
 		Use:   "tenantctl",
 		Short: "CLI for managing tenants in Multi-SaaS CRM",
@@ -24,10 +72,12 @@ func main() {
 This tool provides commands for creating and managing tenants, triggering backups and restores,
 and monitoring tenant health in a Kubernetes-based environment.`,
 		PersistentPreRun: func(cmd *cobra.Command, args []string) {
-			if err := cfg.Load(); err != nil {
+			if err := cfg.Load(cmd.Flags().Changed("namespace")); err != nil {
 				fmt.Fprintf(os.Stderr, "Error loading config: %v\n", err)
 				os.Exit(1)
 			}
+			cfg.Logger = log.New(os.Stdout, cfg.LogFormat, cfg.Verbose)
+			i18n.Init(cfg.Language)
 		},
 	}
 
@@ -36,6 +86,13 @@ and monitoring tenant health in a Kubernetes-based environment.`,
 	rootCmd.PersistentFlags().StringVar(&cfg.Context, "context", "", "Kubernetes context to use")
 	rootCmd.PersistentFlags().StringVar(&cfg.Namespace, "namespace", "tenant-system", "Default namespace")
 	rootCmd.PersistentFlags().BoolVarP(&cfg.Verbose, "verbose", "v", false, "Enable verbose output")
+	rootCmd.PersistentFlags().StringVar(&cfg.LogFormat, "log-format", "text", "Log output format (text, json)")
+	rootCmd.PersistentFlags().StringVar(&cfg.Language, "language", "", "Language for CLI output (e.g. en, es); defaults to LC_MESSAGES/LANG")
+
+	// Dynamic completion for flags whose valid values live in the cluster,
+	// not in the binary.
+	rootCmd.RegisterFlagCompletionFunc("context", completion.Contexts(cfg))
+	rootCmd.RegisterFlagCompletionFunc("namespace", completion.Namespaces(cfg))
 
 	// Add commands
 	rootCmd.AddCommand(
@@ -43,14 +100,30 @@ and monitoring tenant health in a Kubernetes-based environment.`,
 		commands.NewBackupCmd(cfg),
 		commands.NewRestoreCmd(cfg),
 		commands.NewMonitorCmd(cfg),
+		commands.NewPluginCmd(cfg),
 		newVersionCmd(),
 		newCompletionCmd(rootCmd),
 	)
 
-	if err := rootCmd.Execute(); err != nil {
-		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-		os.Exit(1)
+	return rootCmd
+}
+
+// pluginEnviron returns os.Environ() plus KUBECONFIG/TENANTCTL_NAMESPACE/
+// TENANTCTL_CONTEXT derived from cfg, for handing off to a plugin
+// executable so it sees the same cluster targeting tenantctl itself would
+// have used.
+func pluginEnviron(cfg *config.Config) []string {
+	env := os.Environ()
+	if cfg.KubeConfig != "" {
+		env = append(env, "KUBECONFIG="+cfg.KubeConfig)
+	}
+	if cfg.Namespace != "" {
+		env = append(env, "TENANTCTL_NAMESPACE="+cfg.Namespace)
+	}
+	if cfg.Context != "" {
+		env = append(env, "TENANTCTL_CONTEXT="+cfg.Context)
 	}
+	return env
 }
 
 func newVersionCmd() *cobra.Command {
@@ -122,6 +195,135 @@ PowerShell:
 				return rootCmd.GenPowerShellCompletionWithDesc(os.Stdout)
 			},
 		},
+		newCompletionInstallCmd(rootCmd),
 	)
 	return completionCmd
+}
+
+// completionGenerators maps a shell name to the cobra generator that writes
+// its completion script to w, for use by `completion install` where the
+// destination is a file rather than stdout.
+var completionGenerators = map[string]func(cmd *cobra.Command, w io.Writer) error{
+	"bash":       func(cmd *cobra.Command, w io.Writer) error { return cmd.GenBashCompletionV2(w, true) },
+	"zsh":        func(cmd *cobra.Command, w io.Writer) error { return cmd.GenZshCompletion(w) },
+	"fish":       func(cmd *cobra.Command, w io.Writer) error { return cmd.GenFishCompletion(w, true) },
+	"powershell": func(cmd *cobra.Command, w io.Writer) error { return cmd.GenPowerShellCompletionWithDesc(w) },
+}
+
+func newCompletionInstallCmd(rootCmd *cobra.Command) *cobra.Command {
+	var (
+		path   string
+		user   bool
+		system bool
+	)
+	cmd := &cobra.Command{
+		Use:       "install [bash|zsh|fish|powershell]",
+		Short:     "Install a shell completion script to its canonical location",
+		Long:      "Generate a shell completion script and write it straight to the location that shell loads completions from, instead of printing it to stdout for the user to redirect themselves.",
+		Args:      cobra.ExactValidArgs(1),
+		ValidArgs: []string{"bash", "zsh", "fish", "powershell"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			shell := args[0]
+			generate, ok := completionGenerators[shell]
+			if !ok {
+				return fmt.Errorf("unsupported shell: %s", shell)
+			}
+			if user && system {
+				return fmt.Errorf("--user and --system are mutually exclusive")
+			}
+
+			dest := path
+			if dest == "" {
+				resolved, err := completionInstallPath(shell, !system)
+				if err != nil {
+					return err
+				}
+				dest = resolved
+			}
+
+			if err := os.MkdirAll(filepath.Dir(dest), 0o755); err != nil {
+				return fmt.Errorf("failed to create completion directory: %w", err)
+			}
+			f, err := os.Create(dest)
+			if err != nil {
+				return fmt.Errorf("failed to create completion file: %w", err)
+			}
+			defer f.Close()
+			if err := generate(rootCmd, f); err != nil {
+				return fmt.Errorf("failed to generate %s completion: %w", shell, err)
+			}
+
+			fmt.Printf("Installed %s completion to %s\n", shell, dest)
+			fmt.Println(completionReloadHint(shell, dest))
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&path, "path", "", "Install to this path instead of the canonical per-OS location")
+	cmd.Flags().BoolVar(&user, "user", false, "Install to the current user's completion directory (default)")
+	cmd.Flags().BoolVar(&system, "system", false, "Install to the system-wide completion directory (may require root)")
+	return cmd
+}
+
+// completionInstallPath returns the canonical destination for shell's
+// completion script, mirroring the locations documented in the "completion"
+// command's Long help, with userScope choosing a per-user directory over the
+// system-wide one.
+func completionInstallPath(shell string, userScope bool) (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve home directory: %w", err)
+	}
+	switch shell {
+	case "bash":
+		if userScope {
+			return filepath.Join(home, ".local/share/bash-completion/completions/tenantctl"), nil
+		}
+		if runtime.GOOS == "darwin" {
+			return "/usr/local/etc/bash_completion.d/tenantctl", nil
+		}
+		return "/etc/bash_completion.d/tenantctl", nil
+	case "zsh":
+		if userScope {
+			return filepath.Join(home, ".zsh/completions/_tenantctl"), nil
+		}
+		if runtime.GOOS == "darwin" {
+			return "/usr/local/share/zsh/site-functions/_tenantctl", nil
+		}
+		return "/usr/share/zsh/site-functions/_tenantctl", nil
+	case "fish":
+		if userScope {
+			return filepath.Join(home, ".config/fish/completions/tenantctl.fish"), nil
+		}
+		if runtime.GOOS == "darwin" {
+			return "/usr/local/etc/fish/completions/tenantctl.fish", nil
+		}
+		return "/etc/fish/completions/tenantctl.fish", nil
+	case "powershell":
+		// PowerShell has no real system-wide profile directory across
+		// platforms, so --system still installs next to the user's
+		// $PROFILE; --path is the escape hatch for anything else.
+		if runtime.GOOS == "windows" {
+			return filepath.Join(home, "Documents", "WindowsPowerShell", "tenantctl.ps1"), nil
+		}
+		return filepath.Join(home, ".config/powershell/tenantctl.ps1"), nil
+	default:
+		return "", fmt.Errorf("unknown shell %q", shell)
+	}
+}
+
+// completionReloadHint tells the user how to make dest take effect in their
+// current shell without starting a new session.
+func completionReloadHint(shell, dest string) string {
+	switch shell {
+	case "bash":
+		return fmt.Sprintf("Reload with: source %s (or open a new shell)", dest)
+	case "zsh":
+		return fmt.Sprintf("Make sure %s is on your $fpath, then run: autoload -U compinit && compinit", filepath.Dir(dest))
+	case "fish":
+		return "New fish sessions pick up completions automatically; run `fish_update_completions` or open a new shell to use it now"
+	case "powershell":
+		return fmt.Sprintf("Add \". %s\" to your $PROFILE to load it automatically, or dot-source it now", dest)
+	default:
+		return "Restart your shell to pick up the new completions"
+	}
 }
\ No newline at end of file
@@ -0,0 +1,264 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	tenantv1alpha1 "github.com/rezenkai/multi-saas-crm/tenant-orchestrator/api/v1alpha1"
+	"github.com/rezenkai/multi-saas-crm/tenant-orchestrator/cli/internal/config"
+	"github.com/rezenkai/multi-saas-crm/tenant-orchestrator/cli/internal/kube"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/jsonmergepatch"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// lastAppliedAnnotation stores the raw configuration passed to the most
+// recent `tenant apply`, mirroring kubectl's
+// kubectl.kubernetes.io/last-applied-configuration so a three-way merge can
+// be computed on the next apply.
+const lastAppliedAnnotation = "tenant.rezenkai.com/last-applied-configuration"
+
+// newTenantApplyCmd implements a kubectl-style declarative apply for
+// Tenants: create on first apply, three-way strategic merge patch (falling
+// back to a JSON merge patch, since CRDs don't support strategic merge
+// server-side) on subsequent applies.
+func newTenantApplyCmd(cfg *config.Config) *cobra.Command {
+	var (
+		filename   string
+		recursive  bool
+		prune      bool
+		serverSide bool
+	)
+	cmd := &cobra.Command{
+		Use:   "apply -f FILE",
+		Short: "Apply a tenant configuration from a file",
+		Long:  "Create or update a tenant declaratively, computing a three-way merge against the last applied configuration",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if filename == "" {
+				return fmt.Errorf("-f/--filename is required")
+			}
+			ctx := context.Background()
+			c, err := kube.NewClient(cfg)
+			if err != nil {
+				return fmt.Errorf("failed to create kubernetes client: %w", err)
+			}
+			tenants, err := loadTenantManifests(filename, recursive)
+			if err != nil {
+				return err
+			}
+			sort.Slice(tenants, func(i, j int) bool { return tenants[i].Name < tenants[j].Name })
+			for _, desired := range tenants {
+				if desired.Namespace == "" {
+					desired.Namespace = cfg.Namespace
+				}
+				if err := applyTenant(ctx, c, desired, prune, serverSide); err != nil {
+					return fmt.Errorf("failed to apply tenant '%s': %w", desired.Name, err)
+				}
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringVarP(&filename, "filename", "f", "", "File, directory (with --recursive), or '-' for stdin")
+	cmd.Flags().BoolVar(&recursive, "recursive", false, "Treat --filename as a directory of manifests, applied in metadata.name order")
+	cmd.Flags().BoolVar(&prune, "prune", false, "Remove Services present in the last-applied configuration but absent from the new file")
+	cmd.Flags().BoolVar(&serverSide, "server-side", false, "Use server-side apply with field manager 'tenant-cli'")
+	return cmd
+}
+
+// loadTenantManifests resolves --filename into one or more Tenant objects:
+// a single file, every *.yaml/*.yml/*.json file in a directory when
+// recursive is set, or stdin when filename is "-".
+func loadTenantManifests(filename string, recursive bool) ([]*tenantv1alpha1.Tenant, error) {
+	if filename == "-" {
+		data, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read stdin: %w", err)
+		}
+		tenant, err := parseTenantManifest(data, "stdin")
+		if err != nil {
+			return nil, err
+		}
+		return []*tenantv1alpha1.Tenant{tenant}, nil
+	}
+
+	if !recursive {
+		data, err := os.ReadFile(filename)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read file: %w", err)
+		}
+		tenant, err := parseTenantManifest(data, filename)
+		if err != nil {
+			return nil, err
+		}
+		return []*tenantv1alpha1.Tenant{tenant}, nil
+	}
+
+	entries, err := os.ReadDir(filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read directory: %w", err)
+	}
+	var paths []string
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		name := entry.Name()
+		if strings.HasSuffix(name, ".yaml") || strings.HasSuffix(name, ".yml") || strings.HasSuffix(name, ".json") {
+			paths = append(paths, filepath.Join(filename, name))
+		}
+	}
+	sort.Strings(paths)
+	var tenants []*tenantv1alpha1.Tenant
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read file: %w", err)
+		}
+		tenant, err := parseTenantManifest(data, path)
+		if err != nil {
+			return nil, err
+		}
+		tenants = append(tenants, tenant)
+	}
+	return tenants, nil
+}
+
+func parseTenantManifest(data []byte, source string) (*tenantv1alpha1.Tenant, error) {
+	tenant := &tenantv1alpha1.Tenant{}
+	if strings.HasSuffix(source, ".json") {
+		if err := json.Unmarshal(data, tenant); err != nil {
+			return nil, fmt.Errorf("failed to parse JSON in %s: %w", source, err)
+		}
+		return tenant, nil
+	}
+	if err := yaml.Unmarshal(data, tenant); err != nil {
+		return nil, fmt.Errorf("failed to parse YAML in %s: %w", source, err)
+	}
+	return tenant, nil
+}
+
+// applyTenant creates desired if it doesn't exist yet, otherwise patches the
+// live object toward it. In non-server-side mode, the patch is a three-way
+// merge between the last-applied annotation, the live object, and desired.
+func applyTenant(ctx context.Context, c client.Client, desired *tenantv1alpha1.Tenant, prune, serverSide bool) error {
+	nn := types.NamespacedName{Name: desired.Name, Namespace: desired.Namespace}
+	live := &tenantv1alpha1.Tenant{}
+	err := c.Get(ctx, nn, live)
+	notFound := errors.IsNotFound(err)
+	if err != nil && !notFound {
+		return fmt.Errorf("failed to get tenant: %w", err)
+	}
+
+	if serverSide {
+		applyObj := desired.DeepCopy()
+		applyObj.ResourceVersion = ""
+		if err := c.Patch(ctx, applyObj, client.Apply, client.FieldOwner("tenant-cli"), client.ForceOwnership); err != nil {
+			return fmt.Errorf("server-side apply failed: %w", err)
+		}
+		fmt.Printf("Tenant '%s' applied (server-side)\n", desired.Name)
+		return nil
+	}
+
+	if !notFound && !prune {
+		// Whichever patch type ends up used, Services has no merge key for
+		// the server to diff element-by-element: a strategic merge patch
+		// falls back to a JSON Merge Patch for most CRDs, and RFC 7396
+		// always replaces an array wholesale. Without --prune, union back
+		// any live service the new file simply didn't mention, so dropping
+		// a service from the file doesn't silently delete it -- --prune is
+		// what opts into that.
+		desired.Spec.Services = unionServices(desired.Spec.Services, live.Spec.Services)
+	}
+
+	modified := desired.DeepCopy()
+	rawModified, err := json.Marshal(desired)
+	if err != nil {
+		return fmt.Errorf("failed to marshal desired configuration: %w", err)
+	}
+	if modified.Annotations == nil {
+		modified.Annotations = map[string]string{}
+	}
+	modified.Annotations[lastAppliedAnnotation] = string(rawModified)
+	modifiedJSON, err := json.Marshal(modified)
+	if err != nil {
+		return fmt.Errorf("failed to marshal desired configuration: %w", err)
+	}
+
+	if notFound {
+		if err := c.Create(ctx, modified); err != nil {
+			return fmt.Errorf("failed to create tenant: %w", err)
+		}
+		fmt.Printf("Tenant '%s' created\n", desired.Name)
+		return nil
+	}
+
+	original := []byte(live.Annotations[lastAppliedAnnotation])
+	currentJSON, err := json.Marshal(live)
+	if err != nil {
+		return fmt.Errorf("failed to marshal live configuration: %w", err)
+	}
+
+	target := live.DeepCopy()
+	if err := patchTenant(ctx, c, target, original, modifiedJSON, currentJSON); err != nil {
+		return err
+	}
+	fmt.Printf("Tenant '%s' applied\n", desired.Name)
+	return nil
+}
+
+// patchTenant computes a three-way merge patch and sends it, preferring a
+// strategic merge patch and falling back to an RFC 7396 JSON merge patch if
+// the server rejects it (CRDs generally only support merge and JSON patch).
+func patchTenant(ctx context.Context, c client.Client, target *tenantv1alpha1.Tenant, original, modified, current []byte) error {
+	strategicPatch, strategicErr := computeStrategicMergePatch(original, modified, current)
+	if strategicErr == nil {
+		if err := c.Patch(ctx, target, client.RawPatch(types.StrategicMergePatchType, strategicPatch)); err == nil {
+			return nil
+		}
+	}
+
+	mergePatch, err := jsonmergepatch.CreateThreeWayJSONMergePatch(original, modified, current)
+	if err != nil {
+		return fmt.Errorf("failed to compute merge patch: %w", err)
+	}
+	if err := c.Patch(ctx, target, client.RawPatch(types.MergePatchType, mergePatch)); err != nil {
+		return fmt.Errorf("failed to patch tenant: %w", err)
+	}
+	return nil
+}
+
+func computeStrategicMergePatch(original, modified, current []byte) ([]byte, error) {
+	patchMeta, err := strategicpatch.NewPatchMetaFromStruct(&tenantv1alpha1.Tenant{})
+	if err != nil {
+		return nil, err
+	}
+	return strategicpatch.CreateThreeWayMergePatch(original, modified, current, patchMeta, true)
+}
+
+// unionServices returns desired with any of live's services appended whose
+// Name isn't already present in desired, so applying a file that simply
+// omits a service (rather than explicitly requesting its removal via
+// --prune) doesn't delete it.
+func unionServices(desired, live []tenantv1alpha1.ServiceSpec) []tenantv1alpha1.ServiceSpec {
+	present := make(map[string]bool, len(desired))
+	for _, svc := range desired {
+		present[svc.Name] = true
+	}
+	result := desired
+	for _, svc := range live {
+		if !present[svc.Name] {
+			result = append(result, svc)
+		}
+	}
+	return result
+}
@@ -10,11 +10,15 @@ import (
 	"time"
 
 	tenantv1alpha1 "github.com/rezenkai/multi-saas-crm/tenant-orchestrator/api/v1alpha1"
+	"github.com/rezenkai/multi-saas-crm/tenant-orchestrator/cli/internal/completion"
 	"github.com/rezenkai/multi-saas-crm/tenant-orchestrator/cli/internal/config"
 	"github.com/rezenkai/multi-saas-crm/tenant-orchestrator/cli/internal/kube"
 	"github.com/rezenkai/multi-saas-crm/tenant-orchestrator/cli/internal/utils"
 	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	kubeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // NewBackupCmd creates the backup management command
@@ -37,9 +41,13 @@ func NewBackupCmd(cfg *config.Config) *cobra.Command {
 
 func newBackupCreateCmd(cfg *config.Config) *cobra.Command {
 	var (
-		tenant string
-		name   string
-		wait   bool
+		tenant  string
+		name    string
+		backend string
+		bucket  string
+		prefix  string
+		mode    string
+		wait    bool
 	)
 	cmd := &cobra.Command{
 		Use:   "create",
@@ -50,27 +58,51 @@ func newBackupCreateCmd(cfg *config.Config) *cobra.Command {
 			if err != nil {
 				return fmt.Errorf("failed to create kubernetes client: %w", err)
 			}
-			// Get tenant
+			// Make sure the tenant exists before creating a backup for it
 			t := &tenantv1alpha1.Tenant{}
 			if err := client.Get(ctx, types.NamespacedName{Name: tenant, Namespace: cfg.Namespace}, t); err != nil {
 				return fmt.Errorf("failed to get tenant: %w", err)
 			}
-			// Trigger backup by creating a backup annotation or using a backup CR if implemented
-			// For simplicity, we'll assume triggering via annotation
-			if t.Annotations == nil {
-				t.Annotations = make(map[string]string)
+			// Fall back to the tenant's configured backup settings (set via
+			// `backup enable`) for any flag left at its zero value.
+			configured := t.Spec.Database.Backup
+			if backend == "" {
+				backend = configured.StorageBackend
+			}
+			if bucket == "" {
+				bucket = configured.Bucket
+			}
+			if prefix == "" {
+				prefix = configured.Prefix
+			}
+			if mode == "" {
+				mode = configured.Mode
 			}
 			if name == "" {
 				name = fmt.Sprintf("%s-manual-%s", tenant, time.Now().Format("20060102-150405"))
 			}
-			t.Annotations["tenant.yourdomain.com/backup-request"] = name
-			if err := client.Update(ctx, t); err != nil {
-				return fmt.Errorf("failed to request backup: %w", err)
+			backup := &tenantv1alpha1.TenantBackup{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: cfg.Namespace,
+				},
+				Spec: tenantv1alpha1.TenantBackupSpec{
+					TenantRef:        tenant,
+					StorageBackend:   backend,
+					Bucket:           bucket,
+					Prefix:           prefix,
+					EncryptionKeyRef: configured.EncryptionKeyRef,
+					WALArchiving:     configured.WALArchiving,
+					Mode:             mode,
+				},
+			}
+			if err := client.Create(ctx, backup); err != nil {
+				return fmt.Errorf("failed to create backup: %w", err)
 			}
 			fmt.Printf("Backup '%s' requested for tenant '%s'\n", name, tenant)
 			if wait {
 				fmt.Println("Waiting for backup to complete...")
-				if err := waitForBackup(ctx, client, tenant, cfg.Namespace, name); err != nil {
+				if err := waitForBackup(ctx, client, cfg.Namespace, name); err != nil {
 					return fmt.Errorf("error waiting for backup: %w", err)
 				}
 				fmt.Println("Backup completed successfully")
@@ -80,8 +112,13 @@ func newBackupCreateCmd(cfg *config.Config) *cobra.Command {
 	}
 	cmd.Flags().StringVarP(&tenant, "tenant", "t", "", "Tenant name (required)")
 	cmd.Flags().StringVar(&name, "name", "", "Backup name (optional)")
+	cmd.Flags().StringVar(&backend, "storage-backend", "", "Object-storage backend: s3, gcs, azure, minio (defaults to the tenant's configured backend)")
+	cmd.Flags().StringVar(&bucket, "bucket", "", "Bucket/container the artifact is uploaded to (defaults to the tenant's configured bucket)")
+	cmd.Flags().StringVar(&prefix, "prefix", "", "Key prefix for the uploaded artifact (defaults to the tenant's configured prefix)")
+	cmd.Flags().StringVar(&mode, "mode", "", "Backup mode: logical or physical (defaults to the tenant's configured mode)")
 	cmd.Flags().BoolVar(&wait, "wait", false, "Wait for backup to complete")
 	cmd.MarkFlagRequired("tenant")
+	cmd.RegisterFlagCompletionFunc("tenant", completion.TenantNames(cfg))
 	return cmd
 }
 
@@ -99,35 +136,27 @@ func newBackupListCmd(cfg *config.Config) *cobra.Command {
 			if err != nil {
 				return fmt.Errorf("failed to create kubernetes client: %w", err)
 			}
-			// Get tenant
-			t := &tenantv1alpha1.Tenant{}
-			if err := client.Get(ctx, types.NamespacedName{Name: tenant, Namespace: cfg.Namespace}, t); err != nil {
-				return fmt.Errorf("failed to get tenant: %w", err)
+			backups := &tenantv1alpha1.TenantBackupList{}
+			if err := client.List(ctx, backups, kubeclient.InNamespace(cfg.Namespace)); err != nil {
+				return fmt.Errorf("failed to list backups: %w", err)
 			}
-			// For simplicity, assume backups are stored in status or as secrets/configmaps
-			// Here we'll simulate listing from status.DatabaseStatus.LastBackupTime and assume multiple
-			// In real impl, list from backup storage or CRs
-			backups := []struct {
-				Name      string
-				Timestamp time.Time
-				Status    string
-			}{
-				// Placeholder data
-				{Name: "auto-20250801-1200", Timestamp: time.Now().Add(-24 * time.Hour), Status: "Completed"},
-				{Name: "manual-20250802-0900", Timestamp: time.Now().Add(-1 * time.Hour), Status: "Completed"},
+			items := make([]tenantv1alpha1.TenantBackup, 0, len(backups.Items))
+			for _, b := range backups.Items {
+				if b.Spec.TenantRef == tenant {
+					items = append(items, b)
+				}
 			}
 			if output == "json" || output == "yaml" {
-				return outputObject(backups, output)
+				return outputObject(items, output)
 			}
-			// Table output
 			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
-			fmt.Fprintln(w, "NAME\tAGE\tSTATUS")
-			sort.Slice(backups, func(i, j int) bool {
-				return backups[i].Timestamp.After(backups[j].Timestamp)
+			fmt.Fprintln(w, "NAME\tAGE\tPHASE\tLOCATION")
+			sort.Slice(items, func(i, j int) bool {
+				return items[i].CreationTimestamp.After(items[j].CreationTimestamp.Time)
 			})
-			for _, b := range backups {
-				age := utils.FormatAge(b.Timestamp)
-				fmt.Fprintf(w, "%s\t%s\t%s\n", b.Name, age, b.Status)
+			for _, b := range items {
+				age := utils.FormatAge(b.CreationTimestamp.Time)
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", b.Name, age, b.Status.Phase, b.Status.BackendLocation)
 			}
 			return w.Flush()
 		},
@@ -135,15 +164,17 @@ func newBackupListCmd(cfg *config.Config) *cobra.Command {
 	cmd.Flags().StringVarP(&tenant, "tenant", "t", "", "Tenant name (required)")
 	cmd.Flags().StringVarP(&output, "output", "o", "", "Output format (json, yaml)")
 	cmd.MarkFlagRequired("tenant")
+	cmd.RegisterFlagCompletionFunc("tenant", completion.TenantNames(cfg))
 	return cmd
 }
 
 func newBackupRestoreCmd(cfg *config.Config) *cobra.Command {
 	var (
-		tenant string
-		name   string
-		force  bool
-		wait   bool
+		tenant      string
+		name        string
+		pointInTime string
+		force       bool
+		wait        bool
 	)
 	cmd := &cobra.Command{
 		Use:   "restore",
@@ -163,23 +194,40 @@ func newBackupRestoreCmd(cfg *config.Config) *cobra.Command {
 			if err != nil {
 				return fmt.Errorf("failed to create kubernetes client: %w", err)
 			}
-			// Get tenant
-			t := &tenantv1alpha1.Tenant{}
-			if err := client.Get(ctx, types.NamespacedName{Name: tenant, Namespace: cfg.Namespace}, t); err != nil {
-				return fmt.Errorf("failed to get tenant: %w", err)
+			// Make sure the source backup exists before requesting a restore from it
+			backup := &tenantv1alpha1.TenantBackup{}
+			if err := client.Get(ctx, types.NamespacedName{Name: name, Namespace: cfg.Namespace}, backup); err != nil {
+				return fmt.Errorf("failed to get backup: %w", err)
 			}
-			// Trigger restore via annotation
-			if t.Annotations == nil {
-				t.Annotations = make(map[string]string)
+			restoreSpec := tenantv1alpha1.TenantRestoreSpec{
+				SourceBackupRef: name,
+				TenantRef:       tenant,
 			}
-			t.Annotations["tenant.yourdomain.com/restore-request"] = name
-			if err := client.Update(ctx, t); err != nil {
+			if pointInTime != "" {
+				if !backup.Spec.WALArchiving {
+					return fmt.Errorf("backup %q was not taken with WAL archiving enabled, cannot do a point-in-time restore", name)
+				}
+				t, err := time.Parse(time.RFC3339, pointInTime)
+				if err != nil {
+					return fmt.Errorf("invalid --point-in-time value: %w", err)
+				}
+				restoreSpec.PointInTime = &metav1.Time{Time: t}
+			}
+			restoreName := fmt.Sprintf("%s-restore-%s", tenant, time.Now().Format("20060102-150405"))
+			restore := &tenantv1alpha1.TenantRestore{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      restoreName,
+					Namespace: cfg.Namespace,
+				},
+				Spec: restoreSpec,
+			}
+			if err := client.Create(ctx, restore); err != nil {
 				return fmt.Errorf("failed to request restore: %w", err)
 			}
 			fmt.Printf("Restore from '%s' requested for tenant '%s'\n", name, tenant)
 			if wait {
 				fmt.Println("Waiting for restore to complete...")
-				if err := waitForRestore(ctx, client, tenant, cfg.Namespace, name); err != nil {
+				if err := waitForRestore(ctx, client, cfg.Namespace, restoreName); err != nil {
 					return fmt.Errorf("error waiting for restore: %w", err)
 				}
 				fmt.Println("Restore completed successfully")
@@ -189,9 +237,11 @@ func newBackupRestoreCmd(cfg *config.Config) *cobra.Command {
 	}
 	cmd.Flags().StringVarP(&tenant, "tenant", "t", "", "Tenant name (required)")
 	cmd.Flags().StringVar(&name, "name", "", "Backup name (required)")
+	cmd.Flags().StringVar(&pointInTime, "point-in-time", "", "RFC3339 timestamp to replay WAL up to (requires a backup taken with --wal-archiving)")
 	cmd.Flags().BoolVar(&force, "force", false, "Skip confirmation")
 	cmd.Flags().BoolVar(&wait, "wait", false, "Wait for restore to complete")
 	cmd.MarkFlagRequired("tenant")
+	cmd.RegisterFlagCompletionFunc("tenant", completion.TenantNames(cfg))
 	cmd.MarkFlagRequired("name")
 	return cmd
 }
@@ -220,8 +270,15 @@ func newBackupDeleteCmd(cfg *config.Config) *cobra.Command {
 			if err != nil {
 				return fmt.Errorf("failed to create kubernetes client: %w", err)
 			}
-			// Implement backup deletion logic, e.g., delete from storage or mark for deletion
-			// For placeholder, just log
+			backup := &tenantv1alpha1.TenantBackup{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: cfg.Namespace,
+				},
+			}
+			if err := client.Delete(ctx, backup); err != nil {
+				return fmt.Errorf("failed to delete backup: %w", err)
+			}
 			fmt.Printf("Backup '%s' deleted for tenant '%s'\n", name, tenant)
 			return nil
 		},
@@ -230,15 +287,26 @@ func newBackupDeleteCmd(cfg *config.Config) *cobra.Command {
 	cmd.Flags().StringVar(&name, "name", "", "Backup name (required)")
 	cmd.Flags().BoolVar(&force, "force", false, "Skip confirmation")
 	cmd.MarkFlagRequired("tenant")
+	cmd.RegisterFlagCompletionFunc("tenant", completion.TenantNames(cfg))
 	cmd.MarkFlagRequired("name")
 	return cmd
 }
 
 func newBackupEnableCmd(cfg *config.Config) *cobra.Command {
 	var (
-		tenant    string
-		schedule  string
-		retention int32
+		tenant             string
+		schedule           string
+		retention          int32
+		storageBackend     string
+		bucket             string
+		prefix             string
+		encryptionKey      string
+		walArchiving       bool
+		mode               string
+		walArchiveInterval time.Duration
+		walRetentionDays   int32
+		walBucket          string
+		walPrefix          string
 	)
 	cmd := &cobra.Command{
 		Use:   "enable",
@@ -256,9 +324,34 @@ func newBackupEnableCmd(cfg *config.Config) *cobra.Command {
 			}
 			// Update backup spec
 			t.Spec.Database.Backup = tenantv1alpha1.BackupSpec{
-				Enabled:       true,
-				Schedule:      schedule,
-				RetentionDays: retention,
+				Enabled:        true,
+				Schedule:       schedule,
+				RetentionDays:  retention,
+				StorageBackend: storageBackend,
+				Bucket:         bucket,
+				Prefix:         prefix,
+				WALArchiving:   walArchiving,
+				Mode:           mode,
+			}
+			if encryptionKey != "" {
+				t.Spec.Database.Backup.EncryptionKeyRef = &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: encryptionKey},
+					Key:                  "key",
+				}
+			}
+			if walArchiving {
+				if walBucket == "" {
+					walBucket = bucket
+				}
+				if walPrefix == "" {
+					walPrefix = prefix
+				}
+				t.Spec.Database.Backup.WAL = &tenantv1alpha1.WALArchivingSpec{
+					ArchiveInterval: metav1.Duration{Duration: walArchiveInterval},
+					RetentionDays:   walRetentionDays,
+					Bucket:          walBucket,
+					Prefix:          walPrefix,
+				}
 			}
 			if err := client.Update(ctx, t); err != nil {
 				return fmt.Errorf("failed to update tenant: %w", err)
@@ -270,7 +363,18 @@ func newBackupEnableCmd(cfg *config.Config) *cobra.Command {
 	cmd.Flags().StringVarP(&tenant, "tenant", "t", "", "Tenant name (required)")
 	cmd.Flags().StringVar(&schedule, "schedule", "0 0 * * *", "Backup schedule (cron format)")
 	cmd.Flags().Int32Var(&retention, "retention", 7, "Retention days")
+	cmd.Flags().StringVar(&storageBackend, "storage-backend", "s3", "Object-storage backend: s3, gcs, azure, minio")
+	cmd.Flags().StringVar(&bucket, "bucket", "multi-saas-crm-backups", "Bucket/container backups are uploaded to")
+	cmd.Flags().StringVar(&prefix, "prefix", "", "Key prefix for uploaded artifacts")
+	cmd.Flags().StringVar(&encryptionKey, "encryption-key-secret", "", "Name of the Secret (key \"key\") holding the server-side encryption key")
+	cmd.Flags().BoolVar(&walArchiving, "wal-archiving", false, "Enable continuous WAL shipping for point-in-time restore")
+	cmd.Flags().StringVar(&mode, "mode", "logical", "Backup mode: logical (pg_dump/mysqldump) or physical (pg_basebackup, required for true point-in-time restore)")
+	cmd.Flags().DurationVar(&walArchiveInterval, "wal-archive-interval", time.Minute, "How often the WAL archiver sidecar ships the current WAL segment (--mode=physical only)")
+	cmd.Flags().Int32Var(&walRetentionDays, "wal-retention-days", 7, "How long archived WAL segments and base backups are kept (--mode=physical only)")
+	cmd.Flags().StringVar(&walBucket, "wal-bucket", "", "Bucket WAL segments are shipped to (defaults to --bucket)")
+	cmd.Flags().StringVar(&walPrefix, "wal-prefix", "", "Key prefix for shipped WAL segments (defaults to --prefix)")
 	cmd.MarkFlagRequired("tenant")
+	cmd.RegisterFlagCompletionFunc("tenant", completion.TenantNames(cfg))
 	return cmd
 }
 
@@ -301,11 +405,11 @@ func newBackupDisableCmd(cfg *config.Config) *cobra.Command {
 	}
 	cmd.Flags().StringVarP(&tenant, "tenant", "t", "", "Tenant name (required)")
 	cmd.MarkFlagRequired("tenant")
+	cmd.RegisterFlagCompletionFunc("tenant", completion.TenantNames(cfg))
 	return cmd
 }
 
-// Helper functions (placeholders)
-func waitForBackup(ctx context.Context, client kube.Client, tenant, namespace, backupName string) error {
+func waitForBackup(ctx context.Context, client kube.Client, namespace, backupName string) error {
 	ticker := time.NewTicker(5 * time.Second)
 	defer ticker.Stop()
 	timeout := time.After(10 * time.Minute)
@@ -316,20 +420,21 @@ func waitForBackup(ctx context.Context, client kube.Client, tenant, namespace, b
 		case <-timeout:
 			return fmt.Errorf("timeout waiting for backup")
 		case <-ticker.C:
-			t := &tenantv1alpha1.Tenant{}
-			if err := client.Get(ctx, types.NamespacedName{Name: tenant, Namespace: namespace}, t); err != nil {
+			b := &tenantv1alpha1.TenantBackup{}
+			if err := client.Get(ctx, types.NamespacedName{Name: backupName, Namespace: namespace}, b); err != nil {
 				return err
 			}
-			// Check if backup is completed, e.g., check status or annotation
-			if _, ok := t.Annotations["tenant.yourdomain.com/backup-status-"+backupName]; ok {
-				return nil // Assume completed if annotation present
+			switch b.Status.Phase {
+			case tenantv1alpha1.TenantBackupPhaseCompleted:
+				return nil
+			case tenantv1alpha1.TenantBackupPhaseFailed:
+				return fmt.Errorf("backup failed: %s", b.Status.Message)
 			}
 		}
 	}
 }
 
-func waitForRestore(ctx context.Context, client kube.Client, tenant, namespace, backupName string) error {
-	// Similar to waitForBackup
+func waitForRestore(ctx context.Context, client kube.Client, namespace, restoreName string) error {
 	ticker := time.NewTicker(5 * time.Second)
 	defer ticker.Stop()
 	timeout := time.After(15 * time.Minute)
@@ -340,14 +445,16 @@ func waitForRestore(ctx context.Context, client kube.Client, tenant, namespace,
 		case <-timeout:
 			return fmt.Errorf("timeout waiting for restore")
 		case <-ticker.C:
-			t := &tenantv1alpha1.Tenant{}
-			if err := client.Get(ctx, types.NamespacedName{Name: tenant, Namespace: namespace}, t); err != nil {
+			r := &tenantv1alpha1.TenantRestore{}
+			if err := client.Get(ctx, types.NamespacedName{Name: restoreName, Namespace: namespace}, r); err != nil {
 				return err
 			}
-			// Check restore status
-			if t.Status.Phase == "Active" {
+			switch r.Status.Phase {
+			case tenantv1alpha1.TenantBackupPhaseCompleted:
 				return nil
+			case tenantv1alpha1.TenantBackupPhaseFailed:
+				return fmt.Errorf("restore failed: %s", r.Status.Message)
 			}
 		}
 	}
-}
\ No newline at end of file
+}
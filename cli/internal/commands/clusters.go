@@ -0,0 +1,179 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	tenantv1alpha1 "github.com/rezenkai/multi-saas-crm/tenant-orchestrator/api/v1alpha1"
+	"github.com/rezenkai/multi-saas-crm/tenant-orchestrator/cli/internal/config"
+	"github.com/rezenkai/multi-saas-crm/tenant-orchestrator/cli/internal/kube"
+	"github.com/rezenkai/multi-saas-crm/tenant-orchestrator/cli/internal/utils"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// clusterSystemNamespace holds ClusterRegistrations' kubeconfig Secrets,
+// mirroring the "tenant-system" namespace discovery.Client reads from.
+const clusterSystemNamespace = "tenant-system"
+
+// newTenantClustersCmd creates the `tenant clusters` command group for
+// registering and inspecting member clusters used by TenantSpec.Placement.
+func newTenantClustersCmd(cfg *config.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clusters",
+		Short: "Manage member clusters for multi-cluster tenant placement",
+		Long:  "Commands for registering, listing, and removing the member clusters Tenants can be placed onto",
+	}
+	cmd.AddCommand(
+		newClustersListCmd(cfg),
+		newClustersRegisterCmd(cfg),
+		newClustersDeregisterCmd(cfg),
+	)
+	return cmd
+}
+
+func newClustersListCmd(cfg *config.Config) *cobra.Command {
+	var output string
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "List registered member clusters",
+		Aliases: []string{"ls"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+			client, err := kube.NewClient(cfg)
+			if err != nil {
+				return fmt.Errorf("failed to create kubernetes client: %w", err)
+			}
+			registrations := &tenantv1alpha1.ClusterRegistrationList{}
+			if err := client.List(ctx, registrations); err != nil {
+				return fmt.Errorf("failed to list cluster registrations: %w", err)
+			}
+			if output == "json" || output == "yaml" {
+				return outputObject(registrations, output)
+			}
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "NAME\tENDPOINT\tREGION\tREADY\tAGE")
+			for _, reg := range registrations.Items {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%v\t%s\n",
+					reg.Spec.ClusterName,
+					reg.Spec.APIEndpoint,
+					reg.Spec.Region,
+					reg.Status.Ready,
+					utils.FormatAge(reg.CreationTimestamp.Time),
+				)
+			}
+			return w.Flush()
+		},
+	}
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Output format (json, yaml)")
+	return cmd
+}
+
+func newClustersRegisterCmd(cfg *config.Config) *cobra.Command {
+	var (
+		apiEndpoint string
+		kubeconfig  string
+		region      string
+		labelsFlag  map[string]string
+	)
+	cmd := &cobra.Command{
+		Use:   "register NAME",
+		Short: "Register a member cluster for tenant placement",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+			name := args[0]
+			if !utils.IsValidName(name) {
+				return fmt.Errorf("invalid cluster name: must be lowercase alphanumeric")
+			}
+			client, err := kube.NewClient(cfg)
+			if err != nil {
+				return fmt.Errorf("failed to create kubernetes client: %w", err)
+			}
+			kubeconfigData, err := os.ReadFile(kubeconfig)
+			if err != nil {
+				return fmt.Errorf("failed to read kubeconfig file: %w", err)
+			}
+
+			secretName := fmt.Sprintf("%s-kubeconfig", name)
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      secretName,
+					Namespace: clusterSystemNamespace,
+				},
+				Type: corev1.SecretTypeOpaque,
+				Data: map[string][]byte{
+					"kubeconfig": kubeconfigData,
+				},
+			}
+			if err := client.Create(ctx, secret); err != nil {
+				return fmt.Errorf("failed to create kubeconfig secret: %w", err)
+			}
+
+			reg := &tenantv1alpha1.ClusterRegistration{
+				ObjectMeta: metav1.ObjectMeta{Name: name},
+				Spec: tenantv1alpha1.ClusterRegistrationSpec{
+					ClusterName: name,
+					APIEndpoint: apiEndpoint,
+					KubeconfigSecretRef: corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: secretName},
+						Key:                  "kubeconfig",
+					},
+					Region: region,
+					Labels: labelsFlag,
+				},
+			}
+			if err := client.Create(ctx, reg); err != nil {
+				return fmt.Errorf("failed to create cluster registration: %w", err)
+			}
+			fmt.Printf("Cluster '%s' registered successfully\n", name)
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&apiEndpoint, "api-endpoint", "", "Member cluster API server URL")
+	cmd.Flags().StringVar(&kubeconfig, "kubeconfig", "", "Path to a kubeconfig file with credentials for the member cluster")
+	cmd.Flags().StringVar(&region, "region", "", "Region, used for placement region-diversity spread constraints")
+	cmd.Flags().StringToStringVar(&labelsFlag, "labels", map[string]string{}, "Labels matched by Tenant placement cluster selectors")
+	cmd.MarkFlagRequired("api-endpoint")
+	cmd.MarkFlagRequired("kubeconfig")
+	return cmd
+}
+
+func newClustersDeregisterCmd(cfg *config.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deregister NAME",
+		Short: "Remove a registered member cluster",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+			name := args[0]
+			client, err := kube.NewClient(cfg)
+			if err != nil {
+				return fmt.Errorf("failed to create kubernetes client: %w", err)
+			}
+			reg := &tenantv1alpha1.ClusterRegistration{}
+			if err := client.Get(ctx, types.NamespacedName{Name: name}, reg); err != nil {
+				return fmt.Errorf("failed to get cluster registration: %w", err)
+			}
+			if err := client.Delete(ctx, reg); err != nil {
+				return fmt.Errorf("failed to delete cluster registration: %w", err)
+			}
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      reg.Spec.KubeconfigSecretRef.Name,
+					Namespace: clusterSystemNamespace,
+				},
+			}
+			if err := client.Delete(ctx, secret); err != nil && !kube.IsNotFound(err) {
+				return fmt.Errorf("failed to delete kubeconfig secret: %w", err)
+			}
+			fmt.Printf("Cluster '%s' deregistered\n", name)
+			return nil
+		},
+	}
+	return cmd
+}
@@ -1,30 +1,272 @@
 package commands
 
 import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
 	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
 	"database/sql"
+	"embed"
+	"encoding/binary"
 	"fmt"
+	"hash/fnv"
+	"io"
+	"io/fs"
+	"net/url"
 	"os"
+	"os/exec"
 	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
+	"sync"
+	"text/tabwriter"
 	"time"
 
 	_ "github.com/go-sql-driver/mysql"
 	"github.com/golang-migrate/migrate/v4"
+	"github.com/golang-migrate/migrate/v4/database"
 	"github.com/golang-migrate/migrate/v4/database/mysql"
 	"github.com/golang-migrate/migrate/v4/database/postgres"
-	_ "github.com/golang-migrate/migrate/v4/source/file"
+	"github.com/golang-migrate/migrate/v4/source"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
 	_ "github.com/lib/pq"
 	"github.com/spf13/cobra"
+	"gocloud.dev/blob"
+	_ "gocloud.dev/blob/azureblob"
+	_ "gocloud.dev/blob/gcsblob"
+	_ "gocloud.dev/blob/s3blob"
+	"gopkg.in/yaml.v3"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	tenantv1alpha1 "github.com/rezenkai/multi-saas-crm/tenant-orchestrator/api/v1alpha1"
 	"github.com/rezenkai/multi-saas-crm/tenant-orchestrator/cli/internal/config"
+	"github.com/rezenkai/multi-saas-crm/tenant-orchestrator/cli/internal/dbdriver"
 	"github.com/rezenkai/multi-saas-crm/tenant-orchestrator/cli/internal/kube"
+	"github.com/rezenkai/multi-saas-crm/tenant-orchestrator/cli/internal/migrations"
 	"github.com/rezenkai/multi-saas-crm/tenant-orchestrator/cli/internal/utils"
 )
 
+//go:embed migrations/postgres/*.sql migrations/mysql/*.sql
+var migrationsFS embed.FS
+
+// migrationSource returns a golang-migrate source.Driver backed by the
+// migrations embedded in the CLI binary for the given database type, so the
+// CLI no longer depends on a MIGRATIONS_DIR on disk at runtime.
+func migrationSource(dbType string) (source.Driver, error) {
+	return iofs.New(migrationsFS, filepath.Join("migrations", dbType))
+}
+
+// mergedStep is either a .sql migration or a registered Go migration,
+// addressed by the same numeric ID so the two can be interleaved and run
+// in a single sequence.
+type mergedStep struct {
+	ID          uint
+	Description string
+	upSQL       []byte
+	downSQL     []byte
+	goMigration *migrations.Migration
+}
+
+func (s mergedStep) runUp(ctx context.Context, db *sql.DB, driver migrate.Driver) error {
+	if s.goMigration != nil {
+		return runInTx(ctx, db, s.goMigration.Up)
+	}
+	return driver.Run(bytes.NewReader(s.upSQL))
+}
+
+func (s mergedStep) runDown(ctx context.Context, db *sql.DB, driver migrate.Driver) error {
+	if s.goMigration != nil {
+		return runInTx(ctx, db, s.goMigration.Down)
+	}
+	return driver.Run(bytes.NewReader(s.downSQL))
+}
+
+func runInTx(ctx context.Context, db *sql.DB, step func(tx *sql.Tx) error) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err := step(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// mergeMigrationSteps reads the .sql migrations embedded for dbType,
+// overlays the Go migrations registered via migrations.RegisterMigration,
+// and returns the combined sequence sorted by ID. It errors if a Go
+// migration and a .sql file claim the same ID.
+func mergeMigrationSteps(dbType string) ([]mergedStep, error) {
+	dir := filepath.Join("migrations", dbType)
+	entries, err := fs.ReadDir(migrationsFS, dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read embedded migrations for %s: %w", dbType, err)
+	}
+
+	byID := make(map[uint]*mergedStep)
+	for _, entry := range entries {
+		isUp := strings.HasSuffix(entry.Name(), ".up.sql")
+		isDown := strings.HasSuffix(entry.Name(), ".down.sql")
+		if !isUp && !isDown {
+			continue
+		}
+		version, _, err := parseMigrationFilename(entry.Name())
+		if err != nil {
+			return nil, err
+		}
+		content, err := migrationsFS.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		step, ok := byID[version]
+		if !ok {
+			step = &mergedStep{ID: version}
+			byID[version] = step
+		}
+		if isUp {
+			step.upSQL = content
+			step.Description = parseMigrationDescription(content)
+		} else {
+			step.downSQL = content
+		}
+	}
+
+	for _, m := range migrations.All() {
+		if _, exists := byID[m.ID]; exists {
+			return nil, fmt.Errorf("migration %d is registered both as a Go migration and a %s .sql file", m.ID, dbType)
+		}
+		m := m
+		byID[m.ID] = &mergedStep{ID: m.ID, Description: m.Description, goMigration: &m}
+	}
+
+	steps := make([]mergedStep, 0, len(byID))
+	for _, step := range byID {
+		steps = append(steps, *step)
+	}
+	sort.Slice(steps, func(i, j int) bool { return steps[i].ID < steps[j].ID })
+	return steps, nil
+}
+
+// applyMergedMigrations runs every merged step not yet reflected in
+// driver's current version, in ID order for "up" or reverse ID order for
+// "down", recording progress in the same version/dirty state golang-migrate
+// itself tracks so "db status" and a plain golang-migrate run stay in sync.
+// It returns migrate.ErrNoChange if there was nothing to do, matching
+// *migrate.Migrate's Up()/Down() behavior.
+func applyMergedMigrations(ctx context.Context, db *sql.DB, driver migrate.Driver, dbType, direction string) error {
+	if err := driver.Lock(); err != nil {
+		return fmt.Errorf("failed to acquire migration lock: %w", err)
+	}
+	defer driver.Unlock()
+
+	steps, err := mergeMigrationSteps(dbType)
+	if err != nil {
+		return err
+	}
+	version, dirty, err := driver.Version()
+	if err != nil {
+		return fmt.Errorf("failed to read current version: %w", err)
+	}
+	if dirty {
+		return fmt.Errorf("database is in a dirty state at version %d; resolve manually before migrating", version)
+	}
+
+	applied := 0
+	switch direction {
+	case "up":
+		for _, step := range steps {
+			if int(step.ID) <= version {
+				continue
+			}
+			if err := step.runUp(ctx, db, driver); err != nil {
+				driver.SetVersion(int(step.ID), true)
+				return fmt.Errorf("migration %d (%s) failed: %w", step.ID, step.Description, err)
+			}
+			if err := driver.SetVersion(int(step.ID), false); err != nil {
+				return fmt.Errorf("failed to record version %d: %w", step.ID, err)
+			}
+			applied++
+		}
+	case "down":
+		for i := len(steps) - 1; i >= 0; i-- {
+			step := steps[i]
+			if int(step.ID) > version {
+				continue
+			}
+			if err := step.runDown(ctx, db, driver); err != nil {
+				driver.SetVersion(int(step.ID), true)
+				return fmt.Errorf("migration %d (%s) failed: %w", step.ID, step.Description, err)
+			}
+			applied++
+		}
+		if applied > 0 {
+			if err := driver.SetVersion(database.NilVersion, false); err != nil {
+				return fmt.Errorf("failed to reset version: %w", err)
+			}
+		}
+	default:
+		return fmt.Errorf("invalid migration direction: %s", direction)
+	}
+
+	if applied == 0 {
+		return migrate.ErrNoChange
+	}
+	return nil
+}
+
+// withMigrationLock holds a session-scoped advisory lock keyed by tenant for
+// the duration of fn, so two concurrent CLI invocations (or a migrate-all
+// run racing a lone "db migrate") against the same tenant can't both drive
+// migrations at once and leave schema_migrations in a dirty state.
+func withMigrationLock(ctx context.Context, db *sql.DB, dbType, tenant string, fn func() error) error {
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to acquire a dedicated connection for locking: %w", err)
+	}
+	defer conn.Close()
+
+	switch dbType {
+	case "postgres":
+		key := advisoryLockKey(tenant)
+		if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", key); err != nil {
+			return fmt.Errorf("failed to acquire migration lock: %w", err)
+		}
+		defer conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", key)
+	case "mysql":
+		lockName := fmt.Sprintf("migrate:%s", tenant)
+		var acquired sql.NullInt64
+		if err := conn.QueryRowContext(ctx, "SELECT GET_LOCK(?, 30)", lockName).Scan(&acquired); err != nil {
+			return fmt.Errorf("failed to acquire migration lock: %w", err)
+		}
+		if !acquired.Valid || acquired.Int64 != 1 {
+			return fmt.Errorf("timed out waiting for migration lock on tenant %q", tenant)
+		}
+		defer conn.ExecContext(context.Background(), "SELECT RELEASE_LOCK(?)", lockName)
+	default:
+		return fmt.Errorf("unsupported database type: %s", dbType)
+	}
+
+	return fn()
+}
+
+// advisoryLockKey derives a deterministic bigint key for pg_advisory_lock
+// from a tenant name via FNV-1a, since Postgres advisory locks take an
+// int8, not a string.
+func advisoryLockKey(tenant string) int64 {
+	h := fnv.New64a()
+	h.Write([]byte(tenant))
+	return int64(h.Sum64())
+}
+
 // NewDatabaseCmd creates the database management command
 func NewDatabaseCmd(cfg *config.Config) *cobra.Command {
 	cmd := &cobra.Command{
@@ -36,12 +278,17 @@ func NewDatabaseCmd(cfg *config.Config) *cobra.Command {
 
 	cmd.AddCommand(
 		newDBMigrateCmd(cfg),
+		newDBMigrateAllCmd(cfg),
 		newDBStatusCmd(cfg),
 		newDBRollbackCmd(cfg),
 		newDBSeedCmd(cfg),
 		newDBConsoleCmd(cfg),
 		newDBDumpCmd(cfg),
 		newDBRestoreCmd(cfg),
+		newDBScheduleBackupCmd(cfg),
+		newDBCheckCmd(cfg),
+		newDBMakeMigrationCmd(),
+		newDBRepairCmd(cfg),
 	)
 
 	return cmd
@@ -54,6 +301,7 @@ func newDBMigrateCmd(cfg *config.Config) *cobra.Command {
 		steps     int
 		version   int
 		force     bool
+		list      bool
 	)
 
 	cmd := &cobra.Command{
@@ -84,35 +332,52 @@ func newDBMigrateCmd(cfg *config.Config) *cobra.Command {
 				return fmt.Errorf("failed to create migration driver: %w", err)
 			}
 
-			// Get migrations path
-			migrationsPath := filepath.Join("file://", getMigrationsDir(dbType))
-			
-			m, err := migrate.NewWithDatabaseInstance(migrationsPath, dbType, driver)
+			src, err := migrationSource(dbType)
+			if err != nil {
+				return fmt.Errorf("failed to load migrations: %w", err)
+			}
+			m, err := migrate.NewWithInstance("iofs", src, dbType, driver)
 			if err != nil {
 				return fmt.Errorf("failed to create migrator: %w", err)
 			}
 			defer m.Close()
 
-			// Execute migration based on direction
-			switch direction {
-			case "up":
-				if version > 0 {
-					err = m.Migrate(uint(version))
-				} else if steps > 0 {
-					err = m.Steps(steps)
-				} else {
-					err = m.Up()
-				}
-			case "down":
-				if steps > 0 {
-					err = m.Steps(-steps)
-				} else {
-					err = m.Down()
+			if list {
+				infos, err := listMigrations(dbType, driver)
+				if err != nil {
+					return fmt.Errorf("failed to list migrations: %w", err)
 				}
-			default:
+				printMigrations(tenant, dbType, infos)
+				return nil
+			}
+
+			if direction != "up" && direction != "down" {
 				return fmt.Errorf("invalid migration direction: %s", direction)
 			}
 
+			// Execute migration based on direction, under an advisory lock
+			// so a concurrent invocation against the same tenant can't race
+			// into a dirty state. A plain up/down (no --steps, and for up
+			// no --version) goes through applyMergedMigrations so it picks
+			// up any Go-coded migrations alongside the .sql ones; a step
+			// count, or a target version on up, stays on golang-migrate
+			// directly, since interleaving Go migrations into a partial run
+			// isn't supported yet. --version is ignored for down.
+			err = withMigrationLock(ctx, db, dbType, tenant, func() error {
+				if direction == "up" {
+					if version > 0 {
+						return m.Migrate(uint(version))
+					} else if steps > 0 {
+						return m.Steps(steps)
+					}
+					return applyMergedMigrations(ctx, db, driver, dbType, "up")
+				}
+				if steps > 0 {
+					return m.Steps(-steps)
+				}
+				return applyMergedMigrations(ctx, db, driver, dbType, "down")
+			})
+
 			if err != nil && err != migrate.ErrNoChange {
 				return fmt.Errorf("migration failed: %w", err)
 			}
@@ -144,6 +409,7 @@ func newDBMigrateCmd(cfg *config.Config) *cobra.Command {
 	cmd.Flags().IntVar(&steps, "steps", 0, "Number of migrations to apply")
 	cmd.Flags().IntVar(&version, "version", 0, "Migrate to specific version")
 	cmd.Flags().BoolVar(&force, "force", false, "Force migration (use with caution)")
+	cmd.Flags().BoolVar(&list, "list", false, "List available migrations and their applied status instead of running them")
 
 	cmd.MarkFlagRequired("tenant")
 
@@ -151,7 +417,10 @@ func newDBMigrateCmd(cfg *config.Config) *cobra.Command {
 }
 
 func newDBStatusCmd(cfg *config.Config) *cobra.Command {
-	var tenant string
+	var (
+		tenant string
+		all    bool
+	)
 
 	cmd := &cobra.Command{
 		Use:   "status",
@@ -159,70 +428,99 @@ func newDBStatusCmd(cfg *config.Config) *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := context.Background()
 
-			// Get database connection
-			db, dbType, err := getDatabaseConnection(ctx, cfg, tenant)
-			if err != nil {
-				return fmt.Errorf("failed to connect to database: %w", err)
+			if !all {
+				if tenant == "" {
+					return fmt.Errorf("--tenant is required unless --all is set")
+				}
+				return printTenantStatus(ctx, cfg, tenant)
 			}
-			defer db.Close()
 
-			// Check connection
-			if err := db.Ping(); err != nil {
-				return fmt.Errorf("database ping failed: %w", err)
+			names, err := listTenantNames(ctx, cfg, "")
+			if err != nil {
+				return fmt.Errorf("failed to list tenants: %w", err)
 			}
-
-			fmt.Printf("Database connection successful for tenant '%s'\n", tenant)
-			fmt.Printf("Database type: %s\n\n", dbType)
-
-			// Get migration status
-			var driver migrate.Driver
-			switch dbType {
-			case "postgres":
-				driver, err = postgres.WithInstance(db, &postgres.Config{})
-			case "mysql":
-				driver, err = mysql.WithInstance(db, &mysql.Config{})
+			var failed int
+			for i, name := range names {
+				if i > 0 {
+					fmt.Println()
+				}
+				if err := printTenantStatus(ctx, cfg, name); err != nil {
+					fmt.Printf("[%s] error: %v\n", name, err)
+					failed++
+				}
 			}
-			if err != nil {
-				return fmt.Errorf("failed to create migration driver: %w", err)
+			if failed > 0 {
+				return fmt.Errorf("failed to get status for %d of %d tenant(s)", failed, len(names))
 			}
+			return nil
+		},
+	}
 
-			migrationsPath := filepath.Join("file://", getMigrationsDir(dbType))
-			m, err := migrate.NewWithDatabaseInstance(migrationsPath, dbType, driver)
-			if err != nil {
-				return fmt.Errorf("failed to create migrator: %w", err)
-			}
-			defer m.Close()
+	cmd.Flags().StringVarP(&tenant, "tenant", "t", "", "Tenant name (required unless --all is set)")
+	cmd.Flags().BoolVar(&all, "all", false, "Show status for every tenant in the configured namespace")
 
-			// Get current version
-			version, dirty, err := m.Version()
-			if err != nil && err != migrate.ErrNilVersion {
-				return fmt.Errorf("failed to get migration version: %w", err)
-			}
+	return cmd
+}
 
-			if err == migrate.ErrNilVersion {
-				fmt.Println("Migration Status: No migrations applied")
-			} else {
-				fmt.Printf("Migration Status:\n")
-				fmt.Printf("  Current Version: %d\n", version)
-				fmt.Printf("  Dirty:          %v\n", dirty)
-			}
+// printTenantStatus prints the connection and migration status for a single
+// tenant, the body newDBStatusCmd runs once per --tenant (or once per
+// discovered tenant under --all).
+func printTenantStatus(ctx context.Context, cfg *config.Config, tenant string) error {
+	db, dbType, err := getDatabaseConnection(ctx, cfg, tenant)
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+	defer db.Close()
+
+	if err := db.Ping(); err != nil {
+		return fmt.Errorf("database ping failed: %w", err)
+	}
 
-			// Show database statistics
-			fmt.Println("\nDatabase Statistics:")
-			stats := db.Stats()
-			fmt.Printf("  Open Connections:    %d\n", stats.OpenConnections)
-			fmt.Printf("  In Use:             %d\n", stats.InUse)
-			fmt.Printf("  Idle:               %d\n", stats.Idle)
-			fmt.Printf("  Max Open:           %d\n", stats.MaxOpenConnections)
+	fmt.Printf("Database connection successful for tenant '%s'\n", tenant)
+	fmt.Printf("Database type: %s\n\n", dbType)
 
-			return nil
-		},
+	var driver migrate.Driver
+	switch dbType {
+	case "postgres":
+		driver, err = postgres.WithInstance(db, &postgres.Config{})
+	case "mysql":
+		driver, err = mysql.WithInstance(db, &mysql.Config{})
+	}
+	if err != nil {
+		return fmt.Errorf("failed to create migration driver: %w", err)
 	}
 
-	cmd.Flags().StringVarP(&tenant, "tenant", "t", "", "Tenant name (required)")
-	cmd.MarkFlagRequired("tenant")
+	src, err := migrationSource(dbType)
+	if err != nil {
+		return fmt.Errorf("failed to load migrations: %w", err)
+	}
+	m, err := migrate.NewWithInstance("iofs", src, dbType, driver)
+	if err != nil {
+		return fmt.Errorf("failed to create migrator: %w", err)
+	}
+	defer m.Close()
 
-	return cmd
+	version, dirty, err := m.Version()
+	if err != nil && err != migrate.ErrNilVersion {
+		return fmt.Errorf("failed to get migration version: %w", err)
+	}
+
+	if err == migrate.ErrNilVersion {
+		fmt.Println("Migration Status: No migrations applied")
+	} else {
+		fmt.Printf("Migration Status:\n")
+		fmt.Printf("  Current Version: %d\n", version)
+		fmt.Printf("  Dirty:          %v\n", dirty)
+	}
+
+	fmt.Println("\nDatabase Statistics:")
+	stats := db.Stats()
+	fmt.Printf("  Open Connections:    %d\n", stats.OpenConnections)
+	fmt.Printf("  In Use:             %d\n", stats.InUse)
+	fmt.Printf("  Idle:               %d\n", stats.Idle)
+	fmt.Printf("  Max Open:           %d\n", stats.MaxOpenConnections)
+
+	return nil
 }
 
 func newDBRollbackCmd(cfg *config.Config) *cobra.Command {
@@ -380,185 +678,1648 @@ func newDBConsoleCmd(cfg *config.Config) *cobra.Command {
 	return cmd
 }
 
+// blobBackupPrefix is the separator between a tenant name and the
+// timestamp in a blob-storage backup's object key, matching the local
+// "<tenant>-backup-<timestamp>.sql" naming newDBDumpCmd already used.
+const blobBackupPrefix = "-backup-"
+
+// blobSchemes are the object-storage URL schemes newDBDumpCmd/newDBRestoreCmd
+// accept for --output/--input, matching BackupRepoSpec's s3/gcs/azure backends.
+var blobSchemes = map[string]bool{"s3": true, "gs": true, "azblob": true}
+
+func isBlobURL(raw string) bool {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return false
+	}
+	return blobSchemes[u.Scheme]
+}
+
+// splitBlobURL splits a gocloud.dev/blob object URL (e.g.
+// "s3://bucket/prefix/file.sql.gz.enc") into the bucket-level URL
+// blob.OpenBucket expects ("s3://bucket") and the object key under it
+// ("prefix/file.sql.gz.enc").
+func splitBlobURL(raw string) (bucketURL, key string, err error) {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid backup URL %q: %w", raw, err)
+	}
+	key = strings.TrimPrefix(u.Path, "/")
+	u.Path = ""
+	u.RawPath = ""
+	return u.String(), key, nil
+}
+
+// defaultBackupKey names a blob-storage backup object the same way
+// newDBDumpCmd already named local files, with .gz (and .enc once
+// encrypted) appended so pruneBackups and `db restore --from-latest` can
+// recognize it again.
+func defaultBackupKey(tenant string, encrypted bool) string {
+	name := fmt.Sprintf("%s%s%s.sql.gz", tenant, blobBackupPrefix, time.Now().Format("20060102-150405"))
+	if encrypted {
+		name += ".enc"
+	}
+	return name
+}
+
+// resolveEncryptionKey looks up the AES key backups for tenant should be
+// encrypted with, read from the Secret its Tenant CR's
+// Spec.Database.Backup.EncryptionKeyRef points at. ok is false, with no
+// error, when the tenant has no EncryptionKeyRef configured, since
+// encryption is optional.
+func resolveEncryptionKey(ctx context.Context, cfg *config.Config, tenantName string) (key []byte, ok bool, err error) {
+	c, err := kube.NewClient(cfg)
+	if err != nil {
+		return nil, false, err
+	}
+	tenant := &tenantv1alpha1.Tenant{}
+	if err := c.Get(ctx, types.NamespacedName{Name: tenantName, Namespace: cfg.Namespace}, tenant); err != nil {
+		return nil, false, fmt.Errorf("failed to get tenant: %w", err)
+	}
+	ref := tenant.Spec.Database.Backup.EncryptionKeyRef
+	if ref == nil {
+		return nil, false, nil
+	}
+	secret := &corev1.Secret{}
+	if err := c.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: cfg.Namespace}, secret); err != nil {
+		return nil, false, fmt.Errorf("failed to get encryption key secret %q: %w", ref.Name, err)
+	}
+	value, present := secret.Data[ref.Key]
+	if !present {
+		return nil, false, fmt.Errorf("secret %q has no key %q", ref.Name, ref.Key)
+	}
+	return value, true, nil
+}
+
+// gcmFrameSize is how much plaintext each AES-GCM frame written by
+// gcmWriter covers. AES-GCM seals a whole message in one call, so a
+// multi-gigabyte dump is sealed as a sequence of independently
+// length-prefixed, independently-authenticated frames rather than one,
+// letting gcmWriter/gcmReader stream without buffering the full backup.
+const gcmFrameSize = 1 << 20 // 1 MiB
+
+// gcmWriter wraps an io.Writer, encrypting everything written to it as a
+// stream of AES-GCM frames. Callers must call Close to flush the final,
+// possibly short, frame.
+type gcmWriter struct {
+	w   io.Writer
+	gcm cipher.AEAD
+	buf []byte
+	n   int
+}
+
+func newGCMWriter(w io.Writer, key []byte) (*gcmWriter, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid encryption key: %w", err)
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &gcmWriter{w: w, gcm: gcm, buf: make([]byte, gcmFrameSize)}, nil
+}
+
+func (g *gcmWriter) Write(p []byte) (int, error) {
+	written := 0
+	for len(p) > 0 {
+		n := copy(g.buf[g.n:], p)
+		g.n += n
+		p = p[n:]
+		written += n
+		if g.n == len(g.buf) {
+			if err := g.flush(); err != nil {
+				return written, err
+			}
+		}
+	}
+	return written, nil
+}
+
+func (g *gcmWriter) flush() error {
+	if g.n == 0 {
+		return nil
+	}
+	nonce := make([]byte, g.gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return err
+	}
+	sealed := g.gcm.Seal(nil, nonce, g.buf[:g.n], nil)
+	var header [4]byte
+	binary.BigEndian.PutUint32(header[:], uint32(len(sealed)))
+	if _, err := g.w.Write(nonce); err != nil {
+		return err
+	}
+	if _, err := g.w.Write(header[:]); err != nil {
+		return err
+	}
+	if _, err := g.w.Write(sealed); err != nil {
+		return err
+	}
+	g.n = 0
+	return nil
+}
+
+func (g *gcmWriter) Close() error {
+	return g.flush()
+}
+
+// gcmReader reverses gcmWriter, decrypting an AES-GCM frame stream back
+// into plaintext as it's read.
+type gcmReader struct {
+	r         io.Reader
+	gcm       cipher.AEAD
+	nonceSize int
+	buf       []byte
+	pos       int
+}
+
+func newGCMReader(r io.Reader, key []byte) (*gcmReader, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid encryption key: %w", err)
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &gcmReader{r: r, gcm: gcm, nonceSize: gcm.NonceSize()}, nil
+}
+
+func (g *gcmReader) Read(p []byte) (int, error) {
+	if g.pos >= len(g.buf) {
+		if err := g.nextFrame(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, g.buf[g.pos:])
+	g.pos += n
+	return n, nil
+}
+
+func (g *gcmReader) nextFrame() error {
+	nonce := make([]byte, g.nonceSize)
+	if _, err := io.ReadFull(g.r, nonce); err != nil {
+		return err // a clean io.EOF here just means the stream is finished
+	}
+	var header [4]byte
+	if _, err := io.ReadFull(g.r, header[:]); err != nil {
+		return fmt.Errorf("truncated backup: %w", err)
+	}
+	sealed := make([]byte, binary.BigEndian.Uint32(header[:]))
+	if _, err := io.ReadFull(g.r, sealed); err != nil {
+		return fmt.Errorf("truncated backup: %w", err)
+	}
+	plain, err := g.gcm.Open(nil, nonce, sealed, nil)
+	if err != nil {
+		return fmt.Errorf("backup decryption failed: %w", err)
+	}
+	g.buf = plain
+	g.pos = 0
+	return nil
+}
+
+// pruneBackups keeps the keep newest objects under bucket matching
+// "<keyPrefix><tenant>-backup-*" and deletes the rest. Backup keys embed a
+// YYYYMMDD-HHMMSS timestamp right after the prefix, so lexicographic order
+// is also chronological order.
+func pruneBackups(ctx context.Context, bucket *blob.Bucket, keyPrefix, tenant string, keep int) error {
+	var keys []string
+	iter := bucket.List(&blob.ListOptions{Prefix: keyPrefix + tenant + blobBackupPrefix})
+	for {
+		obj, err := iter.Next(ctx)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		keys = append(keys, obj.Key)
+	}
+	sort.Strings(keys)
+	if len(keys) <= keep {
+		return nil
+	}
+	for _, key := range keys[:len(keys)-keep] {
+		if err := bucket.Delete(ctx, key); err != nil {
+			return fmt.Errorf("failed to delete old backup %q: %w", key, err)
+		}
+		fmt.Printf("Pruned old backup: %s\n", key)
+	}
+	return nil
+}
+
+// latestBackupURL resolves an s3://, gs:// or azblob:// bucket (or
+// bucket/prefix) URL to the newest "<tenant>-backup-*" object inside it,
+// for `db restore --from-latest`.
+func latestBackupURL(ctx context.Context, prefixURL, tenant string) (string, error) {
+	bucketURL, keyPrefix, err := splitBlobURL(prefixURL)
+	if err != nil {
+		return "", err
+	}
+	if keyPrefix != "" && !strings.HasSuffix(keyPrefix, "/") {
+		keyPrefix += "/"
+	}
+
+	bucket, err := blob.OpenBucket(ctx, bucketURL)
+	if err != nil {
+		return "", fmt.Errorf("failed to open bucket %q: %w", bucketURL, err)
+	}
+	defer bucket.Close()
+
+	var newest string
+	iter := bucket.List(&blob.ListOptions{Prefix: keyPrefix + tenant + blobBackupPrefix})
+	for {
+		obj, err := iter.Next(ctx)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+		if obj.Key > newest {
+			newest = obj.Key
+		}
+	}
+	if newest == "" {
+		return "", fmt.Errorf("no backups found for tenant %q under %s", tenant, prefixURL)
+	}
+	return bucketURL + "/" + newest, nil
+}
+
 func newDBDumpCmd(cfg *config.Config) *cobra.Command {
 	var (
-		tenant string
-		output string
-		format string
+		tenant    string
+		output    string
+		format    string
+		retention int
 	)
 
 	cmd := &cobra.Command{
 		Use:   "dump",
 		Short: "Create database backup",
+		Long:  "Create a database backup, either to a local file or streamed through gzip (and, when the tenant has an EncryptionKeyRef configured, AES-GCM) directly to an s3://, gs:// or azblob:// object-storage URL",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := context.Background()
 
-			// Get database credentials
 			creds, dbType, err := getDatabaseCredentials(ctx, cfg, tenant)
 			if err != nil {
 				return fmt.Errorf("failed to get database credentials: %w", err)
 			}
 
-			// Generate output filename if not specified
 			if output == "" {
-				timestamp := time.Now().Format("20060102-150405")
-				output = fmt.Sprintf("%s-backup-%s.sql", tenant, timestamp)
+				output = fmt.Sprintf("%s-backup-%s.sql", tenant, time.Now().Format("20060102-150405"))
 			}
 
-			// Build dump command
-			var dumpCmd []string
-			switch dbType {
-			case "postgres":
-				dumpCmd = []string{
-					"pg_dump",
-					"-h", creds.Host,
-					"-p", fmt.Sprintf("%d", creds.Port),
-					"-U", creds.Username,
-					"-d", creds.Database,
-					"-f", output,
-				}
-				if format == "custom" {
-					dumpCmd = append(dumpCmd, "-Fc")
-				}
-			case "mysql":
-				dumpCmd = []string{
-					"mysqldump",
-					"-h", creds.Host,
-					"-P", fmt.Sprintf("%d", creds.Port),
-					"-u", creds.Username,
-					fmt.Sprintf("-p%s", creds.Password),
-					"--result-file", output,
-					creds.Database,
-				}
-			default:
-				return fmt.Errorf("unsupported database type: %s", dbType)
+			if !isBlobURL(output) {
+				return dumpToFile(tenant, dbType, creds, output, format)
 			}
+			return dumpToBlob(ctx, cfg, tenant, dbType, creds, output, retention)
+		},
+	}
+
+	cmd.Flags().StringVarP(&tenant, "tenant", "t", "", "Tenant name (required)")
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Output file path, or an s3://, gs:// or azblob:// URL to stream the backup to")
+	cmd.Flags().StringVar(&format, "format", "plain", "Local backup format (plain, custom); ignored for object-storage output")
+	cmd.Flags().IntVar(&retention, "retention", 0, "Keep only the N newest backups matching <tenant>-backup-* under the same object-storage prefix (0 disables pruning)")
+
+	cmd.MarkFlagRequired("tenant")
+
+	return cmd
+}
+
+// dumpToFile is newDBDumpCmd's local-file path: pg_dump/mysqldump write
+// straight to a path on disk, unchanged from before object-storage support.
+func dumpToFile(tenant, dbType string, creds *DatabaseCredentials, output, format string) error {
+	var dumpCmd []string
+	switch dbType {
+	case "postgres":
+		dumpCmd = []string{
+			"pg_dump",
+			"-h", creds.Host,
+			"-p", fmt.Sprintf("%d", creds.Port),
+			"-U", creds.Username,
+			"-d", creds.Database,
+			"-f", output,
+		}
+		if format == "custom" {
+			dumpCmd = append(dumpCmd, "-Fc")
+		}
+	case "mysql":
+		dumpCmd = []string{
+			"mysqldump",
+			"-h", creds.Host,
+			"-P", fmt.Sprintf("%d", creds.Port),
+			"-u", creds.Username,
+			fmt.Sprintf("-p%s", creds.Password),
+			"--result-file", output,
+			creds.Database,
+		}
+	default:
+		return fmt.Errorf("unsupported database type: %s", dbType)
+	}
+
+	fmt.Printf("Creating backup for tenant '%s'...\n", tenant)
+	if err := utils.ExecuteCommand(dumpCmd[0], dumpCmd[1:]...); err != nil {
+		return fmt.Errorf("backup failed: %w", err)
+	}
+
+	fileInfo, err := os.Stat(output)
+	if err != nil {
+		return fmt.Errorf("failed to stat backup file: %w", err)
+	}
+
+	fmt.Printf("Backup completed successfully!\n")
+	fmt.Printf("File: %s\n", output)
+	fmt.Printf("Size: %s\n", utils.FormatBytes(fileInfo.Size()))
+
+	return nil
+}
+
+// dumpToBlob streams pg_dump/mysqldump's stdout through gzip and, once
+// resolveEncryptionKey finds a key, AES-GCM straight into an
+// object-storage bucket, so the full backup is never written to local disk.
+func dumpToBlob(ctx context.Context, cfg *config.Config, tenant, dbType string, creds *DatabaseCredentials, output string, retention int) error {
+	key, encrypted, err := resolveEncryptionKey(ctx, cfg, tenant)
+	if err != nil {
+		return fmt.Errorf("failed to resolve encryption key: %w", err)
+	}
+
+	bucketURL, objectKey, err := splitBlobURL(output)
+	if err != nil {
+		return err
+	}
+	if objectKey == "" || strings.HasSuffix(objectKey, "/") {
+		objectKey += defaultBackupKey(tenant, encrypted)
+	}
+
+	bucket, err := blob.OpenBucket(ctx, bucketURL)
+	if err != nil {
+		return fmt.Errorf("failed to open bucket %q: %w", bucketURL, err)
+	}
+	defer bucket.Close()
+
+	var dumpArgs []string
+	switch dbType {
+	case "postgres":
+		dumpArgs = []string{
+			"pg_dump",
+			"-h", creds.Host,
+			"-p", fmt.Sprintf("%d", creds.Port),
+			"-U", creds.Username,
+			"-d", creds.Database,
+		}
+	case "mysql":
+		dumpArgs = []string{
+			"mysqldump",
+			"-h", creds.Host,
+			"-P", fmt.Sprintf("%d", creds.Port),
+			"-u", creds.Username,
+			fmt.Sprintf("-p%s", creds.Password),
+			creds.Database,
+		}
+	default:
+		return fmt.Errorf("unsupported database type: %s", dbType)
+	}
+
+	dumper := exec.CommandContext(ctx, dumpArgs[0], dumpArgs[1:]...)
+	stdout, err := dumper.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	dumper.Stderr = os.Stderr
+	if err := dumper.Start(); err != nil {
+		return fmt.Errorf("failed to start %s: %w", dumpArgs[0], err)
+	}
+
+	fmt.Printf("Streaming backup for tenant '%s' to %s...\n", tenant, bucketURL+"/"+objectKey)
+	writer, err := bucket.NewWriter(ctx, objectKey, nil)
+	if err != nil {
+		return fmt.Errorf("failed to open %q for writing: %w", objectKey, err)
+	}
+
+	var dest io.Writer = writer
+	var encCloser io.Closer
+	if encrypted {
+		gw, err := newGCMWriter(writer, key)
+		if err != nil {
+			writer.Close()
+			return err
+		}
+		dest = gw
+		encCloser = gw
+	}
+
+	gz := gzip.NewWriter(dest)
+	if _, err := io.Copy(gz, stdout); err != nil {
+		gz.Close()
+		if encCloser != nil {
+			encCloser.Close()
+		}
+		writer.Close()
+		dumper.Wait()
+		return fmt.Errorf("failed to stream backup: %w", err)
+	}
+	if err := gz.Close(); err != nil {
+		return fmt.Errorf("failed to flush backup: %w", err)
+	}
+	if encCloser != nil {
+		if err := encCloser.Close(); err != nil {
+			return fmt.Errorf("failed to flush encrypted backup: %w", err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return fmt.Errorf("failed to finalize %q: %w", objectKey, err)
+	}
+	if err := dumper.Wait(); err != nil {
+		return fmt.Errorf("backup failed: %w", err)
+	}
+
+	fmt.Printf("Backup completed successfully!\n")
+	fmt.Printf("Object: %s\n", bucketURL+"/"+objectKey)
+
+	if retention > 0 {
+		keyPrefix := objectKey[:strings.LastIndex(objectKey, "/")+1]
+		if err := pruneBackups(ctx, bucket, keyPrefix, tenant, retention); err != nil {
+			return fmt.Errorf("backup succeeded but retention pruning failed: %w", err)
+		}
+	}
+	return nil
+}
+
+func newDBRestoreCmd(cfg *config.Config) *cobra.Command {
+	var (
+		tenant            string
+		input             string
+		force             bool
+		fromLatest        bool
+		singleTransaction bool
+		stopOnError       bool
+		parallelJobs      int
+	)
+
+	cmd := &cobra.Command{
+		Use:   "restore",
+		Short: "Restore database from backup",
+		Long:  "Restore a database from a local file, or stream it back from an s3://, gs:// or azblob:// backup, decrypting and decompressing it on the fly. Plain-SQL dumps are applied statement-by-statement through database/sql; Postgres custom-format (-Fc) dumps still go through pg_restore.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !force {
+				fmt.Printf("WARNING: This will restore database for tenant '%s' from backup. All current data will be lost. Continue? [y/N]: ", tenant)
+				var response string
+				fmt.Scanln(&response)
+				if strings.ToLower(response) != "y" {
+					fmt.Println("Restore cancelled")
+					return nil
+				}
+			}
+
+			ctx := context.Background()
+
+			if fromLatest {
+				if !isBlobURL(input) {
+					return fmt.Errorf("--from-latest requires --input to be an s3://, gs:// or azblob:// prefix")
+				}
+				latest, err := latestBackupURL(ctx, input, tenant)
+				if err != nil {
+					return fmt.Errorf("failed to find latest backup: %w", err)
+				}
+				fmt.Printf("Restoring from latest backup: %s\n", latest)
+				input = latest
+			}
+
+			creds, dbType, err := getDatabaseCredentials(ctx, cfg, tenant)
+			if err != nil {
+				return fmt.Errorf("failed to get database credentials: %w", err)
+			}
+
+			opts := dbdriver.Options{
+				SingleTransaction: singleTransaction,
+				StopOnError:       stopOnError,
+				ParallelJobs:      parallelJobs,
+			}
+
+			if isBlobURL(input) {
+				return restoreFromBlob(ctx, cfg, tenant, dbType, input, opts)
+			}
+
+			if _, err := os.Stat(input); err != nil {
+				return fmt.Errorf("backup file not found: %w", err)
+			}
+			return restoreFromFile(ctx, cfg, tenant, dbType, creds, input, opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&tenant, "tenant", "t", "", "Tenant name (required)")
+	cmd.Flags().StringVarP(&input, "input", "i", "", "Backup file path, or an s3://, gs:// or azblob:// URL/prefix (required)")
+	cmd.Flags().BoolVar(&force, "force", false, "Skip confirmation")
+	cmd.Flags().BoolVar(&fromLatest, "from-latest", false, "Treat --input as a bucket/prefix and restore the newest <tenant>-backup-* object found in it")
+	cmd.Flags().BoolVar(&singleTransaction, "single-transaction", true, "Run a plain-SQL restore inside one transaction, rolling back entirely on the first failing statement")
+	cmd.Flags().BoolVar(&stopOnError, "stop-on-error", true, "Abort on the first failing statement instead of continuing past it (only applies when --single-transaction=false)")
+	cmd.Flags().IntVar(&parallelJobs, "parallel-jobs", 1, "Parallel restore jobs, passed through as pg_restore -j for custom-format Postgres dumps only")
+
+	cmd.MarkFlagRequired("tenant")
+	cmd.MarkFlagRequired("input")
+
+	return cmd
+}
+
+// restoreFromFile is newDBRestoreCmd's local-file path: a Postgres
+// custom-format (-Fc) dump still goes through pg_restore, everything else
+// is parsed and applied statement-by-statement via dbdriver.
+func restoreFromFile(ctx context.Context, cfg *config.Config, tenant, dbType string, creds *DatabaseCredentials, input string, opts dbdriver.Options) error {
+	if dbType == "postgres" && strings.HasSuffix(input, ".dump") {
+		return restoreCustomFormat(ctx, tenant, creds, input, opts)
+	}
+
+	db, _, err := getDatabaseConnection(ctx, cfg, tenant)
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+	defer db.Close()
+
+	f, err := os.Open(input)
+	if err != nil {
+		return fmt.Errorf("failed to open backup file: %w", err)
+	}
+	defer f.Close()
+
+	statements, err := dbdriver.SplitStatements(f, dbType)
+	if err != nil {
+		return fmt.Errorf("failed to parse backup: %w", err)
+	}
+
+	fmt.Printf("Restoring database for tenant '%s' (%d statements)...\n", tenant, len(statements))
+	if err := dbdriver.Restore(ctx, db, statements, opts, printRestoreProgress); err != nil {
+		return fmt.Errorf("restore failed: %w", err)
+	}
+
+	fmt.Println("Database restored successfully!")
+	return nil
+}
+
+// restoreCustomFormat restores a Postgres custom-format dump via
+// pg_restore, streaming it from stdin instead of passing it as a
+// positional argument. Note --parallel-jobs/-j only parallelizes a real
+// pg_restore archive or directory; piped through stdin, pg_restore still
+// applies it serially.
+func restoreCustomFormat(ctx context.Context, tenant string, creds *DatabaseCredentials, input string, opts dbdriver.Options) error {
+	f, err := os.Open(input)
+	if err != nil {
+		return fmt.Errorf("failed to open backup file: %w", err)
+	}
+	defer f.Close()
+
+	args := []string{
+		"-h", creds.Host,
+		"-p", fmt.Sprintf("%d", creds.Port),
+		"-U", creds.Username,
+		"-d", creds.Database,
+		"-c", // Clean database before restore
+	}
+	if opts.SingleTransaction {
+		args = append(args, "--single-transaction")
+	}
+	if opts.StopOnError {
+		args = append(args, "--exit-on-error")
+	}
+	if opts.ParallelJobs > 1 {
+		args = append(args, "-j", strconv.Itoa(opts.ParallelJobs))
+	}
+
+	restorer := exec.CommandContext(ctx, "pg_restore", args...)
+	restorer.Stdin = f
+	restorer.Stdout = os.Stdout
+	restorer.Stderr = os.Stderr
+
+	fmt.Printf("Restoring database for tenant '%s'...\n", tenant)
+	if err := restorer.Run(); err != nil {
+		return fmt.Errorf("restore failed: %w", err)
+	}
+
+	fmt.Println("Database restored successfully!")
+	return nil
+}
+
+// restoreFromBlob streams a backup straight from object storage, reversing
+// dumpToBlob's gzip+AES-GCM pipeline and applying it through the same
+// dbdriver path as restoreFromFile, without ever writing it to local disk.
+// Custom-format (pg_restore -Fc) backups aren't supported here, since
+// dumpToBlob only ever produces plain SQL text to keep the streaming
+// pipeline simple.
+func restoreFromBlob(ctx context.Context, cfg *config.Config, tenant, dbType, input string, opts dbdriver.Options) error {
+	bucketURL, objectKey, err := splitBlobURL(input)
+	if err != nil {
+		return err
+	}
+	bucket, err := blob.OpenBucket(ctx, bucketURL)
+	if err != nil {
+		return fmt.Errorf("failed to open bucket %q: %w", bucketURL, err)
+	}
+	defer bucket.Close()
+
+	reader, err := bucket.NewReader(ctx, objectKey, nil)
+	if err != nil {
+		return fmt.Errorf("failed to open %q: %w", objectKey, err)
+	}
+	defer reader.Close()
+
+	var src io.Reader = reader
+	if strings.HasSuffix(objectKey, ".enc") {
+		key, ok, err := resolveEncryptionKey(ctx, cfg, tenant)
+		if err != nil {
+			return fmt.Errorf("failed to resolve encryption key: %w", err)
+		}
+		if !ok {
+			return fmt.Errorf("backup %q is encrypted but tenant '%s' has no EncryptionKeyRef configured", objectKey, tenant)
+		}
+		gr, err := newGCMReader(src, key)
+		if err != nil {
+			return err
+		}
+		src = gr
+	}
+
+	gz, err := gzip.NewReader(src)
+	if err != nil {
+		return fmt.Errorf("failed to decompress backup: %w", err)
+	}
+	defer gz.Close()
+
+	db, _, err := getDatabaseConnection(ctx, cfg, tenant)
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+	defer db.Close()
+
+	statements, err := dbdriver.SplitStatements(gz, dbType)
+	if err != nil {
+		return fmt.Errorf("failed to parse backup: %w", err)
+	}
+
+	fmt.Printf("Restoring database for tenant '%s' from %s (%d statements)...\n", tenant, input, len(statements))
+	if err := dbdriver.Restore(ctx, db, statements, opts, printRestoreProgress); err != nil {
+		return fmt.Errorf("restore failed: %w", err)
+	}
+
+	fmt.Println("Database restored successfully!")
+	return nil
+}
+
+// printRestoreProgress is the ProgressFunc dbdriver.Restore reports
+// through for both restoreFromFile and restoreFromBlob, printing
+// "n/total statements, x MB/s" in place on a single line.
+func printRestoreProgress(p dbdriver.Progress) {
+	var mbPerSec float64
+	if p.Elapsed > 0 {
+		mbPerSec = (float64(p.BytesDone) / (1024 * 1024)) / p.Elapsed.Seconds()
+	}
+	fmt.Printf("\r%d/%d statements, %.1f MB/s", p.Index, p.Total, mbPerSec)
+	if p.Index == p.Total {
+		fmt.Println()
+	}
+}
+
+// newDBScheduleBackupCmd emits a Kubernetes CronJob manifest that runs this
+// CLI's own `db dump` on a schedule, for clusters that would rather run
+// backups as a plain CronJob than adopt the operator's BackupSchedule CRD.
+func newDBScheduleBackupCmd(cfg *config.Config) *cobra.Command {
+	var (
+		tenant    string
+		schedule  string
+		output    string
+		retention int
+		image     string
+		file      string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "schedule-backup",
+		Short: "Emit a CronJob manifest that runs 'db dump' on a schedule",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !isBlobURL(output) {
+				return fmt.Errorf("--output must be an s3://, gs:// or azblob:// URL")
+			}
+
+			dumpArgs := []string{"db", "dump", "--tenant", tenant, "--output", output}
+			if retention > 0 {
+				dumpArgs = append(dumpArgs, "--retention", strconv.Itoa(retention))
+			}
+
+			job := batchv1.CronJob{
+				TypeMeta: metav1.TypeMeta{APIVersion: "batch/v1", Kind: "CronJob"},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      fmt.Sprintf("%s-db-backup", tenant),
+					Namespace: cfg.Namespace,
+				},
+				Spec: batchv1.CronJobSpec{
+					Schedule: schedule,
+					JobTemplate: batchv1.JobTemplateSpec{
+						Spec: batchv1.JobSpec{
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									RestartPolicy: corev1.RestartPolicyOnFailure,
+									Containers: []corev1.Container{
+										{
+											Name:  "db-dump",
+											Image: image,
+											Args:  dumpArgs,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+
+			out, err := yaml.Marshal(job)
+			if err != nil {
+				return fmt.Errorf("failed to render manifest: %w", err)
+			}
+			if file == "" {
+				fmt.Print(string(out))
+				return nil
+			}
+			if err := os.WriteFile(file, out, 0644); err != nil {
+				return fmt.Errorf("failed to write %q: %w", file, err)
+			}
+			fmt.Printf("Wrote CronJob manifest to %s\n", file)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&tenant, "tenant", "t", "", "Tenant name (required)")
+	cmd.Flags().StringVar(&schedule, "schedule", "0 3 * * *", "Cron expression governing how often the backup runs")
+	cmd.Flags().StringVarP(&output, "output", "o", "", "s3://, gs:// or azblob:// URL 'db dump' writes backups to (required)")
+	cmd.Flags().IntVar(&retention, "retention", 7, "Passed through as 'db dump --retention'")
+	cmd.Flags().StringVar(&image, "image", "ghcr.io/rezenkai/multi-saas-crm-cli:latest", "Container image running this CLI")
+	cmd.Flags().StringVarP(&file, "file", "f", "", "Write the manifest to this path instead of stdout")
+
+	cmd.MarkFlagRequired("tenant")
+	cmd.MarkFlagRequired("output")
+
+	return cmd
+}
+
+func newDBCheckCmd(cfg *config.Config) *cobra.Command {
+	var tenant string
+
+	cmd := &cobra.Command{
+		Use:   "check",
+		Short: "Verify migrations match the live schema",
+		Long: "Applies all migrations to a scratch schema (Postgres) or scratch database (MySQL) and diffs " +
+			"the result against the tenant's live schema, failing loudly on drift (missing tables, changed " +
+			"column types, missing indexes/constraints). Also rolls each migration back and re-applies it, " +
+			"verifying its down followed by its up reproduces the schema recorded before the rollback. The " +
+			"reverse order (up followed by down returning to the schema from before the up) is not checked.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+
+			creds, dbType, err := getDatabaseCredentials(ctx, cfg, tenant)
+			if err != nil {
+				return fmt.Errorf("failed to get database credentials: %w", err)
+			}
+
+			liveDB, err := openDSN(dbType, creds, creds.Database)
+			if err != nil {
+				return fmt.Errorf("failed to connect to database: %w", err)
+			}
+			defer liveDB.Close()
+
+			fmt.Printf("Checking migrations for tenant '%s'...\n", tenant)
+
+			scratchName := fmt.Sprintf("migcheck_%d", time.Now().UnixNano())
+			if err := createScratch(ctx, liveDB, dbType, scratchName); err != nil {
+				return fmt.Errorf("failed to create scratch %s: %w", scratchKind(dbType), err)
+			}
+			defer func() {
+				if err := dropScratch(context.Background(), liveDB, dbType, scratchName); err != nil {
+					fmt.Printf("warning: failed to clean up scratch %s %q: %v\n", scratchKind(dbType), scratchName, err)
+				}
+			}()
+
+			scratchDB, driver, err := scratchMigrationDriver(dbType, creds, scratchName)
+			if err != nil {
+				return fmt.Errorf("failed to connect to scratch %s: %w", scratchKind(dbType), err)
+			}
+			defer scratchDB.Close()
+
+			src, err := migrationSource(dbType)
+			if err != nil {
+				return fmt.Errorf("failed to load migrations: %w", err)
+			}
+			m, err := migrate.NewWithInstance("iofs", src, dbType, driver)
+			if err != nil {
+				return fmt.Errorf("failed to create migrator: %w", err)
+			}
+			defer m.Close()
+
+			if err := checkReversibility(ctx, scratchDB, dbType, scratchName, m); err != nil {
+				return fmt.Errorf("reversibility check failed: %w", err)
+			}
+			fmt.Println("Reversibility check passed: every migration's down followed by up restores the schema from before the rollback")
+
+			scratchSnapshot, err := snapshotSchema(ctx, scratchDB, dbType, scratchName)
+			if err != nil {
+				return fmt.Errorf("failed to snapshot scratch schema: %w", err)
+			}
+			liveSnapshot, err := snapshotSchema(ctx, liveDB, dbType, liveSchemaName(dbType, creds))
+			if err != nil {
+				return fmt.Errorf("failed to snapshot live schema: %w", err)
+			}
+
+			mismatches := diffSnapshots(scratchSnapshot, liveSnapshot)
+			if len(mismatches) == 0 {
+				fmt.Println("No schema drift detected: live schema matches migrations")
+				return nil
+			}
+
+			fmt.Printf("Schema drift detected (%d mismatch(es)):\n", len(mismatches))
+			for _, mismatch := range mismatches {
+				fmt.Printf("  - %s\n", mismatch)
+			}
+			return fmt.Errorf("tenant '%s' schema has drifted from migrations", tenant)
+		},
+	}
+
+	cmd.Flags().StringVarP(&tenant, "tenant", "t", "", "Tenant name (required)")
+	cmd.MarkFlagRequired("tenant")
+
+	return cmd
+}
+
+// migrationHeaderTemplate is the template newDBMakeMigrationCmd writes into
+// every migration file it scaffolds; listMigrations parses the Description
+// line back out of it to annotate "db migrate --list" output.
+const migrationHeaderTemplate = `-- Description: %s
+-- Author: %s
+-- Created: %s
+
+`
+
+func newDBMakeMigrationCmd() *cobra.Command {
+	var dbType string
+
+	cmd := &cobra.Command{
+		Use:   "makemigration <name> <description>",
+		Short: "Scaffold a new migration",
+		Long:  "Scaffolds a timestamped up/down migration file pair with a header comment, ready to fill in and embed into the next CLI build.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name, description := args[0], args[1]
+
+			author := gitConfigAuthor()
+			version := time.Now().UTC().Format("20060102150405")
+			slug := slugify(name)
+			header := fmt.Sprintf(migrationHeaderTemplate, description, author, time.Now().UTC().Format(time.RFC3339))
+
+			dir := filepath.Join("migrations", dbType)
+			if err := os.MkdirAll(dir, 0o755); err != nil {
+				return fmt.Errorf("failed to create migrations directory %s: %w", dir, err)
+			}
+
+			upPath := filepath.Join(dir, fmt.Sprintf("%s_%s.up.sql", version, slug))
+			downPath := filepath.Join(dir, fmt.Sprintf("%s_%s.down.sql", version, slug))
+
+			if err := os.WriteFile(upPath, []byte(header), 0o644); err != nil {
+				return fmt.Errorf("failed to write %s: %w", upPath, err)
+			}
+			if err := os.WriteFile(downPath, []byte(header), 0o644); err != nil {
+				return fmt.Errorf("failed to write %s: %w", downPath, err)
+			}
+
+			fmt.Printf("Created migration %s_%s:\n  %s\n  %s\n", version, slug, upPath, downPath)
+			fmt.Println("Rebuild the CLI so the new migration is picked up by the embedded migration source.")
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&dbType, "type", "postgres", "Database type the migration targets (postgres, mysql)")
+
+	return cmd
+}
+
+// gitConfigAuthor returns "Name <email>" from the local git config, falling
+// back to "unknown" when git isn't available or no author is configured.
+func gitConfigAuthor() string {
+	name, nameErr := exec.Command("git", "config", "user.name").Output()
+	email, emailErr := exec.Command("git", "config", "user.email").Output()
+	if nameErr != nil || emailErr != nil {
+		return "unknown"
+	}
+	return fmt.Sprintf("%s <%s>", strings.TrimSpace(string(name)), strings.TrimSpace(string(email)))
+}
+
+// slugify lower-cases name and replaces any run of non alphanumeric
+// characters with a single underscore, for use in a migration filename.
+func slugify(name string) string {
+	var b strings.Builder
+	lastUnderscore := false
+	for _, r := range strings.ToLower(name) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			b.WriteRune(r)
+			lastUnderscore = false
+		case !lastUnderscore:
+			b.WriteRune('_')
+			lastUnderscore = true
+		}
+	}
+	return strings.Trim(b.String(), "_")
+}
+
+func newDBRepairCmd(cfg *config.Config) *cobra.Command {
+	var (
+		tenant           string
+		strategy         string
+		yes              bool
+		iKnowWhatImDoing bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "repair",
+		Short: "Recover a tenant's database from a dirty migration state",
+		Long: "Inspects schema_migrations.dirty and, given --strategy=force-version=<N> or " +
+			"--strategy=rollback-and-retry, either forces the version or runs the failed migration's down " +
+			"script, clears the dirty flag, and retries its up. Requires --yes, and, for an enterprise-tier " +
+			"tenant, --i-know-what-im-doing as well.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+
+			if !yes {
+				return fmt.Errorf("refusing to repair without --yes")
+			}
+
+			kubeClient, err := kube.NewClient(cfg)
+			if err != nil {
+				return fmt.Errorf("failed to create kubernetes client: %w", err)
+			}
+			t := &tenantv1alpha1.Tenant{}
+			if err := kubeClient.Get(ctx, types.NamespacedName{Name: tenant, Namespace: cfg.Namespace}, t); err != nil {
+				return fmt.Errorf("failed to get tenant: %w", err)
+			}
+			if t.Spec.Tier == "enterprise" && !iKnowWhatImDoing {
+				return fmt.Errorf("refusing to repair enterprise-tier tenant %q without --i-know-what-im-doing", tenant)
+			}
+
+			db, dbType, err := getDatabaseConnection(ctx, cfg, tenant)
+			if err != nil {
+				return fmt.Errorf("failed to connect to database: %w", err)
+			}
+			defer db.Close()
+
+			var driver migrate.Driver
+			switch dbType {
+			case "postgres":
+				driver, err = postgres.WithInstance(db, &postgres.Config{})
+			case "mysql":
+				driver, err = mysql.WithInstance(db, &mysql.Config{})
+			default:
+				return fmt.Errorf("unsupported database type: %s", dbType)
+			}
+			if err != nil {
+				return fmt.Errorf("failed to create migration driver: %w", err)
+			}
+
+			beforeVersion, _, err := driver.Version()
+			if err != nil {
+				return fmt.Errorf("failed to read migration version: %w", err)
+			}
+
+			if err := withMigrationLock(ctx, db, dbType, tenant, func() error {
+				return repairDirtyState(ctx, db, driver, dbType, strategy)
+			}); err != nil {
+				if cfg.Logger != nil {
+					cfg.Logger.Info("migration repair failed", "tenant.name", tenant, "strategy", strategy, "from.version", beforeVersion, "error", err.Error())
+				}
+				return err
+			}
+
+			afterVersion, _, _ := driver.Version()
+			if cfg.Logger != nil {
+				cfg.Logger.Info("repaired dirty migration state", "tenant.name", tenant, "strategy", strategy, "from.version", beforeVersion, "to.version", afterVersion)
+			}
+			fmt.Printf("Repaired tenant '%s': version %d -> %d\n", tenant, beforeVersion, afterVersion)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&tenant, "tenant", "t", "", "Tenant name (required)")
+	cmd.Flags().StringVar(&strategy, "strategy", "", "Repair strategy: force-version=<N> or rollback-and-retry (required)")
+	cmd.Flags().BoolVar(&yes, "yes", false, "Confirm running this non-interactively")
+	cmd.Flags().BoolVar(&iKnowWhatImDoing, "i-know-what-im-doing", false, "Required in addition to --yes for enterprise-tier tenants")
+	cmd.MarkFlagRequired("tenant")
+	cmd.MarkFlagRequired("strategy")
+
+	return cmd
+}
+
+// repairDirtyState inspects driver's current version/dirty state and applies
+// the chosen strategy: "force-version=<N>" trusts the operator's word that
+// version N is actually correct and just clears the dirty flag there;
+// "rollback-and-retry" runs the stuck migration's down script, clears the
+// dirty flag at the prior version, then retries its up.
+func repairDirtyState(ctx context.Context, db *sql.DB, driver migrate.Driver, dbType, strategy string) error {
+	version, dirty, err := driver.Version()
+	if err != nil {
+		return fmt.Errorf("failed to read migration version: %w", err)
+	}
+	if !dirty {
+		return fmt.Errorf("migration state is not dirty (version %d); nothing to repair", version)
+	}
+
+	switch {
+	case strings.HasPrefix(strategy, "force-version="):
+		raw := strings.TrimPrefix(strategy, "force-version=")
+		target, err := strconv.Atoi(raw)
+		if err != nil {
+			return fmt.Errorf("invalid --strategy force-version target %q: %w", raw, err)
+		}
+		return driver.SetVersion(target, false)
+
+	case strategy == "rollback-and-retry":
+		steps, err := mergeMigrationSteps(dbType)
+		if err != nil {
+			return err
+		}
+		var failed *mergedStep
+		prevVersion := database.NilVersion
+		for i := range steps {
+			if int(steps[i].ID) == version {
+				failed = &steps[i]
+				continue
+			}
+			if int(steps[i].ID) < version && int(steps[i].ID) > prevVersion {
+				prevVersion = int(steps[i].ID)
+			}
+		}
+		if failed == nil {
+			return fmt.Errorf("no migration found for dirty version %d", version)
+		}
+
+		if err := failed.runDown(ctx, db, driver); err != nil {
+			return fmt.Errorf("failed to roll back migration %d: %w", failed.ID, err)
+		}
+		if err := driver.SetVersion(prevVersion, false); err != nil {
+			return fmt.Errorf("failed to clear dirty flag at version %d: %w", prevVersion, err)
+		}
+		if err := failed.runUp(ctx, db, driver); err != nil {
+			driver.SetVersion(int(failed.ID), true)
+			return fmt.Errorf("retry of migration %d failed again: %w", failed.ID, err)
+		}
+		return driver.SetVersion(int(failed.ID), false)
 
-			fmt.Printf("Creating backup for tenant '%s'...\n", tenant)
-			if err := utils.ExecuteCommand(dumpCmd[0], dumpCmd[1:]...); err != nil {
-				return fmt.Errorf("backup failed: %w", err)
-			}
+	default:
+		return fmt.Errorf("unknown --strategy %q (expected force-version=<N> or rollback-and-retry)", strategy)
+	}
+}
+
+func newDBMigrateAllCmd(cfg *config.Config) *cobra.Command {
+	var (
+		tier        string
+		parallelism int
+		timeout     time.Duration
+		failFast    bool
+		dryRun      bool
+	)
 
-			fileInfo, err := os.Stat(output)
+	cmd := &cobra.Command{
+		Use:   "migrate-all",
+		Short: "Run database migrations across every tenant",
+		Long: "Discovers every Tenant in the configured namespace (optionally filtered by --tier) and runs " +
+			"migrations against each one concurrently, streaming per-tenant progress and aggregating " +
+			"failures into a single summary table instead of aborting the whole run on the first one.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+
+			names, err := listTenantNames(ctx, cfg, tier)
 			if err != nil {
-				return fmt.Errorf("failed to stat backup file: %w", err)
+				return fmt.Errorf("failed to list tenants: %w", err)
 			}
+			if len(names) == 0 {
+				fmt.Println("No tenants matched")
+				return nil
+			}
+
+			results := runFleetMigration(ctx, cfg, names, parallelism, timeout, failFast, dryRun)
 
-			fmt.Printf("Backup completed successfully!\n")
-			fmt.Printf("File: %s\n", output)
-			fmt.Printf("Size: %s\n", utils.FormatBytes(fileInfo.Size()))
+			fmt.Println()
+			printFleetResults(results, dryRun)
 
+			if failed := countFailed(results); failed > 0 {
+				return fmt.Errorf("migration failed for %d of %d tenant(s)", failed, len(results))
+			}
 			return nil
 		},
 	}
 
-	cmd.Flags().StringVarP(&tenant, "tenant", "t", "", "Tenant name (required)")
-	cmd.Flags().StringVarP(&output, "output", "o", "", "Output file path")
-	cmd.Flags().StringVar(&format, "format", "plain", "Backup format (plain, custom)")
-
-	cmd.MarkFlagRequired("tenant")
+	cmd.Flags().StringVar(&tier, "tier", "", "Only migrate tenants whose Spec.Tier matches (enterprise, starter, ...)")
+	cmd.Flags().IntVar(&parallelism, "parallelism", 5, "Number of tenants to migrate concurrently")
+	cmd.Flags().DurationVar(&timeout, "timeout", 5*time.Minute, "Per-tenant migration timeout")
+	cmd.Flags().BoolVar(&failFast, "fail-fast", false, "Stop starting new tenants as soon as one fails")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Only report which migrations would run per tenant")
 
 	return cmd
 }
 
-func newDBRestoreCmd(cfg *config.Config) *cobra.Command {
-	var (
-		tenant string
-		input  string
-		force  bool
-	)
+// listTenantNames returns the name of every Tenant in cfg.Namespace, filtered
+// to those whose Spec.Tier equals tier when tier is non-empty.
+func listTenantNames(ctx context.Context, cfg *config.Config, tier string) ([]string, error) {
+	c, err := kube.NewClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+	tenants := &tenantv1alpha1.TenantList{}
+	if err := c.List(ctx, tenants, client.InNamespace(cfg.Namespace)); err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(tenants.Items))
+	for _, t := range tenants.Items {
+		if tier != "" && t.Spec.Tier != tier {
+			continue
+		}
+		names = append(names, t.Name)
+	}
+	return names, nil
+}
 
-	cmd := &cobra.Command{
-		Use:   "restore",
-		Short: "Restore database from backup",
-		RunE: func(cmd *cobra.Command, args []string) error {
-			if !force {
-				fmt.Printf("WARNING: This will restore database for tenant '%s' from backup. All current data will be lost. Continue? [y/N]: ", tenant)
-				var response string
-				fmt.Scanln(&response)
-				if strings.ToLower(response) != "y" {
-					fmt.Println("Restore cancelled")
-					return nil
+// fleetResult is one tenant's outcome from a migrate-all run.
+type fleetResult struct {
+	Tenant          string
+	PreviousVersion int
+	NewVersion      int
+	Status          string
+	Err             error
+}
+
+// indexedFleetResult threads a fleetResult back through the worker pool
+// alongside the position of its tenant in the original name list, so
+// runFleetMigration can return results in input order despite tenants
+// finishing in whatever order their workers complete.
+type indexedFleetResult struct {
+	index  int
+	result fleetResult
+}
+
+// runFleetMigration fans tenant migrations out across parallelism workers,
+// streaming each tenant's result to stdout as it completes and, unless
+// failFast is set, letting every tenant run to completion regardless of
+// earlier failures.
+func runFleetMigration(ctx context.Context, cfg *config.Config, names []string, parallelism int, timeout time.Duration, failFast, dryRun bool) []fleetResult {
+	if parallelism < 1 {
+		parallelism = 1
+	}
+
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	jobs := make(chan int)
+	resultsCh := make(chan indexedFleetResult)
+
+	var wg sync.WaitGroup
+	for i := 0; i < parallelism; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for idx := range jobs {
+				name := names[idx]
+				r := migrateOneTenant(runCtx, cfg, name, timeout, dryRun)
+				if r.Err != nil && failFast {
+					cancel()
 				}
+				resultsCh <- indexedFleetResult{index: idx, result: r}
 			}
+		}()
+	}
 
-			ctx := context.Background()
+	go func() {
+		defer close(jobs)
+		for i := range names {
+			select {
+			case jobs <- i:
+			case <-runCtx.Done():
+				return
+			}
+		}
+	}()
+
+	go func() {
+		wg.Wait()
+		close(resultsCh)
+	}()
+
+	results := make([]fleetResult, len(names))
+	seen := make([]bool, len(names))
+	for entry := range resultsCh {
+		results[entry.index] = entry.result
+		seen[entry.index] = true
+		printFleetProgress(entry.result, dryRun)
+	}
+	for i, ok := range seen {
+		if !ok {
+			results[i] = fleetResult{Tenant: names[i], Status: "skipped", Err: fmt.Errorf("not started: fail-fast triggered by another tenant")}
+		}
+	}
+	return results
+}
 
-			// Check if backup file exists
-			if _, err := os.Stat(input); err != nil {
-				return fmt.Errorf("backup file not found: %w", err)
+// migrateOneTenant connects to a single tenant's database and either
+// applies pending migrations or, under dryRun, just counts them.
+func migrateOneTenant(ctx context.Context, cfg *config.Config, tenant string, timeout time.Duration, dryRun bool) fleetResult {
+	tenantCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	db, dbType, err := getDatabaseConnection(tenantCtx, cfg, tenant)
+	if err != nil {
+		return fleetResult{Tenant: tenant, Status: "error", Err: fmt.Errorf("failed to connect: %w", err)}
+	}
+	defer db.Close()
+
+	var driver migrate.Driver
+	switch dbType {
+	case "postgres":
+		driver, err = postgres.WithInstance(db, &postgres.Config{})
+	case "mysql":
+		driver, err = mysql.WithInstance(db, &mysql.Config{})
+	default:
+		return fleetResult{Tenant: tenant, Status: "error", Err: fmt.Errorf("unsupported database type: %s", dbType)}
+	}
+	if err != nil {
+		return fleetResult{Tenant: tenant, Status: "error", Err: fmt.Errorf("failed to create migration driver: %w", err)}
+	}
+
+	previousVersion, _, err := driver.Version()
+	if err != nil {
+		return fleetResult{Tenant: tenant, Status: "error", Err: fmt.Errorf("failed to read current version: %w", err)}
+	}
+
+	if dryRun {
+		steps, err := mergeMigrationSteps(dbType)
+		if err != nil {
+			return fleetResult{Tenant: tenant, PreviousVersion: previousVersion, Status: "error", Err: err}
+		}
+		pending := 0
+		for _, step := range steps {
+			if int(step.ID) > previousVersion {
+				pending++
 			}
+		}
+		status := fmt.Sprintf("%d pending", pending)
+		if pending == 0 {
+			status = "up to date"
+		}
+		return fleetResult{Tenant: tenant, PreviousVersion: previousVersion, NewVersion: previousVersion, Status: status}
+	}
 
-			// Get database credentials
-			creds, dbType, err := getDatabaseCredentials(ctx, cfg, tenant)
-			if err != nil {
-				return fmt.Errorf("failed to get database credentials: %w", err)
+	applyErr := withMigrationLock(tenantCtx, db, dbType, tenant, func() error {
+		return applyMergedMigrations(tenantCtx, db, driver, dbType, "up")
+	})
+	if applyErr != nil && applyErr != migrate.ErrNoChange {
+		return fleetResult{Tenant: tenant, PreviousVersion: previousVersion, Status: "error", Err: applyErr}
+	}
+
+	newVersion, _, err := driver.Version()
+	if err != nil {
+		return fleetResult{Tenant: tenant, PreviousVersion: previousVersion, Status: "error", Err: fmt.Errorf("failed to read new version: %w", err)}
+	}
+
+	status := "migrated"
+	if applyErr == migrate.ErrNoChange {
+		status = "up to date"
+	}
+	return fleetResult{Tenant: tenant, PreviousVersion: previousVersion, NewVersion: newVersion, Status: status}
+}
+
+// printFleetProgress streams one tenant's outcome as soon as it's known.
+func printFleetProgress(r fleetResult, dryRun bool) {
+	if r.Err != nil {
+		fmt.Printf("[%s] failed: %v\n", r.Tenant, r.Err)
+		return
+	}
+	if dryRun {
+		fmt.Printf("[%s] %s\n", r.Tenant, r.Status)
+		return
+	}
+	fmt.Printf("[%s] %s (v%d -> v%d)\n", r.Tenant, r.Status, r.PreviousVersion, r.NewVersion)
+}
+
+// printFleetResults prints the final tenant -> previous version -> new
+// version -> status summary table for a migrate-all run.
+func printFleetResults(results []fleetResult, dryRun bool) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "TENANT\tPREVIOUS\tNEW\tSTATUS")
+	for _, r := range results {
+		status := r.Status
+		if r.Err != nil {
+			status = fmt.Sprintf("error: %v", r.Err)
+		}
+		newVersion := fmt.Sprintf("%d", r.NewVersion)
+		if dryRun {
+			newVersion = "-"
+		}
+		fmt.Fprintf(w, "%s\t%d\t%s\t%s\n", r.Tenant, r.PreviousVersion, newVersion, status)
+	}
+	w.Flush()
+}
+
+func countFailed(results []fleetResult) int {
+	n := 0
+	for _, r := range results {
+		if r.Err != nil {
+			n++
+		}
+	}
+	return n
+}
+
+// checkReversibility applies all migrations to scratchDB, then steps back down
+// one migration at a time (recording a schema snapshot before each step) and
+// replays them back up, failing if any migration's up doesn't restore the
+// snapshot recorded before it was rolled back. This catches a migration whose
+// down doesn't actually undo its up (e.g. a dropped column an up doesn't
+// recreate with the right type).
+func checkReversibility(ctx context.Context, scratchDB *sql.DB, dbType, schemaName string, m *migrate.Migrate) error {
+	if err := m.Up(); err != nil && err != migrate.ErrNoChange {
+		return fmt.Errorf("applying migrations failed: %w", err)
+	}
+
+	var versions []uint
+	snapshots := map[uint]*schemaSnapshot{}
+	for {
+		version, _, err := m.Version()
+		if err == migrate.ErrNilVersion {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("reading migration version failed: %w", err)
+		}
+
+		snap, err := snapshotSchema(ctx, scratchDB, dbType, schemaName)
+		if err != nil {
+			return err
+		}
+		snapshots[version] = snap
+		versions = append(versions, version)
+
+		if err := m.Steps(-1); err != nil {
+			if err == migrate.ErrNoChange {
+				break
 			}
+			return fmt.Errorf("rolling back version %d failed: %w", version, err)
+		}
+	}
 
-			// Build restore command
-			var restoreCmd []string
-			switch dbType {
-			case "postgres":
-				// Check if it's a custom format backup
-				isCustomFormat := false
-				// Simple check - could be improved
-				if strings.HasSuffix(input, ".dump") {
-					isCustomFormat = true
-				}
+	for i := len(versions) - 1; i >= 0; i-- {
+		version := versions[i]
+		if err := m.Steps(1); err != nil && err != migrate.ErrNoChange {
+			return fmt.Errorf("re-applying version %d failed: %w", version, err)
+		}
+		snap, err := snapshotSchema(ctx, scratchDB, dbType, schemaName)
+		if err != nil {
+			return err
+		}
+		if diff := diffSnapshots(snapshots[version], snap); len(diff) > 0 {
+			return fmt.Errorf("version %d's up does not undo its own down: %s", version, strings.Join(diff, "; "))
+		}
+	}
 
-				if isCustomFormat {
-					restoreCmd = []string{
-						"pg_restore",
-						"-h", creds.Host,
-						"-p", fmt.Sprintf("%d", creds.Port),
-						"-U", creds.Username,
-						"-d", creds.Database,
-						"-c", // Clean database before restore
-						input,
-					}
-				} else {
-					restoreCmd = []string{
-						"psql",
-						"-h", creds.Host,
-						"-p", fmt.Sprintf("%d", creds.Port),
-						"-U", creds.Username,
-						"-d", creds.Database,
-						"-f", input,
-					}
-				}
-			case "mysql":
-				restoreCmd = []string{
-					"mysql",
-					"-h", creds.Host,
-					"-P", fmt.Sprintf("%d", creds.Port),
-					"-u", creds.Username,
-					fmt.Sprintf("-p%s", creds.Password),
-					creds.Database,
-				}
-				// For MySQL, we need to pipe the file
-				// This would be handled differently in ExecuteCommand
-			default:
-				return fmt.Errorf("unsupported database type: %s", dbType)
+	return nil
+}
+
+// schemaSnapshot is a point-in-time view of a schema's tables, columns, and
+// the indexes/constraints attached to them, used to diff a scratch schema
+// (freshly built from migrations) against a tenant's live schema.
+type schemaSnapshot struct {
+	Columns     map[string]map[string]string
+	Indexes     map[string]map[string]bool
+	Constraints map[string]map[string]bool
+}
+
+func snapshotSchema(ctx context.Context, db *sql.DB, dbType, schemaName string) (*schemaSnapshot, error) {
+	columnsQuery := "SELECT table_name, column_name, data_type FROM information_schema.columns WHERE table_schema = $1"
+	indexQuery := "SELECT tablename, indexname FROM pg_indexes WHERE schemaname = $1"
+	constraintQuery := "SELECT table_name, constraint_name FROM information_schema.table_constraints WHERE table_schema = $1"
+	if dbType == "mysql" {
+		columnsQuery = "SELECT table_name, column_name, data_type FROM information_schema.columns WHERE table_schema = ?"
+		indexQuery = "SELECT table_name, index_name FROM information_schema.statistics WHERE table_schema = ?"
+		constraintQuery = "SELECT table_name, constraint_name FROM information_schema.table_constraints WHERE table_schema = ?"
+	}
+
+	snap := &schemaSnapshot{
+		Columns:     map[string]map[string]string{},
+		Indexes:     map[string]map[string]bool{},
+		Constraints: map[string]map[string]bool{},
+	}
+
+	rows, err := db.QueryContext(ctx, columnsQuery, schemaName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read columns: %w", err)
+	}
+	for rows.Next() {
+		var table, column, dataType string
+		if err := rows.Scan(&table, &column, &dataType); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		if snap.Columns[table] == nil {
+			snap.Columns[table] = map[string]string{}
+		}
+		snap.Columns[table][column] = dataType
+	}
+	if err := rows.Err(); err != nil {
+		rows.Close()
+		return nil, err
+	}
+	rows.Close()
+
+	rows, err = db.QueryContext(ctx, indexQuery, schemaName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read indexes: %w", err)
+	}
+	for rows.Next() {
+		var table, index string
+		if err := rows.Scan(&table, &index); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		if snap.Indexes[table] == nil {
+			snap.Indexes[table] = map[string]bool{}
+		}
+		snap.Indexes[table][index] = true
+	}
+	if err := rows.Err(); err != nil {
+		rows.Close()
+		return nil, err
+	}
+	rows.Close()
+
+	rows, err = db.QueryContext(ctx, constraintQuery, schemaName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read constraints: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var table, constraint string
+		if err := rows.Scan(&table, &constraint); err != nil {
+			return nil, err
+		}
+		if snap.Constraints[table] == nil {
+			snap.Constraints[table] = map[string]bool{}
+		}
+		snap.Constraints[table][constraint] = true
+	}
+	return snap, rows.Err()
+}
+
+// diffSnapshots reports, one line per mismatch, what is in want (what the
+// migrations produce) but absent or different in got (the live schema), plus
+// any table present in got but absent from want.
+func diffSnapshots(want, got *schemaSnapshot) []string {
+	var mismatches []string
+
+	for table, columns := range want.Columns {
+		gotColumns, ok := got.Columns[table]
+		if !ok {
+			mismatches = append(mismatches, fmt.Sprintf("table %q is missing from the live schema", table))
+			continue
+		}
+		for column, dataType := range columns {
+			gotType, ok := gotColumns[column]
+			if !ok {
+				mismatches = append(mismatches, fmt.Sprintf("table %q is missing column %q", table, column))
+			} else if gotType != dataType {
+				mismatches = append(mismatches, fmt.Sprintf("table %q column %q has type %q, migrations expect %q", table, column, gotType, dataType))
 			}
+		}
+	}
+	for table := range got.Columns {
+		if _, ok := want.Columns[table]; !ok {
+			mismatches = append(mismatches, fmt.Sprintf("table %q exists live but isn't produced by any migration", table))
+		}
+	}
 
-			fmt.Printf("Restoring database for tenant '%s'...\n", tenant)
-			if err := utils.ExecuteCommand(restoreCmd[0], restoreCmd[1:]...); err != nil {
-				return fmt.Errorf("restore failed: %w", err)
+	for table, indexes := range want.Indexes {
+		for index := range indexes {
+			if !got.Indexes[table][index] {
+				mismatches = append(mismatches, fmt.Sprintf("table %q is missing index %q", table, index))
 			}
+		}
+	}
 
-			fmt.Println("Database restored successfully!")
-			return nil
-		},
+	for table, constraints := range want.Constraints {
+		for constraint := range constraints {
+			if !got.Constraints[table][constraint] {
+				mismatches = append(mismatches, fmt.Sprintf("table %q is missing constraint %q", table, constraint))
+			}
+		}
 	}
 
-	cmd.Flags().StringVarP(&tenant, "tenant", "t", "", "Tenant name (required)")
-	cmd.Flags().StringVarP(&input, "input", "i", "", "Backup file path (required)")
-	cmd.Flags().BoolVar(&force, "force", false, "Skip confirmation")
+	sort.Strings(mismatches)
+	return mismatches
+}
 
-	cmd.MarkFlagRequired("tenant")
-	cmd.MarkFlagRequired("input")
+// openDSN connects to database (a database name for MySQL, or the tenant's
+// database for Postgres since scratch there is a schema, not a database) and
+// pings it before returning.
+func openDSN(dbType string, creds *DatabaseCredentials, database string) (*sql.DB, error) {
+	var dsn string
+	switch dbType {
+	case "postgres":
+		dsn = fmt.Sprintf("host=%s port=%d user=%s password=%s dbname=%s sslmode=disable",
+			creds.Host, creds.Port, creds.Username, creds.Password, database)
+	case "mysql":
+		dsn = fmt.Sprintf("%s:%s@tcp(%s:%d)/%s?parseTime=true",
+			creds.Username, creds.Password, creds.Host, creds.Port, database)
+	default:
+		return nil, fmt.Errorf("unsupported database type: %s", dbType)
+	}
 
-	return cmd
+	db, err := sql.Open(dbType, dsn)
+	if err != nil {
+		return nil, err
+	}
+	if err := db.Ping(); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return db, nil
+}
+
+// createScratch creates the throwaway schema/database migrations are applied
+// to, named name, which the caller generates to avoid colliding with a real one.
+func createScratch(ctx context.Context, liveDB *sql.DB, dbType, name string) error {
+	switch dbType {
+	case "postgres":
+		_, err := liveDB.ExecContext(ctx, fmt.Sprintf("CREATE SCHEMA %s", name))
+		return err
+	case "mysql":
+		_, err := liveDB.ExecContext(ctx, fmt.Sprintf("CREATE DATABASE `%s`", name))
+		return err
+	default:
+		return fmt.Errorf("unsupported database type: %s", dbType)
+	}
+}
+
+func dropScratch(ctx context.Context, liveDB *sql.DB, dbType, name string) error {
+	switch dbType {
+	case "postgres":
+		_, err := liveDB.ExecContext(ctx, fmt.Sprintf("DROP SCHEMA IF EXISTS %s CASCADE", name))
+		return err
+	case "mysql":
+		_, err := liveDB.ExecContext(ctx, fmt.Sprintf("DROP DATABASE IF EXISTS `%s`", name))
+		return err
+	default:
+		return fmt.Errorf("unsupported database type: %s", dbType)
+	}
+}
+
+func scratchKind(dbType string) string {
+	if dbType == "mysql" {
+		return "database"
+	}
+	return "schema"
+}
+
+// scratchMigrationDriver opens a connection scoped to the scratch schema
+// (Postgres) or scratch database (MySQL) and wraps it in the migrate.Driver
+// that database's migrations should run against.
+func scratchMigrationDriver(dbType string, creds *DatabaseCredentials, scratchName string) (*sql.DB, migrate.Driver, error) {
+	switch dbType {
+	case "postgres":
+		db, err := openDSN(dbType, creds, creds.Database)
+		if err != nil {
+			return nil, nil, err
+		}
+		driver, err := postgres.WithInstance(db, &postgres.Config{SchemaName: scratchName})
+		if err != nil {
+			db.Close()
+			return nil, nil, err
+		}
+		return db, driver, nil
+	case "mysql":
+		db, err := openDSN(dbType, creds, scratchName)
+		if err != nil {
+			return nil, nil, err
+		}
+		driver, err := mysql.WithInstance(db, &mysql.Config{DatabaseName: scratchName})
+		if err != nil {
+			db.Close()
+			return nil, nil, err
+		}
+		return db, driver, nil
+	default:
+		return nil, nil, fmt.Errorf("unsupported database type: %s", dbType)
+	}
+}
+
+// liveSchemaName is the schema snapshotSchema should read the tenant's live
+// schema from: its database name for MySQL (where "schema" and "database" are
+// the same concept), or Postgres's default "public" schema.
+func liveSchemaName(dbType string, creds *DatabaseCredentials) string {
+	if dbType == "mysql" {
+		return creds.Database
+	}
+	return "public"
 }
 
 // Helper functions
@@ -670,13 +2431,89 @@ func setupPortForward(ctx context.Context, cfg *config.Config, tenant, dbType st
 	return localPort, nil
 }
 
-func getMigrationsDir(dbType string) string {
-	// Return the path to migrations based on database type
-	baseDir := os.Getenv("MIGRATIONS_DIR")
-	if baseDir == "" {
-		baseDir = "./migrations"
+// migrationInfo describes one migration in the merged .sql + Go sequence
+// and whether it has already been applied to driver's database.
+type migrationInfo struct {
+	Version     uint
+	Kind        string
+	Description string
+	Applied     bool
+}
+
+// listMigrations merges the .sql and Go migrations registered for dbType
+// and marks each as applied or pending based on driver's current version.
+func listMigrations(dbType string, driver migrate.Driver) ([]migrationInfo, error) {
+	steps, err := mergeMigrationSteps(dbType)
+	if err != nil {
+		return nil, err
+	}
+	currentVersion, _, err := driver.Version()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get current version: %w", err)
+	}
+
+	infos := make([]migrationInfo, 0, len(steps))
+	for _, step := range steps {
+		kind := "sql"
+		if step.goMigration != nil {
+			kind = "go"
+		}
+		infos = append(infos, migrationInfo{
+			Version:     step.ID,
+			Kind:        kind,
+			Description: step.Description,
+			Applied:     int(step.ID) <= currentVersion,
+		})
+	}
+	return infos, nil
+}
+
+// printMigrations prints the result of listMigrations in the same
+// human-readable style as the rest of the db command family.
+func printMigrations(tenant, dbType string, infos []migrationInfo) {
+	fmt.Printf("Migrations for tenant '%s' (%s):\n", tenant, dbType)
+	for _, info := range infos {
+		status := "pending"
+		if info.Applied {
+			status = "applied"
+		}
+		fmt.Printf("  [%s][%s] %06d", status, info.Kind, info.Version)
+		if info.Description != "" {
+			fmt.Printf(" - %s", info.Description)
+		}
+		fmt.Println()
+	}
+}
+
+// parseMigrationFilename splits a golang-migrate "NNNNNN_name.up.sql" /
+// "NNNNNN_name.down.sql" filename into its version and name.
+func parseMigrationFilename(filename string) (uint, string, error) {
+	base := strings.TrimSuffix(strings.TrimSuffix(filename, ".up.sql"), ".down.sql")
+	parts := strings.SplitN(base, "_", 2)
+	if len(parts) != 2 {
+		return 0, "", fmt.Errorf("unrecognized migration filename %q", filename)
 	}
-	return filepath.Join(baseDir, dbType)
+	version, err := strconv.ParseUint(parts[0], 10, 64)
+	if err != nil {
+		return 0, "", fmt.Errorf("unrecognized migration filename %q: %w", filename, err)
+	}
+	return uint(version), parts[1], nil
+}
+
+// parseMigrationDescription extracts the "-- Description: ..." header line
+// that newDBMakeMigrationCmd writes into every migration it scaffolds.
+func parseMigrationDescription(content []byte) string {
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, "-- Description:") {
+			return strings.TrimSpace(strings.TrimPrefix(line, "-- Description:"))
+		}
+		if !strings.HasPrefix(line, "--") && line != "" {
+			break
+		}
+	}
+	return ""
 }
 
 func getSeedData(dataSet, dbType string) string {
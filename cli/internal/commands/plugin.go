@@ -0,0 +1,62 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/rezenkai/multi-saas-crm/tenant-orchestrator/cli/internal/config"
+	"github.com/rezenkai/multi-saas-crm/tenant-orchestrator/cli/internal/plugin"
+	"github.com/spf13/cobra"
+)
+
+// PluginPrefix is the executable prefix tenantctl looks for on PATH, e.g.
+// "tenantctl-foo-bar" for a plugin invoked as `tenantctl foo-bar`. It's
+// exported so main.go's plugin-dispatch handler and this package's `plugin
+// list` command scan for the same prefix.
+const PluginPrefix = "tenantctl"
+
+// NewPluginCmd creates the `plugin` command group for inspecting the
+// tenantctl-<name> executables discoverable on PATH.
+func NewPluginCmd(cfg *config.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "plugin",
+		Short: "Provides utilities for interacting with plugins",
+		Long:  "Commands for listing and inspecting tenantctl plugin executables discovered on PATH",
+	}
+	cmd.AddCommand(newPluginListCmd(cfg))
+	return cmd
+}
+
+func newPluginListCmd(cfg *config.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List all visible plugin executables on PATH",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			candidates, err := plugin.Scan(PluginPrefix)
+			if err != nil {
+				return fmt.Errorf("failed to scan PATH for plugins: %w", err)
+			}
+			if len(candidates) == 0 {
+				fmt.Println("error: unable to find any tenantctl plugins on the path")
+				return nil
+			}
+			fmt.Println("The following compatible plugins are available:")
+			warned := false
+			for _, c := range candidates {
+				fmt.Println(c.Path)
+				if !c.Executable {
+					fmt.Printf("  - warning: %s identified as a tenantctl plugin, but it is not executable\n", c.Path)
+					warned = true
+				}
+				if c.Shadowed {
+					fmt.Printf("  - warning: %s is shadowed by a similarly named plugin earlier on PATH\n", c.Path)
+					warned = true
+				}
+			}
+			if warned {
+				return fmt.Errorf("one or more plugin warnings were found")
+			}
+			return nil
+		},
+	}
+	return cmd
+}
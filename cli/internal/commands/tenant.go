@@ -3,6 +3,7 @@ package commands
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"strings"
@@ -11,59 +12,73 @@ import (
 
 	"github.com/docker/docker/client"
 	tenantv1alpha1 "github.com/rezenkai/multi-saas-crm/tenant-orchestrator/api/v1alpha1"
+	"github.com/rezenkai/multi-saas-crm/tenant-orchestrator/cli/internal/completion"
 	"github.com/rezenkai/multi-saas-crm/tenant-orchestrator/cli/internal/config"
+	"github.com/rezenkai/multi-saas-crm/tenant-orchestrator/cli/internal/i18n"
 	"github.com/rezenkai/multi-saas-crm/tenant-orchestrator/cli/internal/kube"
+	"github.com/rezenkai/multi-saas-crm/tenant-orchestrator/cli/internal/tracing"
 	"github.com/rezenkai/multi-saas-crm/tenant-orchestrator/cli/internal/utils"
 	"github.com/spf13/cobra"
+	"go.opentelemetry.io/otel/attribute"
 	"gopkg.in/yaml.v3"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
 )
 
 // NewTenantCmd creates the tenant management command
 func NewTenantCmd(cfg *config.Config) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "tenant",
-		Short: "Manage tenants",
-		Long:  "Commands for creating, updating, and managing multi-tenant deployments",
+		Short: i18n.T("tenant.cmd.short"),
+		Long:  i18n.T("tenant.cmd.long"),
 	}
 	cmd.AddCommand(
 		newTenantCreateCmd(cfg),
+		newTenantApplyCmd(cfg),
 		newTenantListCmd(cfg),
 		newTenantGetCmd(cfg),
 		newTenantUpdateCmd(cfg),
 		newTenantDeleteCmd(cfg),
 		newTenantScaleCmd(cfg),
 		newTenantUpgradeCmd(cfg),
+		newTenantClustersCmd(cfg),
 	)
 	return cmd
 }
 
 func newTenantCreateCmd(cfg *config.Config) *cobra.Command {
 	var (
-		file            string
-		organization    string
-		tier            string
-		domains         []string
-		services        []string
-		databaseType    string
-		databaseVersion string
-		cpuRequest      string
-		cpuLimit        string
-		memoryRequest   string
-		memoryLimit     string
-		storageSize     string
-		wait            bool
-		output          string
+		file              string
+		organization      string
+		tier              string
+		domains           []string
+		services          []string
+		databaseType      string
+		databaseVersion   string
+		cpuRequest        string
+		cpuLimit          string
+		memoryRequest     string
+		memoryLimit       string
+		storageSize       string
+		haReplicas        int32
+		haSyncReplicas    bool
+		haFailoverMode    string
+		haMinSyncReplicas int32
+		wait              bool
+		output            string
+		timeout           time.Duration
+		pollFallback      bool
 	)
 	cmd := &cobra.Command{
 		Use:   "create [NAME]",
-		Short: "Create a new tenant",
-		Long:  "Create a new tenant with specified configuration",
+		Short: i18n.T("tenant.create.short"),
+		Long:  i18n.T("tenant.create.long"),
 		Args:  cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx, span := tracing.StartCommandSpan(context.Background(), "create")
+			defer span.End()
 			client, err := kube.NewClient(cfg)
 			if err != nil {
 				return fmt.Errorf("failed to create kubernetes client: %w", err)
@@ -148,38 +163,58 @@ func newTenantCreateCmd(cfg *config.Config) *cobra.Command {
 						},
 					},
 				}
+				if haReplicas > 0 {
+					tenant.Spec.Database.HighAvailability = &tenantv1alpha1.HighAvailabilitySpec{
+						Replicas:               haReplicas,
+						SynchronousReplication: haSyncReplicas,
+						FailoverMode:           haFailoverMode,
+						MinSyncReplicas:        haMinSyncReplicas,
+					}
+				}
+			}
+			if traceID := tracing.TraceID(ctx); traceID != "" {
+				if tenant.Annotations == nil {
+					tenant.Annotations = map[string]string{}
+				}
+				tenant.Annotations["tenant.rezenkai.com/trace-id"] = traceID
 			}
 			// Create tenant
 			if err := client.Create(ctx, tenant); err != nil {
 				return fmt.Errorf("failed to create tenant: %w", err)
 			}
-			fmt.Printf("Tenant '%s' created successfully\n", tenant.Name)
+			cfg.Logger.Info(i18n.T("tenant.create.log.created"), "tenant.name", tenant.Name)
 			// Wait for tenant to be ready if requested
 			if wait {
-				fmt.Println("Waiting for tenant to be ready...")
-				if err := waitForTenant(ctx, client, tenant.Name, tenant.Namespace); err != nil {
+				cfg.Logger.Info(i18n.T("tenant.log.waiting-ready"), "tenant.name", tenant.Name)
+				if err := waitForTenant(ctx, client, tenant.Name, tenant.Namespace, timeout, pollFallback); err != nil {
 					return fmt.Errorf("error waiting for tenant: %w", err)
 				}
-				fmt.Println("Tenant is ready!")
+				cfg.Logger.Info(i18n.T("tenant.log.ready"), "tenant.name", tenant.Name)
 			}
 			// Output result
 			return outputTenant(tenant, output)
 		},
 	}
-	cmd.Flags().StringVarP(&file, "file", "f", "", "Path to tenant configuration file")
-	cmd.Flags().StringVar(&organization, "org", "", "Organization name")
-	cmd.Flags().StringVar(&tier, "tier", "standard", "Tenant tier (standard, premium)")
-	cmd.Flags().StringSliceVar(&domains, "domains", []string{}, "Custom domains for the tenant")
-	cmd.Flags().StringSliceVar(&services, "services", []string{}, "Services to deploy (format: name:version:replicas)")
-	cmd.Flags().StringVar(&databaseType, "db-type", "postgres", "Database type (postgres, mysql)")
-	cmd.Flags().StringVar(&databaseVersion, "db-version", "13", "Database version")
-	cmd.Flags().StringVar(&cpuRequest, "cpu-request", "500m", "CPU request")
-	cmd.Flags().StringVar(&cpuLimit, "cpu-limit", "1000m", "CPU limit")
-	cmd.Flags().StringVar(&memoryRequest, "memory-request", "512Mi", "Memory request")
-	cmd.Flags().StringVar(&memoryLimit, "memory-limit", "1Gi", "Memory limit")
-	cmd.Flags().StringVar(&storageSize, "storage", "10Gi", "Storage size")
-	cmd.Flags().BoolVar(&wait, "wait", false, "Wait for tenant to be ready")
-	cmd.Flags().StringVarP(&output, "output", "o", "", "Output format (json, yaml)")
+	cmd.Flags().StringVarP(&file, "file", "f", "", i18n.T("tenant.create.flag.file"))
+	cmd.Flags().StringVar(&organization, "org", "", i18n.T("tenant.create.flag.org"))
+	cmd.Flags().StringVar(&tier, "tier", "standard", i18n.T("tenant.create.flag.tier"))
+	cmd.Flags().StringSliceVar(&domains, "domains", []string{}, i18n.T("tenant.create.flag.domains"))
+	cmd.Flags().StringSliceVar(&services, "services", []string{}, i18n.T("tenant.create.flag.services"))
+	cmd.Flags().StringVar(&databaseType, "db-type", "postgres", i18n.T("tenant.create.flag.db-type"))
+	cmd.Flags().StringVar(&databaseVersion, "db-version", "13", i18n.T("tenant.create.flag.db-version"))
+	cmd.Flags().StringVar(&cpuRequest, "cpu-request", "500m", i18n.T("tenant.create.flag.cpu-request"))
+	cmd.Flags().StringVar(&cpuLimit, "cpu-limit", "1000m", i18n.T("tenant.create.flag.cpu-limit"))
+	cmd.Flags().StringVar(&memoryRequest, "memory-request", "512Mi", i18n.T("tenant.create.flag.memory-request"))
+	cmd.Flags().StringVar(&memoryLimit, "memory-limit", "1Gi", i18n.T("tenant.create.flag.memory-limit"))
+	cmd.Flags().StringVar(&storageSize, "storage", "10Gi", i18n.T("tenant.create.flag.storage"))
+	cmd.Flags().Int32Var(&haReplicas, "ha-replicas", 0, i18n.T("tenant.create.flag.ha-replicas"))
+	cmd.Flags().BoolVar(&haSyncReplicas, "ha-synchronous-replication", false, i18n.T("tenant.create.flag.ha-synchronous-replication"))
+	cmd.Flags().StringVar(&haFailoverMode, "ha-failover-mode", "automatic", i18n.T("tenant.create.flag.ha-failover-mode"))
+	cmd.Flags().Int32Var(&haMinSyncReplicas, "ha-min-sync-replicas", 1, i18n.T("tenant.create.flag.ha-min-sync-replicas"))
+	cmd.Flags().BoolVar(&wait, "wait", false, i18n.T("tenant.flag.wait.ready"))
+	cmd.Flags().StringVarP(&output, "output", "o", "", i18n.T("tenant.flag.output"))
+	cmd.Flags().DurationVar(&timeout, "timeout", 10*time.Minute, i18n.T("tenant.flag.timeout.ready"))
+	cmd.Flags().BoolVar(&pollFallback, "poll-fallback", false, i18n.T("tenant.flag.poll-fallback"))
 	return cmd
 }
 
@@ -191,7 +226,7 @@ func newTenantListCmd(cfg *config.Config) *cobra.Command {
 	)
 	cmd := &cobra.Command{
 		Use:     "list",
-		Short:   "List tenants",
+		Short:   i18n.T("tenant.list.short"),
 		Aliases: []string{"ls"},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := context.Background()
@@ -215,34 +250,46 @@ func newTenantListCmd(cfg *config.Config) *cobra.Command {
 			}
 			// Table output
 			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
-			fmt.Fprintln(w, "NAME\tORGANIZATION\tTIER\tPHASE\tSERVICES\tAGE")
+			fmt.Fprintln(w, i18n.T("tenant.list.header"))
 			for _, tenant := range tenants.Items {
 				age := utils.FormatAge(tenant.CreationTimestamp.Time)
 				services := fmt.Sprintf("%d", len(tenant.Spec.Services))
-				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n",
+				clusters := "-"
+				if tenant.Spec.Placement != nil {
+					ready := 0
+					for _, cs := range tenant.Status.ClusterStatuses {
+						if cs.Ready {
+							ready++
+						}
+					}
+					clusters = fmt.Sprintf("%d/%d", ready, len(tenant.Status.ClusterStatuses))
+				}
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
 					tenant.Name,
 					tenant.Spec.OrganizationName,
 					tenant.Spec.Tier,
 					tenant.Status.Phase,
 					services,
+					clusters,
 					age,
 				)
 			}
 			return w.Flush()
 		},
 	}
-	cmd.Flags().BoolVarP(&allNamespaces, "all-namespaces", "A", false, "List tenants across all namespaces")
-	cmd.Flags().StringVarP(&output, "output", "o", "", "Output format (json, yaml)")
-	cmd.Flags().StringVarP(&selector, "selector", "l", "", "Label selector")
+	cmd.Flags().BoolVarP(&allNamespaces, "all-namespaces", "A", false, i18n.T("tenant.flag.all-namespaces"))
+	cmd.Flags().StringVarP(&output, "output", "o", "", i18n.T("tenant.flag.output"))
+	cmd.Flags().StringVarP(&selector, "selector", "l", "", i18n.T("tenant.flag.selector"))
 	return cmd
 }
 
 func newTenantGetCmd(cfg *config.Config) *cobra.Command {
 	var output string
 	cmd := &cobra.Command{
-		Use:   "get NAME",
-		Short: "Get tenant details",
-		Args:  cobra.ExactArgs(1),
+		Use:               "get NAME",
+		Short:             i18n.T("tenant.get.short"),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completion.TenantNames(cfg),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := context.Background()
 			client, err := kube.NewClient(cfg)
@@ -259,95 +306,118 @@ func newTenantGetCmd(cfg *config.Config) *cobra.Command {
 			return outputTenant(tenant, output)
 		},
 	}
-	cmd.Flags().StringVarP(&output, "output", "o", "", "Output format (json, yaml)")
+	cmd.Flags().StringVarP(&output, "output", "o", "", i18n.T("tenant.flag.output"))
 	return cmd
 }
 
 func newTenantUpdateCmd(cfg *config.Config) *cobra.Command {
 	var (
-		file     string
-		tier     string
-		replicas map[string]int32
-		wait     bool
+		file            string
+		tier            string
+		replicas        map[string]int32
+		wait            bool
+		timeout         time.Duration
+		conflictRetries int
+		dryRun          string
+		pollFallback    bool
 	)
 	cmd := &cobra.Command{
-		Use:   "update NAME",
-		Short: "Update tenant configuration",
-		Args:  cobra.ExactArgs(1),
+		Use:               "update NAME",
+		Short:             i18n.T("tenant.update.short"),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completion.TenantNames(cfg),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx, span := tracing.StartCommandSpan(context.Background(), "update")
+			defer span.End()
 			client, err := kube.NewClient(cfg)
 			if err != nil {
 				return fmt.Errorf("failed to create kubernetes client: %w", err)
 			}
-			name := args[0]
-			// Get existing tenant
-			tenant := &tenantv1alpha1.Tenant{}
-			if err := client.Get(ctx, types.NamespacedName{
-				Name:      name,
-				Namespace: cfg.Namespace,
-			}, tenant); err != nil {
-				return fmt.Errorf("failed to get tenant: %w", err)
+			if dryRun != "" && dryRun != "server" {
+				return fmt.Errorf("invalid --dry-run value: %s (must be 'server')", dryRun)
 			}
-			if file != "" {
-				// Update from file
-				data, err := os.ReadFile(file)
-				if err != nil {
-					return fmt.Errorf("failed to read file: %w", err)
-				}
-				updated := &tenantv1alpha1.Tenant{}
-				if err := yaml.Unmarshal(data, updated); err != nil {
-					return fmt.Errorf("failed to parse YAML: %w", err)
-				}
-				tenant.Spec = updated.Spec
-			} else {
-				// Update from flags
-				if tier != "" {
-					tenant.Spec.Tier = tier
+			name := args[0]
+			nn := types.NamespacedName{Name: name, Namespace: cfg.Namespace}
+			opts := kube.MutateOptions{MaxRetries: conflictRetries, DryRun: dryRun == "server"}
+			_, err = kube.MutateTenantWithRetry(ctx, client, nn, opts, func(tenant *tenantv1alpha1.Tenant) error {
+				if traceID := tracing.TraceID(ctx); traceID != "" {
+					if tenant.Annotations == nil {
+						tenant.Annotations = map[string]string{}
+					}
+					tenant.Annotations["tenant.rezenkai.com/trace-id"] = traceID
 				}
-				// Update service replicas
-				for svcName, count := range replicas {
-					for i, svc := range tenant.Spec.Services {
-						if svc.Name == svcName {
-							tenant.Spec.Services[i].Replicas = count
-							break
+				if file != "" {
+					// Update from file
+					data, err := os.ReadFile(file)
+					if err != nil {
+						return fmt.Errorf("failed to read file: %w", err)
+					}
+					updated := &tenantv1alpha1.Tenant{}
+					if err := yaml.Unmarshal(data, updated); err != nil {
+						return fmt.Errorf("failed to parse YAML: %w", err)
+					}
+					tenant.Spec = updated.Spec
+				} else {
+					// Update from flags
+					if tier != "" {
+						tenant.Spec.Tier = tier
+					}
+					// Update service replicas
+					for svcName, count := range replicas {
+						for i, svc := range tenant.Spec.Services {
+							if svc.Name == svcName {
+								tenant.Spec.Services[i].Replicas = count
+								break
+							}
 						}
 					}
 				}
-			}
-			// Update tenant
-			if err := client.Update(ctx, tenant); err != nil {
+				return nil
+			})
+			if err != nil {
 				return fmt.Errorf("failed to update tenant: %w", err)
 			}
-			fmt.Printf("Tenant '%s' updated successfully\n", name)
+			if dryRun == "server" {
+				cfg.Logger.Info(i18n.T("tenant.update.log.validated"), "tenant.name", name)
+				return nil
+			}
+			cfg.Logger.Info(i18n.T("tenant.update.log.updated"), "tenant.name", name)
 			if wait {
-				fmt.Println("Waiting for update to complete...")
-				if err := waitForTenant(ctx, client, name, cfg.Namespace); err != nil {
+				cfg.Logger.Info(i18n.T("tenant.log.waiting-update"), "tenant.name", name)
+				if err := waitForTenant(ctx, client, name, cfg.Namespace, timeout, pollFallback); err != nil {
 					return fmt.Errorf("error waiting for tenant: %w", err)
 				}
-				fmt.Println("Update completed!")
+				cfg.Logger.Info(i18n.T("tenant.log.update-completed"), "tenant.name", name)
 			}
 			return nil
 		},
 	}
-	cmd.Flags().StringVarP(&file, "file", "f", "", "Path to updated tenant configuration")
-	cmd.Flags().StringVar(&tier, "tier", "", "Update tenant tier")
-	cmd.Flags().StringToInt32Var(&replicas, "replicas", map[string]int32{}, "Update service replicas (format: service=count)")
-	cmd.Flags().BoolVar(&wait, "wait", false, "Wait for update to complete")
+	cmd.Flags().StringVarP(&file, "file", "f", "", i18n.T("tenant.update.flag.file"))
+	cmd.Flags().StringVar(&tier, "tier", "", i18n.T("tenant.update.flag.tier"))
+	cmd.Flags().StringToInt32Var(&replicas, "replicas", map[string]int32{}, i18n.T("tenant.update.flag.replicas"))
+	cmd.Flags().BoolVar(&wait, "wait", false, i18n.T("tenant.flag.wait.update"))
+	cmd.Flags().DurationVar(&timeout, "timeout", 10*time.Minute, i18n.T("tenant.flag.timeout.update"))
+	cmd.Flags().IntVar(&conflictRetries, "conflict-retries", 5, i18n.T("tenant.flag.conflict-retries"))
+	cmd.Flags().StringVar(&dryRun, "dry-run", "", i18n.T("tenant.flag.dry-run.update"))
+	cmd.Flags().BoolVar(&pollFallback, "poll-fallback", false, i18n.T("tenant.flag.poll-fallback"))
 	return cmd
 }
 
 func newTenantDeleteCmd(cfg *config.Config) *cobra.Command {
 	var (
-		force bool
-		wait  bool
+		force        bool
+		wait         bool
+		timeout      time.Duration
+		pollFallback bool
 	)
 	cmd := &cobra.Command{
-		Use:   "delete NAME",
-		Short: "Delete a tenant",
-		Args:  cobra.ExactArgs(1),
+		Use:               "delete NAME",
+		Short:             i18n.T("tenant.delete.short"),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completion.TenantNames(cfg),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx, span := tracing.StartCommandSpan(context.Background(), "delete")
+			defer span.End()
 			client, err := kube.NewClient(cfg)
 			if err != nil {
 				return fmt.Errorf("failed to create kubernetes client: %w", err)
@@ -355,11 +425,11 @@ func newTenantDeleteCmd(cfg *config.Config) *cobra.Command {
 			name := args[0]
 			if !force {
 				// Confirmation prompt
-				fmt.Printf("Are you sure you want to delete tenant '%s'? This action cannot be undone. [y/N]: ", name)
+				fmt.Printf(i18n.T("tenant.delete.confirm"), name)
 				var response string
 				fmt.Scanln(&response)
 				if strings.ToLower(response) != "y" {
-					fmt.Println("Deletion cancelled")
+					cfg.Logger.Info(i18n.T("tenant.delete.log.cancelled"), "tenant.name", name)
 					return nil
 				}
 			}
@@ -373,34 +443,47 @@ func newTenantDeleteCmd(cfg *config.Config) *cobra.Command {
 			if err := client.Delete(ctx, tenant); err != nil {
 				return fmt.Errorf("failed to delete tenant: %w", err)
 			}
-			fmt.Printf("Tenant '%s' deletion initiated\n", name)
+			cfg.Logger.Info(i18n.T("tenant.delete.log.initiated"), "tenant.name", name)
 			if wait {
-				fmt.Println("Waiting for tenant deletion to complete...")
-				if err := waitForDeletion(ctx, client, name, cfg.Namespace); err != nil {
+				cfg.Logger.Info(i18n.T("tenant.log.waiting-deletion"), "tenant.name", name)
+				if err := waitForDeletion(ctx, client, name, cfg.Namespace, timeout, pollFallback); err != nil {
 					return fmt.Errorf("error waiting for deletion: %w", err)
 				}
-				fmt.Println("Tenant deleted successfully")
+				cfg.Logger.Info(i18n.T("tenant.log.deleted"), "tenant.name", name)
 			}
 			return nil
 		},
 	}
-	cmd.Flags().BoolVar(&force, "force", false, "Skip confirmation prompt")
-	cmd.Flags().BoolVar(&wait, "wait", false, "Wait for deletion to complete")
+	cmd.Flags().BoolVar(&force, "force", false, i18n.T("tenant.flag.force"))
+	cmd.Flags().BoolVar(&wait, "wait", false, i18n.T("tenant.flag.wait.delete"))
+	cmd.Flags().DurationVar(&timeout, "timeout", 5*time.Minute, i18n.T("tenant.flag.timeout.delete"))
+	cmd.Flags().BoolVar(&pollFallback, "poll-fallback", false, i18n.T("tenant.flag.poll-fallback"))
 	return cmd
 }
 
 func newTenantScaleCmd(cfg *config.Config) *cobra.Command {
-	var wait bool
+	var (
+		wait            bool
+		conflictRetries int
+		dryRun          string
+		timeout         time.Duration
+		pollFallback    bool
+	)
 	cmd := &cobra.Command{
-		Use:   "scale NAME SERVICE=REPLICAS",
-		Short: "Scale tenant services",
-		Args:  cobra.MinimumNArgs(2),
+		Use:               "scale NAME SERVICE=REPLICAS",
+		Short:             i18n.T("tenant.scale.short"),
+		Args:              cobra.MinimumNArgs(2),
+		ValidArgsFunction: completion.TenantNames(cfg),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx, span := tracing.StartCommandSpan(context.Background(), "scale")
+			defer span.End()
 			client, err := kube.NewClient(cfg)
 			if err != nil {
 				return fmt.Errorf("failed to create kubernetes client: %w", err)
 			}
+			if dryRun != "" && dryRun != "server" {
+				return fmt.Errorf("invalid --dry-run value: %s (must be 'server')", dryRun)
+			}
 			name := args[0]
 			// Parse scale requests
 			scaleRequests := make(map[string]int32)
@@ -415,138 +498,53 @@ func newTenantScaleCmd(cfg *config.Config) *cobra.Command {
 				}
 				scaleRequests[parts[0]] = replicas
 			}
-			// Get tenant
-			tenant := &tenantv1alpha1.Tenant{}
-			if err := client.Get(ctx, types.NamespacedName{
-				Name:      name,
-				Namespace: cfg.Namespace,
-			}, tenant); err != nil {
-				return fmt.Errorf("failed to get tenant: %w", err)
-			}
-			// Update service replicas
-			updated := false
-			for svcName, replicas := range scaleRequests {
-				found := false
-				for i, svc := range tenant.Spec.Services {
-					if svc.Name == svcName {
-						tenant.Spec.Services[i].Replicas = replicas
-						found = true
-						updated = true
-						fmt.Printf("Scaling service '%s' to %d replicas\n", svcName, replicas)
-						break
+			nn := types.NamespacedName{Name: name, Namespace: cfg.Namespace}
+			opts := kube.MutateOptions{MaxRetries: conflictRetries, DryRun: dryRun == "server"}
+			tenant, err := kube.MutateTenantWithRetry(ctx, client, nn, opts, func(tenant *tenantv1alpha1.Tenant) error {
+				for svcName, replicas := range scaleRequests {
+					found := false
+					for i, svc := range tenant.Spec.Services {
+						if svc.Name == svcName {
+							tenant.Spec.Services[i].Replicas = replicas
+							found = true
+							cfg.Logger.Info(i18n.T("tenant.scale.log.scaling"), "tenant.name", name, "service", svcName, "replicas", replicas)
+							break
+						}
 					}
-				}
-				if !found {
-					return fmt.Errorf("service '%s' not found in tenant", svcName)
-				}
-			}
-			if updated {
-				if err := client.Update(ctx, tenant); err != nil {
-					return fmt.Errorf("failed to update tenant: %w", err)
-				}
-				if wait {
-					fmt.Println("Waiting for scale operation to complete...")
-					if err := waitForServiceScale(ctx, client, tenant, scaleRequests); err != nil {
-						return fmt.Errorf("error waiting for scale: %w", err)
+					if !found {
+						return fmt.Errorf("service '%s' not found in tenant", svcName)
 					}
-					fmt.Println("Scale operation completed successfully")
 				}
-			}
-			return nil
-		},
-	}
-	cmd.Flags().BoolVar(&wait, "wait", false, "Wait for scale operation to complete")
-	return cmd
-}
-
-func newTenantUpgradeCmd(cfg *config.Config) *cobra.Command {
-	var (
-		service  string
-		version  string
-		all      bool
-		wait     bool
-		strategy string
-	)
-	cmd := &cobra.Command{
-		Use:   "upgrade NAME",
-		Short: "Upgrade tenant services",
-		Args:  cobra.ExactArgs(1),
-		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
-			client, err := kube.NewClient(cfg)
+				return nil
+			})
 			if err != nil {
-				return fmt.Errorf("failed to create kubernetes client: %w", err)
-			}
-			name := args[0]
-			// Get tenant
-			tenant := &tenantv1alpha1.Tenant{}
-			if err := client.Get(ctx, types.NamespacedName{
-				Name:      name,
-				Namespace: cfg.Namespace,
-			}, tenant); err != nil {
-				return fmt.Errorf("failed to get tenant: %w", err)
+				return fmt.Errorf("failed to update tenant: %w", err)
 			}
-			// Validate strategy
-			if strategy != "rolling" && strategy != "recreate" {
-				return fmt.Errorf("invalid strategy: %s (must be 'rolling' or 'recreate')", strategy)
-			}
-			// Update service versions
-			updated := false
-			if all {
-				// Upgrade all services
-				for i := range tenant.Spec.Services {
-					tenant.Spec.Services[i].Version = version
-					fmt.Printf("Upgrading service '%s' to version %s\n", tenant.Spec.Services[i].Name, version)
-					updated = true
-				}
-			} else if service != "" {
-				// Upgrade specific service
-				found := false
-				for i, svc := range tenant.Spec.Services {
-					if svc.Name == service {
-						tenant.Spec.Services[i].Version = version
-						fmt.Printf("Upgrading service '%s' to version %s\n", service, version)
-						found = true
-						updated = true
-						break
-					}
-				}
-				if !found {
-					return fmt.Errorf("service '%s' not found in tenant", service)
-				}
-			} else {
-				return fmt.Errorf("either --service or --all must be specified")
+			if dryRun == "server" {
+				cfg.Logger.Info(i18n.T("tenant.scale.log.validated"), "tenant.name", name)
+				return nil
 			}
-			if updated {
-				// Add upgrade annotation
-				if tenant.Annotations == nil {
-					tenant.Annotations = make(map[string]string)
-				}
-				tenant.Annotations["tenant.rezenkai.com/upgrade-strategy"] = strategy
-				tenant.Annotations["tenant.rezenkai.com/upgrade-timestamp"] = time.Now().Format(time.RFC3339)
-				if err := client.Update(ctx, tenant); err != nil {
-					return fmt.Errorf("failed to update tenant: %w", err)
-				}
-				if wait {
-					fmt.Println("Waiting for upgrade to complete...")
-					if err := waitForUpgrade(ctx, client, tenant); err != nil {
-						return fmt.Errorf("error waiting for upgrade: %w", err)
-					}
-					fmt.Println("Upgrade completed successfully")
+			if wait {
+				cfg.Logger.Info(i18n.T("tenant.log.waiting-scale"), "tenant.name", name)
+				if err := waitForServiceScale(ctx, client, tenant, scaleRequests, timeout, pollFallback); err != nil {
+					return fmt.Errorf("error waiting for scale: %w", err)
 				}
+				cfg.Logger.Info(i18n.T("tenant.log.scale-completed"), "tenant.name", name)
 			}
 			return nil
 		},
 	}
-	cmd.Flags().StringVar(&service, "service", "", "Service to upgrade")
-	cmd.Flags().StringVar(&version, "version", "", "Target version")
-	cmd.Flags().BoolVar(&all, "all", false, "Upgrade all services")
-	cmd.Flags().BoolVar(&wait, "wait", false, "Wait for upgrade to complete")
-	cmd.Flags().StringVar(&strategy, "strategy", "rolling", "Upgrade strategy (rolling, recreate)")
-	cmd.MarkFlagRequired("version")
+	cmd.Flags().BoolVar(&wait, "wait", false, i18n.T("tenant.flag.wait.scale"))
+	cmd.Flags().IntVar(&conflictRetries, "conflict-retries", 5, i18n.T("tenant.flag.conflict-retries"))
+	cmd.Flags().StringVar(&dryRun, "dry-run", "", i18n.T("tenant.flag.dry-run.scale"))
+	cmd.Flags().DurationVar(&timeout, "timeout", 5*time.Minute, i18n.T("tenant.flag.timeout.scale"))
+	cmd.Flags().BoolVar(&pollFallback, "poll-fallback", false, i18n.T("tenant.flag.poll-fallback"))
 	return cmd
 }
 
+// newTenantUpgradeCmd is defined in upgrade.go, alongside the rollout
+// orchestration driver it shares with the health-gate and rollback helpers.
+
 // Helper functions
 func outputTenant(tenant *tenantv1alpha1.Tenant, format string) error {
 	switch format {
@@ -564,56 +562,99 @@ func outputTenant(tenant *tenantv1alpha1.Tenant, format string) error {
 		fmt.Print(string(data))
 	default:
 		// Detailed output
-		fmt.Printf("Name: %s\n", tenant.Name)
-		fmt.Printf("Namespace: %s\n", tenant.Namespace)
-		fmt.Printf("Organization: %s\n", tenant.Spec.OrganizationName)
-		fmt.Printf("Tier: %s\n", tenant.Spec.Tier)
-		fmt.Printf("Phase: %s\n", tenant.Status.Phase)
+		fmt.Println(i18n.T("tenant.detail.name", tenant.Name))
+		fmt.Println(i18n.T("tenant.detail.namespace", tenant.Namespace))
+		fmt.Println(i18n.T("tenant.detail.organization", tenant.Spec.OrganizationName))
+		fmt.Println(i18n.T("tenant.detail.tier", tenant.Spec.Tier))
+		fmt.Println(i18n.T("tenant.detail.phase", tenant.Status.Phase))
 		if len(tenant.Spec.Domains) > 0 {
-			fmt.Printf("Domains: %s\n", strings.Join(tenant.Spec.Domains, ", "))
+			fmt.Println(i18n.T("tenant.detail.domains", strings.Join(tenant.Spec.Domains, ", ")))
 		}
 		if tenant.Status.URL != "" {
-			fmt.Printf("URL: %s\n", tenant.Status.URL)
+			fmt.Println(i18n.T("tenant.detail.url", tenant.Status.URL))
 		}
-		fmt.Println("\nServices:")
+		fmt.Println("\n" + i18n.T("tenant.detail.services-header"))
 		for _, svc := range tenant.Spec.Services {
-			status := "Not deployed"
+			status := i18n.T("tenant.detail.service.not-deployed")
 			for _, s := range tenant.Status.Services {
 				if s.Name == svc.Name {
 					if s.Ready {
-						status = "Ready"
+						status = i18n.T("tenant.detail.service.ready")
 					} else {
-						status = "Not ready"
+						status = i18n.T("tenant.detail.service.not-ready")
 					}
 					break
 				}
 			}
-			fmt.Printf(" - %s (v%s): %d replicas - %s\n", svc.Name, svc.Version, svc.Replicas, status)
+			fmt.Println(i18n.T("tenant.detail.service-line", svc.Name, svc.Version, svc.Replicas, status))
+		}
+		fmt.Println("\n" + i18n.T("tenant.detail.database-header"))
+		fmt.Println(i18n.T("tenant.detail.database.type", tenant.Spec.Database.Type, tenant.Spec.Database.Version))
+		fmt.Println(i18n.T("tenant.detail.database.backup-enabled", tenant.Spec.Database.Backup.Enabled))
+		if tenant.Spec.Database.Backup.Mode != "" {
+			fmt.Println(i18n.T("tenant.detail.database.mode", tenant.Spec.Database.Backup.Mode))
 		}
-		fmt.Println("\nDatabase:")
-		fmt.Printf(" Type: %s %s\n", tenant.Spec.Database.Type, tenant.Spec.Database.Version)
-		fmt.Printf(" Backup Enabled: %v\n", tenant.Spec.Database.Backup.Enabled)
 		if tenant.Status.DatabaseStatus.ConnectionURL != "" {
-			fmt.Printf(" URL: %s\n", tenant.Status.DatabaseStatus.ConnectionURL)
+			fmt.Println(i18n.T("tenant.detail.database.url", tenant.Status.DatabaseStatus.ConnectionURL))
 		}
 		if tenant.Status.DatabaseStatus.LastBackupTime != nil {
-			fmt.Printf(" Last Backup: %s\n", utils.FormatTime(tenant.Status.DatabaseStatus.LastBackupTime.Time))
+			fmt.Println(i18n.T("tenant.detail.database.last-backup", utils.FormatTime(tenant.Status.DatabaseStatus.LastBackupTime.Time)))
 		}
 		if tenant.Status.DatabaseStatus.LastRestoreTime != nil {
-			fmt.Printf(" Last Restore: %s\n", utils.FormatTime(tenant.Status.DatabaseStatus.LastRestoreTime.Time))
+			fmt.Println(i18n.T("tenant.detail.database.last-restore", utils.FormatTime(tenant.Status.DatabaseStatus.LastRestoreTime.Time)))
+		}
+		if tenant.Status.DatabaseStatus.PITRWindowStart != nil && tenant.Status.DatabaseStatus.PITRWindowEnd != nil {
+			fmt.Println(i18n.T("tenant.detail.database.pitr-window",
+				utils.FormatTime(tenant.Status.DatabaseStatus.PITRWindowStart.Time),
+				utils.FormatTime(tenant.Status.DatabaseStatus.PITRWindowEnd.Time)))
+		}
+		if tenant.Status.DatabaseStatus.LastWALArchiveTime != nil {
+			fmt.Println(i18n.T("tenant.detail.database.last-wal-archive", utils.FormatTime(tenant.Status.DatabaseStatus.LastWALArchiveTime.Time)))
+		}
+		if tenant.Status.DatabaseStatus.NextScheduledBackup != nil {
+			fmt.Println(i18n.T("tenant.detail.database.next-backup", utils.FormatTime(tenant.Status.DatabaseStatus.NextScheduledBackup.Time)))
+		}
+		if tenant.Status.DatabaseStatus.LastSuccessfulBackup != nil {
+			fmt.Println(i18n.T("tenant.detail.database.last-successful-backup", utils.FormatTime(tenant.Status.DatabaseStatus.LastSuccessfulBackup.Time)))
+		}
+		if tenant.Status.DatabaseStatus.BackupCount > 0 {
+			fmt.Println(i18n.T("tenant.detail.database.backup-count", tenant.Status.DatabaseStatus.BackupCount))
+		}
+		if ha := tenant.Spec.Database.HighAvailability; ha != nil {
+			fmt.Println(i18n.T("tenant.detail.database.ha-replicas", ha.Replicas))
+			if tenant.Status.DatabaseStatus.PrimaryPod != "" {
+				fmt.Println(i18n.T("tenant.detail.database.ha-primary", tenant.Status.DatabaseStatus.PrimaryPod))
+			}
+			if len(tenant.Status.DatabaseStatus.ReplicaPods) > 0 {
+				fmt.Println(i18n.T("tenant.detail.database.ha-replica-pods", strings.Join(tenant.Status.DatabaseStatus.ReplicaPods, ", ")))
+			}
+		}
+		fmt.Println("\n" + i18n.T("tenant.detail.resources-header"))
+		fmt.Println(i18n.T("tenant.detail.resources.cpu", tenant.Spec.Resources.CPU.Request, tenant.Spec.Resources.CPU.Limit))
+		fmt.Println(i18n.T("tenant.detail.resources.memory", tenant.Spec.Resources.Memory.Request, tenant.Spec.Resources.Memory.Limit))
+		fmt.Println(i18n.T("tenant.detail.resources.storage", tenant.Spec.Resources.Storage.Size))
+		if len(tenant.Status.ClusterStatuses) > 0 {
+			fmt.Println("\n" + i18n.T("tenant.detail.clusters-header"))
+			for _, cs := range tenant.Status.ClusterStatuses {
+				status := i18n.T("tenant.detail.cluster.not-ready")
+				if cs.Ready {
+					status = i18n.T("tenant.detail.cluster.ready")
+				}
+				line := fmt.Sprintf(" - %s: %s", cs.Name, status)
+				if cs.Message != "" {
+					line += fmt.Sprintf(" (%s)", cs.Message)
+				}
+				fmt.Println(line)
+			}
 		}
-		fmt.Println("\nResources:")
-		fmt.Printf(" CPU: %s/%s (request/limit)\n", tenant.Spec.Resources.CPU.Request, tenant.Spec.Resources.CPU.Limit)
-		fmt.Printf(" Memory: %s/%s (request/limit)\n", tenant.Spec.Resources.Memory.Request, tenant.Spec.Resources.Memory.Limit)
-		fmt.Printf(" Storage: %s\n", tenant.Spec.Resources.Storage.Size)
 		if len(tenant.Status.Conditions) > 0 {
-			fmt.Println("\nConditions:")
+			fmt.Println("\n" + i18n.T("tenant.detail.conditions-header"))
 			for _, cond := range tenant.Status.Conditions {
 				fmt.Printf(" - %s: %s (%s)\n", cond.Type, cond.Status, cond.Message)
 			}
 		}
 		if tenant.Status.LastReconciled != nil {
-			fmt.Printf("\nLast Reconciled: %s\n", utils.FormatTime(tenant.Status.LastReconciled.Time))
+			fmt.Println("\n" + i18n.T("tenant.detail.last-reconciled", utils.FormatTime(tenant.Status.LastReconciled.Time)))
 		}
 	}
 	return nil
@@ -639,47 +680,159 @@ func outputObject(obj interface{}, format string) error {
 	return nil
 }
 
-func waitForTenant(ctx context.Context, client client.Client, name, namespace string) error {
+// waitForTenant watches the Tenant for its Phase to reach a terminal state,
+// falling back to 2-second polling when pollFallback is set or the watch
+// itself can't be established (some API servers reject long-lived watches).
+func waitForTenant(ctx context.Context, c client.WithWatch, name, namespace string, timeoutDuration time.Duration, pollFallback bool) error {
+	ctx, cancel := context.WithTimeout(ctx, timeoutDuration)
+	defer cancel()
+	if pollFallback {
+		return waitForTenantPoll(ctx, c, name, namespace)
+	}
+
+	nn := types.NamespacedName{Name: name, Namespace: namespace}
+	current := &tenantv1alpha1.Tenant{}
+	if err := c.Get(ctx, nn, current); err != nil {
+		return err
+	}
+	if done, err := tenantPhaseResult(current); done {
+		return err
+	}
+
+	watcher, err := kube.WatchTenant(ctx, c, nn)
+	if err != nil {
+		return waitForTenantPoll(ctx, c, name, namespace)
+	}
+	defer watcher.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return fmt.Errorf("timeout waiting for tenant to be ready")
+			}
+			return ctx.Err()
+		case event, ok := <-watcher.ResultChan():
+			if !ok {
+				return waitForTenantPoll(ctx, c, name, namespace)
+			}
+			tenant, ok := event.Object.(*tenantv1alpha1.Tenant)
+			if !ok {
+				continue
+			}
+			_, pollSpan := tracing.StartPollSpan(ctx, "tenant.wait.watch",
+				attribute.String("tenant.name", tenant.Name),
+				attribute.String("tenant.phase", tenant.Status.Phase),
+			)
+			done, err := tenantPhaseResult(tenant)
+			pollSpan.End()
+			if done {
+				return err
+			}
+		}
+	}
+}
+
+// tenantPhaseResult reports whether tenant's phase is terminal and, if so,
+// the error waitForTenant should return (nil for Active).
+func tenantPhaseResult(tenant *tenantv1alpha1.Tenant) (bool, error) {
+	switch tenant.Status.Phase {
+	case "Active":
+		return true, nil
+	case "Failed":
+		return true, fmt.Errorf("tenant failed to provision")
+	default:
+		return false, nil
+	}
+}
+
+func waitForTenantPoll(ctx context.Context, c client.Client, name, namespace string) error {
 	ticker := time.NewTicker(2 * time.Second)
 	defer ticker.Stop()
-	timeout := time.After(10 * time.Minute)
 	for {
 		select {
 		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return fmt.Errorf("timeout waiting for tenant to be ready")
+			}
 			return ctx.Err()
-		case <-timeout:
-			return fmt.Errorf("timeout waiting for tenant to be ready")
 		case <-ticker.C:
 			tenant := &tenantv1alpha1.Tenant{}
-			if err := client.Get(ctx, types.NamespacedName{
+			if err := c.Get(ctx, types.NamespacedName{
 				Name:      name,
 				Namespace: namespace,
 			}, tenant); err != nil {
 				return err
 			}
-			if tenant.Status.Phase == "Active" {
-				return nil
+			_, pollSpan := tracing.StartPollSpan(ctx, "tenant.wait.poll",
+				attribute.String("tenant.name", name),
+				attribute.String("tenant.phase", tenant.Status.Phase),
+			)
+			done, err := tenantPhaseResult(tenant)
+			pollSpan.End()
+			if done {
+				return err
+			}
+		}
+	}
+}
+
+// waitForDeletion watches for the Tenant's deletion, falling back to polling
+// under the same conditions as waitForTenant.
+func waitForDeletion(ctx context.Context, c client.WithWatch, name, namespace string, timeoutDuration time.Duration, pollFallback bool) error {
+	ctx, cancel := context.WithTimeout(ctx, timeoutDuration)
+	defer cancel()
+	if pollFallback {
+		return waitForDeletionPoll(ctx, c, name, namespace)
+	}
+
+	nn := types.NamespacedName{Name: name, Namespace: namespace}
+	current := &tenantv1alpha1.Tenant{}
+	err := c.Get(ctx, nn, current)
+	if kube.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	watcher, err := kube.WatchTenant(ctx, c, nn)
+	if err != nil {
+		return waitForDeletionPoll(ctx, c, name, namespace)
+	}
+	defer watcher.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return fmt.Errorf("timeout waiting for deletion")
 			}
-			if tenant.Status.Phase == "Failed" {
-				return fmt.Errorf("tenant failed to provision")
+			return ctx.Err()
+		case event, ok := <-watcher.ResultChan():
+			if !ok {
+				return waitForDeletionPoll(ctx, c, name, namespace)
+			}
+			if event.Type == watch.Deleted {
+				return nil
 			}
 		}
 	}
 }
 
-func waitForDeletion(ctx context.Context, client client.Client, name, namespace string) error {
+func waitForDeletionPoll(ctx context.Context, c client.Client, name, namespace string) error {
 	ticker := time.NewTicker(2 * time.Second)
 	defer ticker.Stop()
-	timeout := time.After(5 * time.Minute)
 	for {
 		select {
 		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return fmt.Errorf("timeout waiting for deletion")
+			}
 			return ctx.Err()
-		case <-timeout:
-			return fmt.Errorf("timeout waiting for deletion")
 		case <-ticker.C:
 			tenant := &tenantv1alpha1.Tenant{}
-			err := client.Get(ctx, types.NamespacedName{
+			err := c.Get(ctx, types.NamespacedName{
 				Name:      name,
 				Namespace: namespace,
 			}, tenant)
@@ -693,48 +846,110 @@ func waitForDeletion(ctx context.Context, client client.Client, name, namespace
 	}
 }
 
-func waitForServiceScale(ctx context.Context, client client.Client, tenant *tenantv1alpha1.Tenant, expected map[string]int32) error {
+// waitForServiceScale watches for every entry in expected to report the
+// requested replica count as Ready, falling back to polling under the same
+// conditions as waitForTenant.
+func waitForServiceScale(ctx context.Context, c client.WithWatch, tenant *tenantv1alpha1.Tenant, expected map[string]int32, timeoutDuration time.Duration, pollFallback bool) error {
+	ctx, cancel := context.WithTimeout(ctx, timeoutDuration)
+	defer cancel()
+	if pollFallback {
+		return waitForServiceScalePoll(ctx, c, tenant, expected)
+	}
+
+	nn := types.NamespacedName{Name: tenant.Name, Namespace: tenant.Namespace}
+	current := &tenantv1alpha1.Tenant{}
+	if err := c.Get(ctx, nn, current); err != nil {
+		return err
+	}
+	if scaleSatisfied(current, expected) {
+		return nil
+	}
+
+	watcher, err := kube.WatchTenant(ctx, c, nn)
+	if err != nil {
+		return waitForServiceScalePoll(ctx, c, tenant, expected)
+	}
+	defer watcher.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return fmt.Errorf("timeout waiting for scale operation")
+			}
+			return ctx.Err()
+		case event, ok := <-watcher.ResultChan():
+			if !ok {
+				return waitForServiceScalePoll(ctx, c, tenant, expected)
+			}
+			current, ok := event.Object.(*tenantv1alpha1.Tenant)
+			if !ok {
+				continue
+			}
+			_, pollSpan := tracing.StartPollSpan(ctx, "tenant.scale.watch",
+				attribute.String("tenant.name", current.Name),
+				attribute.String("tenant.phase", current.Status.Phase),
+				attribute.Int("services.ready", countScaled(current, expected)),
+			)
+			done := scaleSatisfied(current, expected)
+			pollSpan.End()
+			if done {
+				return nil
+			}
+		}
+	}
+}
+
+func waitForServiceScalePoll(ctx context.Context, c client.Client, tenant *tenantv1alpha1.Tenant, expected map[string]int32) error {
 	ticker := time.NewTicker(2 * time.Second)
 	defer ticker.Stop()
-	timeout := time.After(5 * time.Minute)
 	for {
 		select {
 		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return fmt.Errorf("timeout waiting for scale operation")
+			}
 			return ctx.Err()
-		case <-timeout:
-			return fmt.Errorf("timeout waiting for scale operation")
 		case <-ticker.C:
-			// Refresh tenant status
 			current := &tenantv1alpha1.Tenant{}
-			if err := client.Get(ctx, types.NamespacedName{
+			if err := c.Get(ctx, types.NamespacedName{
 				Name:      tenant.Name,
 				Namespace: tenant.Namespace,
 			}, current); err != nil {
 				return err
 			}
-			// Check if all services are scaled
-			allScaled := true
-			for svcName, expectedReplicas := range expected {
-				scaled := false
-				for _, status := range current.Status.Services {
-					if status.Name == svcName && status.Replicas == expectedReplicas && status.Ready {
-						scaled = true
-						break
-					}
-				}
-				if !scaled {
-					allScaled = false
-					break
-				}
-			}
-			if allScaled {
+			readyCount := countScaled(current, expected)
+			_, pollSpan := tracing.StartPollSpan(ctx, "tenant.scale.poll",
+				attribute.String("tenant.name", current.Name),
+				attribute.String("tenant.phase", current.Status.Phase),
+				attribute.Int("services.ready", readyCount),
+			)
+			pollSpan.End()
+			if readyCount == len(expected) {
 				return nil
 			}
 		}
 	}
 }
 
-func waitForUpgrade(ctx context.Context, client client.Client, tenant *tenantv1alpha1.Tenant) error {
-	// Simplified: reuse waitForTenant to check if tenant is Active
-	return waitForTenant(ctx, client, tenant.Name, tenant.Namespace)
-}
\ No newline at end of file
+// countScaled returns how many entries in expected are satisfied by
+// current's reported service status.
+func countScaled(current *tenantv1alpha1.Tenant, expected map[string]int32) int {
+	count := 0
+	for svcName, expectedReplicas := range expected {
+		for _, status := range current.Status.Services {
+			if status.Name == svcName && status.Replicas == expectedReplicas && status.Ready {
+				count++
+				break
+			}
+		}
+	}
+	return count
+}
+
+func scaleSatisfied(current *tenantv1alpha1.Tenant, expected map[string]int32) bool {
+	return countScaled(current, expected) == len(expected)
+}
+
+// waitForUpgrade and the staged rollout driver it supports are defined in
+// upgrade.go.
\ No newline at end of file
@@ -0,0 +1,475 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	tenantv1alpha1 "github.com/rezenkai/multi-saas-crm/tenant-orchestrator/api/v1alpha1"
+	"github.com/rezenkai/multi-saas-crm/tenant-orchestrator/cli/internal/config"
+	"github.com/rezenkai/multi-saas-crm/tenant-orchestrator/cli/internal/kube"
+	"github.com/rezenkai/multi-saas-crm/tenant-orchestrator/cli/internal/log"
+	"github.com/rezenkai/multi-saas-crm/tenant-orchestrator/cli/internal/tracing"
+	"github.com/spf13/cobra"
+	"go.opentelemetry.io/otel/attribute"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// blueGreenAnnotation marks a tenant as running a shadow service set staged
+// by a `tenant upgrade --strategy=blue-green` rollout, keyed by the target
+// version it was staged for.
+const blueGreenAnnotation = "tenant.rezenkai.com/blue-green-shadow"
+
+// newTenantUpgradeCmd drives staged service upgrades: it snapshots the
+// current versions into Status.UpgradeHistory for rollback, then applies
+// one of four strategies (rolling, recreate, canary, blue-green), polling a
+// health gate between steps and automatically reverting on failure.
+func newTenantUpgradeCmd(cfg *config.Config) *cobra.Command {
+	var (
+		service         string
+		version         string
+		all             bool
+		wait            bool
+		strategy        string
+		canarySteps     int32
+		stepDuration    time.Duration
+		healthCheck     string
+		prometheusURL   string
+		timeout         time.Duration
+		conflictRetries int
+		dryRun          string
+		pollFallback    bool
+	)
+	cmd := &cobra.Command{
+		Use:   "upgrade NAME",
+		Short: "Upgrade tenant services",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, span := tracing.StartCommandSpan(context.Background(), "upgrade")
+			defer span.End()
+			c, err := kube.NewClient(cfg)
+			if err != nil {
+				return fmt.Errorf("failed to create kubernetes client: %w", err)
+			}
+			if dryRun != "" && dryRun != "server" {
+				return fmt.Errorf("invalid --dry-run value: %s (must be 'server')", dryRun)
+			}
+			name := args[0]
+			nn := types.NamespacedName{Name: name, Namespace: cfg.Namespace}
+			opts := kube.MutateOptions{MaxRetries: conflictRetries, DryRun: dryRun == "server"}
+
+			tenant := &tenantv1alpha1.Tenant{}
+			if err := c.Get(ctx, nn, tenant); err != nil {
+				return fmt.Errorf("failed to get tenant: %w", err)
+			}
+			switch strategy {
+			case "rolling", "recreate", "canary", "blue-green":
+			default:
+				return fmt.Errorf("invalid strategy: %s (must be 'rolling', 'recreate', 'canary', or 'blue-green')", strategy)
+			}
+
+			targets, err := upgradeTargets(tenant, service, all)
+			if err != nil {
+				return err
+			}
+
+			gate := &healthGate{
+				client:        c,
+				healthCheck:   healthCheck,
+				prometheusURL: prometheusURL,
+			}
+
+			record := tenantv1alpha1.UpgradeRecord{
+				Timestamp:        metav1.Now(),
+				Strategy:         strategy,
+				PreviousVersions: map[string]string{},
+				Services:         targets,
+			}
+			for _, svcName := range targets {
+				for _, svc := range tenant.Spec.Services {
+					if svc.Name == svcName {
+						record.PreviousVersions[svcName] = svc.Version
+					}
+				}
+			}
+			if !opts.DryRun {
+				tenant.Status.UpgradeHistory = append(tenant.Status.UpgradeHistory, record)
+				if err := c.Status().Update(ctx, tenant); err != nil {
+					return fmt.Errorf("failed to record upgrade history: %w", err)
+				}
+			}
+
+			switch strategy {
+			case "canary":
+				err = runCanaryUpgrade(ctx, c, gate, nn, opts, tenant, targets, version, canarySteps, stepDuration, timeout, cfg.Logger)
+			case "blue-green":
+				err = runBlueGreenUpgrade(ctx, c, gate, nn, opts, tenant, targets, version, timeout, cfg.Logger)
+			default:
+				err = runDirectUpgrade(ctx, c, nn, opts, tenant, targets, version, strategy, cfg.Logger)
+			}
+			if err != nil {
+				if opts.DryRun {
+					return fmt.Errorf("upgrade dry run failed: %w", err)
+				}
+				if rollbackErr := rollbackUpgrade(ctx, c, nn, tenant, record, cfg.Logger); rollbackErr != nil {
+					return fmt.Errorf("upgrade failed: %w (rollback also failed: %v)", err, rollbackErr)
+				}
+				return fmt.Errorf("upgrade failed, rolled back to previous versions: %w", err)
+			}
+			if opts.DryRun {
+				cfg.Logger.Info("upgrade validated by the server (dry run, nothing persisted)", "tenant.name", name)
+				return nil
+			}
+
+			if wait {
+				cfg.Logger.Info("waiting for upgrade to complete", "tenant.name", name)
+				if err := waitForTenant(ctx, c, tenant.Name, tenant.Namespace, timeout, pollFallback); err != nil {
+					if rollbackErr := rollbackUpgrade(ctx, c, nn, tenant, record, cfg.Logger); rollbackErr != nil {
+						return fmt.Errorf("error waiting for upgrade: %w (rollback also failed: %v)", err, rollbackErr)
+					}
+					return fmt.Errorf("error waiting for upgrade, rolled back to previous versions: %w", err)
+				}
+				cfg.Logger.Info("upgrade completed successfully", "tenant.name", name)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&service, "service", "", "Service to upgrade")
+	cmd.Flags().StringVar(&version, "version", "", "Target version")
+	cmd.Flags().BoolVar(&all, "all", false, "Upgrade all services")
+	cmd.Flags().BoolVar(&wait, "wait", false, "Wait for upgrade to complete")
+	cmd.Flags().StringVar(&strategy, "strategy", "rolling", "Upgrade strategy (rolling, recreate, canary, blue-green)")
+	cmd.Flags().Int32Var(&canarySteps, "canary-steps", 4, "Number of promotion steps for --strategy=canary")
+	cmd.Flags().DurationVar(&stepDuration, "step-duration", 2*time.Minute, "How long each canary step bakes before the gate is re-checked")
+	cmd.Flags().StringVar(&healthCheck, "health-check", "", "Optional PromQL expression queried against --prometheus-url; the gate fails if the query errors or returns a non-200 status (the result value is not inspected)")
+	cmd.Flags().StringVar(&prometheusURL, "prometheus-url", "", "Prometheus base URL to evaluate --health-check against")
+	cmd.Flags().DurationVar(&timeout, "timeout", 10*time.Minute, "How long to wait for the upgrade to complete before rolling back")
+	cmd.Flags().IntVar(&conflictRetries, "conflict-retries", 5, "How many times to retry on a 409 Conflict")
+	cmd.Flags().StringVar(&dryRun, "dry-run", "", "Set to 'server' to preview the upgrade without persisting it")
+	cmd.Flags().BoolVar(&pollFallback, "poll-fallback", false, "Poll on a 2s ticker instead of watching (for API servers that reject long-lived watches)")
+	cmd.MarkFlagRequired("version")
+	return cmd
+}
+
+// upgradeTargets resolves which service names an upgrade invocation applies
+// to, validating --service/--all the same way newTenantScaleCmd does.
+func upgradeTargets(tenant *tenantv1alpha1.Tenant, service string, all bool) ([]string, error) {
+	if all {
+		names := make([]string, 0, len(tenant.Spec.Services))
+		for _, svc := range tenant.Spec.Services {
+			names = append(names, svc.Name)
+		}
+		return names, nil
+	}
+	if service == "" {
+		return nil, fmt.Errorf("either --service or --all must be specified")
+	}
+	for _, svc := range tenant.Spec.Services {
+		if svc.Name == service {
+			return []string{service}, nil
+		}
+	}
+	return nil, fmt.Errorf("service '%s' not found in tenant", service)
+}
+
+// runDirectUpgrade implements the rolling/recreate strategies: the version
+// bump is applied in one shot and the cluster's own Deployment strategy
+// (RollingUpdate vs Recreate) governs how pods turn over.
+func runDirectUpgrade(ctx context.Context, c client.Client, nn types.NamespacedName, opts kube.MutateOptions, tenant *tenantv1alpha1.Tenant, targets []string, version, strategy string, logger log.Logger) error {
+	updated, err := kube.MutateTenantWithRetry(ctx, c, nn, opts, func(t *tenantv1alpha1.Tenant) error {
+		applyVersions(t, targets, version)
+		stampUpgradeAnnotations(t, strategy)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, svcName := range targets {
+		logger.Info("upgrading service", "service", svcName, "version", version, "strategy", strategy)
+	}
+	*tenant = *updated
+	return nil
+}
+
+// runCanaryUpgrade stages each target service's CanarySpec and promotes it
+// in canarySteps increments, re-checking the health gate after every step
+// bakes for stepDuration. The overall attempt is bounded by timeout.
+func runCanaryUpgrade(ctx context.Context, c client.Client, gate *healthGate, nn types.NamespacedName, opts kube.MutateOptions, tenant *tenantv1alpha1.Tenant, targets []string, version string, steps int32, stepDuration, timeout time.Duration, logger log.Logger) error {
+	if steps < 1 {
+		steps = 1
+	}
+	deadline, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	updated, err := kube.MutateTenantWithRetry(deadline, c, nn, opts, func(t *tenantv1alpha1.Tenant) error {
+		for _, svcName := range targets {
+			for i, svc := range t.Spec.Services {
+				if svc.Name == svcName {
+					t.Spec.Services[i].Canary = &tenantv1alpha1.CanarySpec{
+						TargetVersion: version,
+						Weight:        0,
+						Steps:         steps,
+						StepDuration:  metav1.Duration{Duration: stepDuration},
+					}
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to stage canary: %w", err)
+	}
+	*tenant = *updated
+	if opts.DryRun {
+		return nil
+	}
+
+	stepWeight := int32(100) / steps
+	for step := int32(1); step <= steps; step++ {
+		weight := stepWeight * step
+		if step == steps {
+			weight = 100
+		}
+		stepCtx, pollSpan := tracing.StartPollSpan(deadline, "tenant.upgrade.canary_step",
+			attribute.String("tenant.name", tenant.Name),
+			attribute.String("tenant.phase", tenant.Status.Phase),
+		)
+		logger.Info("canary step", "step", step, "of", steps, "weight_percent", weight, "version", version)
+		updated, err := kube.MutateTenantWithRetry(deadline, c, nn, opts, func(t *tenantv1alpha1.Tenant) error {
+			for _, svcName := range targets {
+				for i, svc := range t.Spec.Services {
+					if svc.Name == svcName && svc.Canary != nil {
+						t.Spec.Services[i].Canary.Weight = weight
+					}
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			pollSpan.End()
+			return fmt.Errorf("failed to advance canary to %d%%: %w", weight, err)
+		}
+		*tenant = *updated
+
+		select {
+		case <-stepCtx.Done():
+			pollSpan.End()
+			return fmt.Errorf("timed out waiting for canary step %d/%d to bake: %w", step, steps, deadline.Err())
+		case <-time.After(stepDuration):
+		}
+
+		if err := gate.check(deadline, tenant, targets); err != nil {
+			pollSpan.End()
+			return fmt.Errorf("health gate failed at canary step %d/%d (%d%%): %w", step, steps, weight, err)
+		}
+		pollSpan.End()
+	}
+
+	updated, err = kube.MutateTenantWithRetry(deadline, c, nn, opts, func(t *tenantv1alpha1.Tenant) error {
+		applyVersions(t, targets, version)
+		for _, svcName := range targets {
+			for i, svc := range t.Spec.Services {
+				if svc.Name == svcName {
+					t.Spec.Services[i].Canary = nil
+				}
+			}
+		}
+		stampUpgradeAnnotations(t, "canary")
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to finalize canary rollout: %w", err)
+	}
+	*tenant = *updated
+	return nil
+}
+
+// runBlueGreenUpgrade stages a shadow service set under blueGreenAnnotation,
+// waits for it to pass the health gate, then swaps traffic by applying the
+// new version to the live services and clearing the annotation atomically
+// in a single Update.
+func runBlueGreenUpgrade(ctx context.Context, c client.Client, gate *healthGate, nn types.NamespacedName, opts kube.MutateOptions, tenant *tenantv1alpha1.Tenant, targets []string, version string, timeout time.Duration, logger log.Logger) error {
+	deadline, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	logger.Info("provisioning shadow service set", "version", version)
+	updated, err := kube.MutateTenantWithRetry(deadline, c, nn, opts, func(t *tenantv1alpha1.Tenant) error {
+		if t.Annotations == nil {
+			t.Annotations = make(map[string]string)
+		}
+		t.Annotations[blueGreenAnnotation] = version
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to stage blue-green shadow: %w", err)
+	}
+	*tenant = *updated
+	if opts.DryRun {
+		return nil
+	}
+
+	if err := gate.check(deadline, tenant, targets); err != nil {
+		return fmt.Errorf("shadow service set failed the health gate: %w", err)
+	}
+
+	logger.Info("swapping traffic", "version", version)
+	updated, err = kube.MutateTenantWithRetry(deadline, c, nn, opts, func(t *tenantv1alpha1.Tenant) error {
+		applyVersions(t, targets, version)
+		delete(t.Annotations, blueGreenAnnotation)
+		stampUpgradeAnnotations(t, "blue-green")
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to swap traffic to %s: %w", version, err)
+	}
+	*tenant = *updated
+	return nil
+}
+
+// applyVersions sets Version on each target service in place.
+func applyVersions(t *tenantv1alpha1.Tenant, targets []string, version string) {
+	for _, svcName := range targets {
+		for i, svc := range t.Spec.Services {
+			if svc.Name == svcName {
+				t.Spec.Services[i].Version = version
+			}
+		}
+	}
+}
+
+// stampUpgradeAnnotations records the strategy and timestamp of the upgrade
+// that just landed, mirroring the annotations the original naive upgrade
+// command set.
+func stampUpgradeAnnotations(t *tenantv1alpha1.Tenant, strategy string) {
+	if t.Annotations == nil {
+		t.Annotations = make(map[string]string)
+	}
+	t.Annotations["tenant.rezenkai.com/upgrade-strategy"] = strategy
+	t.Annotations["tenant.rezenkai.com/upgrade-timestamp"] = time.Now().Format(time.RFC3339)
+}
+
+// rollbackUpgrade reverts targets back to record.PreviousVersions and
+// clears any in-progress canary/blue-green staging, marking the history
+// entry as rolled back so `tenant get` can surface what happened.
+func rollbackUpgrade(ctx context.Context, c client.Client, nn types.NamespacedName, tenant *tenantv1alpha1.Tenant, record tenantv1alpha1.UpgradeRecord, logger log.Logger) error {
+	logger.Info("rolling back upgrade", "services", len(record.Services))
+	updated, err := kube.MutateTenantWithRetry(ctx, c, nn, kube.MutateOptions{}, func(t *tenantv1alpha1.Tenant) error {
+		for _, svcName := range record.Services {
+			prevVersion, ok := record.PreviousVersions[svcName]
+			if !ok {
+				continue
+			}
+			for i, svc := range t.Spec.Services {
+				if svc.Name == svcName {
+					t.Spec.Services[i].Version = prevVersion
+					t.Spec.Services[i].Canary = nil
+					logger.Info("reverted service", "service", svcName, "version", prevVersion)
+				}
+			}
+		}
+		if t.Annotations != nil {
+			delete(t.Annotations, blueGreenAnnotation)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	*tenant = *updated
+
+	if n := len(tenant.Status.UpgradeHistory); n > 0 {
+		tenant.Status.UpgradeHistory[n-1].RolledBack = true
+		if err := c.Status().Update(ctx, tenant); err != nil {
+			return fmt.Errorf("reverted spec but failed to mark upgrade history as rolled back: %w", err)
+		}
+	}
+	return nil
+}
+
+// healthGate polls the signals a staged rollout promotes or rolls back on:
+// each target service's reported readiness, an HTTP GET against the
+// tenant's URL, and an optional PromQL expression.
+type healthGate struct {
+	client        client.Client
+	healthCheck   string
+	prometheusURL string
+}
+
+func (g *healthGate) check(ctx context.Context, tenant *tenantv1alpha1.Tenant, targets []string) error {
+	current := &tenantv1alpha1.Tenant{}
+	if err := g.client.Get(ctx, types.NamespacedName{Name: tenant.Name, Namespace: tenant.Namespace}, current); err != nil {
+		return fmt.Errorf("failed to read tenant status: %w", err)
+	}
+	for _, svcName := range targets {
+		ready := false
+		for _, status := range current.Status.Services {
+			if status.Name == svcName {
+				ready = status.Ready
+			}
+		}
+		if !ready {
+			return fmt.Errorf("service '%s' is not reporting Ready", svcName)
+		}
+	}
+
+	if current.Status.URL != "" {
+		if err := checkHTTPReadiness(ctx, current.Status.URL); err != nil {
+			return err
+		}
+	}
+
+	if g.healthCheck != "" {
+		if err := g.checkPromQL(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func checkHTTPReadiness(ctx context.Context, url string) error {
+	httpClient := &http.Client{Timeout: 5 * time.Second}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to build readiness request: %w", err)
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("readiness check against %s failed: %w", url, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 400 {
+		return fmt.Errorf("readiness check against %s returned status %d", url, resp.StatusCode)
+	}
+	return nil
+}
+
+// checkPromQL evaluates g.healthCheck against g.prometheusURL's instant
+// query API. Prometheus returns 200 even for a query with no results, so
+// this only catches unreachable/misconfigured Prometheus instances and
+// malformed expressions; inspecting the returned vector for thresholds is
+// left to a future request.
+func (g *healthGate) checkPromQL(ctx context.Context) error {
+	if g.prometheusURL == "" {
+		return fmt.Errorf("--health-check was given but --prometheus-url is empty")
+	}
+	httpClient := &http.Client{Timeout: 10 * time.Second}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, g.prometheusURL+"/api/v1/query", nil)
+	if err != nil {
+		return fmt.Errorf("failed to build PromQL request: %w", err)
+	}
+	q := req.URL.Query()
+	q.Set("query", g.healthCheck)
+	req.URL.RawQuery = q.Encode()
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("PromQL health check request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("PromQL health check %q returned status %d", g.healthCheck, resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+// Package completion implements dynamic shell-completion for tenantctl's
+// cluster-aware flags and arguments: --context (from the resolved
+// kubeconfig), --namespace (from the live cluster), and the tenant-name
+// positional argument/flag shared by several command groups. Every lookup
+// degrades to no completions, rather than an error, when the cluster isn't
+// reachable.
+package completion
+
+import (
+	"context"
+	"time"
+
+	tenantv1alpha1 "github.com/rezenkai/multi-saas-crm/tenant-orchestrator/api/v1alpha1"
+	"github.com/rezenkai/multi-saas-crm/tenant-orchestrator/cli/internal/config"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// timeout bounds how long a completion request may block on the network;
+// shells expect completions back almost instantly.
+const timeout = 2 * time.Second
+
+// Contexts completes --context from the contexts defined in the resolved
+// kubeconfig, honoring --kubeconfig if it was already typed earlier on the
+// command line.
+func Contexts(cfg *config.Config) func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		kubeconfig := flagOrDefault(cmd, "kubeconfig", cfg.KubeConfig)
+		apiCfg, err := config.ClientConfig(kubeconfig, "").RawConfig()
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		names := make([]string, 0, len(apiCfg.Contexts))
+		for name := range apiCfg.Contexts {
+			names = append(names, name)
+		}
+		return names, cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// Namespaces completes --namespace by listing Namespace resources in the
+// cluster selected by any --context/--kubeconfig already typed on the line.
+func Namespaces(cfg *config.Config) func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		restCfg, err := restConfig(cmd, cfg)
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		clientset, err := kubernetes.NewForConfig(restCfg)
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+		list, err := clientset.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		names := make([]string, 0, len(list.Items))
+		for _, ns := range list.Items {
+			names = append(names, ns.Name)
+		}
+		return names, cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// TenantNames completes a tenant name, either as a positional argument (only
+// offered while no tenant has been typed yet) or as the value of a --tenant
+// flag, by listing Tenant CRs in the namespace selected by
+// --namespace/--context/--kubeconfig.
+func TenantNames(cfg *config.Config) func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) > 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		restCfg, err := restConfig(cmd, cfg)
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		c, err := client.New(restCfg, client.Options{Scheme: tenantv1alpha1.Scheme})
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+		tenants := &tenantv1alpha1.TenantList{}
+		namespace := flagOrDefault(cmd, "namespace", cfg.Namespace)
+		if err := c.List(ctx, tenants, client.InNamespace(namespace)); err != nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		names := make([]string, 0, len(tenants.Items))
+		for _, t := range tenants.Items {
+			names = append(names, t.Name)
+		}
+		return names, cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// restConfig builds the same *rest.Config kube.NewClient would, but reads
+// --kubeconfig/--context straight off cmd so it reflects whatever the user
+// already typed on this command line rather than cfg's (possibly still
+// zero-value, since Load hasn't run during shell completion) fields.
+func restConfig(cmd *cobra.Command, cfg *config.Config) (*rest.Config, error) {
+	kubeconfig := flagOrDefault(cmd, "kubeconfig", cfg.KubeConfig)
+	kubeContext := flagOrDefault(cmd, "context", cfg.Context)
+	return config.RestConfigFrom(config.ClientConfig(kubeconfig, kubeContext))
+}
+
+// flagOrDefault returns the current value of the named flag on cmd (which,
+// for persistent flags, reflects anything already parsed earlier on the
+// command line), falling back to def if the flag isn't registered or hasn't
+// been set.
+func flagOrDefault(cmd *cobra.Command, name, def string) string {
+	f := cmd.Flags().Lookup(name)
+	if f == nil || !f.Changed {
+		return def
+	}
+	return f.Value.String()
+}
@@ -1,9 +1,14 @@
 package config
 
 import (
-	"os"
+    "fmt"
+    "os"
+    "path/filepath"
 
-	"k8s.io/client-go/tools/clientcmd"
+    "github.com/rezenkai/multi-saas-crm/tenant-orchestrator/cli/internal/log"
+    "k8s.io/client-go/rest"
+    "k8s.io/client-go/tools/clientcmd"
+    "k8s.io/client-go/tools/clientcmd/api"
 )
 
 // Config holds CLI configuration
@@ -12,27 +17,92 @@ type Config struct {
     Context    string
     Namespace  string
     Verbose    bool
+    // LogFormat selects the logger's wire format ("text" or "json").
+    LogFormat string
+    // Logger is initialized from LogFormat/Verbose once flags are parsed;
+    // commands log through it instead of fmt.Println/fmt.Printf.
+    Logger log.Logger
+    // Language overrides the i18n catalog CLI output is translated from
+    // (e.g. "es"); empty uses LC_MESSAGES/LANG, falling back to English.
+    Language string
+
+    // clientConfig is the merged kubeconfig resolved by Load; RestConfig and
+    // RawConfig read through it instead of commands re-deriving loading
+    // rules themselves.
+    clientConfig clientcmd.ClientConfig
 }
 
 // New creates a new Config instance
 func New() *Config {
     return &Config{
         Namespace: "tenant-system",
+        LogFormat: "text",
     }
 }
 
-// Load loads the configuration from flags and environment
-func (c *Config) Load() error {
-    if c.KubeConfig == "" {
-        home, err := os.UserHomeDir()
-        if err != nil {
-            return err
+// Load resolves the kubeconfig to use via client-go's standard merged
+// loading rules: --kubeconfig takes precedence, otherwise KUBECONFIG is
+// split and merged the same way kubectl does, falling back to
+// ~/.kube/config. namespaceExplicit should be the result of
+// cmd.Flags().Changed("namespace"); when false, Load overwrites c.Namespace
+// with the kubeconfig context's default namespace, if it set one.
+func (c *Config) Load(namespaceExplicit bool) error {
+    c.clientConfig = ClientConfig(c.KubeConfig, c.Context)
+
+    if !namespaceExplicit {
+        if ns, _, err := c.clientConfig.Namespace(); err == nil && ns != "" {
+            c.Namespace = ns
         }
-        c.KubeConfig = home + "/.kube/config"
     }
-    if _, err := os.Stat(c.KubeConfig); os.IsNotExist(err) {
-        return nil // Kubeconfig is optional; will use in-cluster config if available
+    return nil
+}
+
+// ClientConfig builds the merged clientcmd.ClientConfig for kubeconfigPath/
+// kubeContext, honoring the KUBECONFIG environment variable the same way
+// Load does. It's exported so code that needs kubeconfig access before Load
+// has run (e.g. shell-completion functions re-parsing flags typed earlier on
+// the command line) doesn't have to rebuild the loading rules itself.
+func ClientConfig(kubeconfigPath, kubeContext string) clientcmd.ClientConfig {
+    rules := clientcmd.NewDefaultClientConfigLoadingRules()
+    rules.ExplicitPath = kubeconfigPath
+    if kubeconfigEnv := os.Getenv("KUBECONFIG"); kubeconfigEnv != "" {
+        rules.Precedence = filepath.SplitList(kubeconfigEnv)
+    }
+    overrides := &clientcmd.ConfigOverrides{}
+    if kubeContext != "" {
+        overrides.CurrentContext = kubeContext
+    }
+    return clientcmd.NewNonInteractiveDeferredLoadingClientConfig(rules, overrides)
+}
+
+// RestConfigFrom builds a *rest.Config from clientConfig, falling back to
+// rest.InClusterConfig when no kubeconfig was found at all.
+func RestConfigFrom(clientConfig clientcmd.ClientConfig) (*rest.Config, error) {
+    restCfg, err := clientConfig.ClientConfig()
+    if err == nil {
+        return restCfg, nil
+    }
+    if !clientcmd.IsEmptyConfig(err) {
+        return nil, err
+    }
+    return rest.InClusterConfig()
+}
+
+// RestConfig returns the *rest.Config for the kubeconfig/context resolved by
+// Load, transparently falling back to in-cluster config when none was
+// found.
+func (c *Config) RestConfig() (*rest.Config, error) {
+    if c.clientConfig == nil {
+        return nil, fmt.Errorf("config not loaded")
+    }
+    return RestConfigFrom(c.clientConfig)
+}
+
+// RawConfig returns the merged, raw api.Config backing RestConfig, e.g. for
+// enumerating known contexts in shell completion.
+func (c *Config) RawConfig() (api.Config, error) {
+    if c.clientConfig == nil {
+        return api.Config{}, fmt.Errorf("config not loaded")
     }
-    _, err := clientcmd.NewDefaultClientConfigLoadingRules().Load() // Ignore ClientConfig
-    return err
-}
\ No newline at end of file
+    return c.clientConfig.RawConfig()
+}
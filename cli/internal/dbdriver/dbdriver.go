@@ -0,0 +1,301 @@
+// Package dbdriver restores plain-SQL dumps directly through database/sql
+// instead of shelling out to psql/mysql, so the CLI can pipe in a backup
+// from anywhere (a local file, a decompressed object-storage stream) and
+// report real progress instead of blocking silently until the subprocess
+// exits.
+package dbdriver
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// Statement is one parsed SQL statement, plus how many bytes of the
+// original input it consumed (including its trailing terminator), used to
+// report restore progress.
+type Statement struct {
+	SQL   string
+	Bytes int
+}
+
+// SplitStatements parses r's SQL text into individual statements. It
+// respects single- and double-quoted strings, line (--) and block (/* */)
+// comments, Postgres's dollar-quoted blocks ($$...$$ or $tag$...$tag$, used
+// by function bodies), and MySQL's DELIMITER meta-command, which changes
+// the statement terminator for stored-routine definitions.
+func SplitStatements(r io.Reader, dbType string) ([]Statement, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	if dbType == "mysql" {
+		return splitMySQLStatements(string(data)), nil
+	}
+	return splitPostgresStatements(string(data)), nil
+}
+
+func splitPostgresStatements(s string) []Statement {
+	var stmts []Statement
+	start := 0
+	i := 0
+	n := len(s)
+	for i < n {
+		switch s[i] {
+		case '-':
+			if i+1 < n && s[i+1] == '-' {
+				for i < n && s[i] != '\n' {
+					i++
+				}
+				continue
+			}
+			i++
+		case '/':
+			if i+1 < n && s[i+1] == '*' {
+				i += 2
+				for i+1 < n && !(s[i] == '*' && s[i+1] == '/') {
+					i++
+				}
+				i += 2
+				if i > n {
+					i = n
+				}
+				continue
+			}
+			i++
+		case '\'', '"':
+			quote := s[i]
+			i++
+			for i < n {
+				if s[i] == quote {
+					if i+1 < n && s[i+1] == quote { // doubled-quote escape
+						i += 2
+						continue
+					}
+					i++
+					break
+				}
+				i++
+			}
+		case '$':
+			if tag, ok := matchDollarTag(s, i); ok {
+				i += len(tag)
+				if end := strings.Index(s[i:], tag); end >= 0 {
+					i += end + len(tag)
+				} else {
+					i = n
+				}
+				continue
+			}
+			i++
+		case ';':
+			if stmt := strings.TrimSpace(s[start:i]); stmt != "" {
+				stmts = append(stmts, Statement{SQL: stmt, Bytes: i + 1 - start})
+			}
+			i++
+			start = i
+			continue
+		default:
+			i++
+		}
+	}
+	if stmt := strings.TrimSpace(s[start:]); stmt != "" {
+		stmts = append(stmts, Statement{SQL: stmt, Bytes: n - start})
+	}
+	return stmts
+}
+
+// matchDollarTag reports whether s[i:] begins a Postgres dollar-quote
+// delimiter ($$ or $tag$), returning the full delimiter.
+func matchDollarTag(s string, i int) (string, bool) {
+	j := i + 1
+	for j < len(s) && isDollarTagByte(s[j]) {
+		j++
+	}
+	if j < len(s) && s[j] == '$' {
+		return s[i : j+1], true
+	}
+	return "", false
+}
+
+func isDollarTagByte(b byte) bool {
+	return b == '_' || (b >= 'a' && b <= 'z') || (b >= 'A' && b <= 'Z') || (b >= '0' && b <= '9')
+}
+
+func splitMySQLStatements(s string) []Statement {
+	var stmts []Statement
+	delim := ";"
+	var buf strings.Builder
+
+	flush := func() {
+		if stmt := strings.TrimSpace(buf.String()); stmt != "" {
+			stmts = append(stmts, Statement{SQL: stmt, Bytes: len(buf.String())})
+		}
+		buf.Reset()
+	}
+
+	for _, line := range strings.Split(s, "\n") {
+		if upper := strings.ToUpper(strings.TrimSpace(line)); strings.HasPrefix(upper, "DELIMITER ") {
+			flush()
+			delim = strings.TrimSpace(line[strings.Index(upper, "DELIMITER ")+len("DELIMITER "):])
+			continue
+		}
+		buf.WriteString(line)
+		buf.WriteString("\n")
+		for {
+			content := buf.String()
+			idx := findUnquoted(content, delim)
+			if idx == -1 {
+				break
+			}
+			if stmt := strings.TrimSpace(content[:idx]); stmt != "" {
+				stmts = append(stmts, Statement{SQL: stmt, Bytes: idx + len(delim)})
+			}
+			buf.Reset()
+			buf.WriteString(content[idx+len(delim):])
+		}
+	}
+	flush()
+	return stmts
+}
+
+// findUnquoted returns the byte offset of the first occurrence of delim in
+// s that isn't inside a quoted string, backtick-quoted identifier, or
+// comment, or -1 if delim doesn't occur outside one.
+func findUnquoted(s, delim string) int {
+	n := len(s)
+	for i := 0; i < n; i++ {
+		switch s[i] {
+		case '\'', '"', '`':
+			quote := s[i]
+			i++
+			for i < n {
+				if s[i] == '\\' && i+1 < n {
+					i++
+				} else if s[i] == quote {
+					break
+				}
+				i++
+			}
+			continue
+		case '-':
+			if i+1 < n && s[i+1] == '-' {
+				for i < n && s[i] != '\n' {
+					i++
+				}
+				continue
+			}
+		case '/':
+			if i+1 < n && s[i+1] == '*' {
+				i += 2
+				for i+1 < n && !(s[i] == '*' && s[i+1] == '/') {
+					i++
+				}
+				i++
+				continue
+			}
+		}
+		if strings.HasPrefix(s[i:], delim) {
+			return i
+		}
+	}
+	return -1
+}
+
+// Options governs how Restore applies a parsed statement list.
+type Options struct {
+	// SingleTransaction runs every statement inside one transaction,
+	// rolling the whole thing back on the first failure. This is the safe
+	// default; the shell-based restore it replaces left a partially
+	// applied database behind on any mid-dump error.
+	SingleTransaction bool
+	// StopOnError aborts on the first failing statement instead of
+	// continuing past it. Only consulted when SingleTransaction is false,
+	// since a single transaction always aborts as a whole on any error.
+	StopOnError bool
+	// ParallelJobs is passed through by callers that shell out to
+	// pg_restore for custom-format dumps (pg_restore -j); Restore itself
+	// always applies statements sequentially within its transaction.
+	ParallelJobs int
+}
+
+// Progress reports how far a Restore call has gotten, for callers that
+// want to print something like "n/total statements, x MB/s".
+type Progress struct {
+	Index      int
+	Total      int
+	BytesDone  int64
+	BytesTotal int64
+	Elapsed    time.Duration
+}
+
+// ProgressFunc receives a Progress update after every statement Restore executes.
+type ProgressFunc func(Progress)
+
+// Restore executes statements against db according to opts, invoking
+// onProgress (if non-nil) after each one.
+func Restore(ctx context.Context, db *sql.DB, statements []Statement, opts Options, onProgress ProgressFunc) error {
+	var bytesTotal int64
+	for _, stmt := range statements {
+		bytesTotal += int64(stmt.Bytes)
+	}
+
+	var tx *sql.Tx
+	if opts.SingleTransaction {
+		var err error
+		tx, err = db.BeginTx(ctx, nil)
+		if err != nil {
+			return fmt.Errorf("failed to begin restore transaction: %w", err)
+		}
+	}
+
+	start := time.Now()
+	var bytesDone int64
+	var failures int
+	var firstErr error
+	for i, stmt := range statements {
+		var execErr error
+		if tx != nil {
+			_, execErr = tx.ExecContext(ctx, stmt.SQL)
+		} else {
+			_, execErr = db.ExecContext(ctx, stmt.SQL)
+		}
+		bytesDone += int64(stmt.Bytes)
+		if onProgress != nil {
+			onProgress(Progress{
+				Index:      i + 1,
+				Total:      len(statements),
+				BytesDone:  bytesDone,
+				BytesTotal: bytesTotal,
+				Elapsed:    time.Since(start),
+			})
+		}
+		if execErr == nil {
+			continue
+		}
+		if tx != nil {
+			tx.Rollback()
+			return fmt.Errorf("statement %d/%d failed, rolled back entire restore: %w", i+1, len(statements), execErr)
+		}
+		failures++
+		if firstErr == nil {
+			firstErr = execErr
+		}
+		if opts.StopOnError {
+			return fmt.Errorf("statement %d/%d failed: %w", i+1, len(statements), execErr)
+		}
+	}
+
+	if tx != nil {
+		if err := tx.Commit(); err != nil {
+			return fmt.Errorf("failed to commit restore: %w", err)
+		}
+	}
+	if failures > 0 {
+		return fmt.Errorf("%d of %d statements failed; first error: %w", failures, len(statements), firstErr)
+	}
+	return nil
+}
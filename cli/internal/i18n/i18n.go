@@ -0,0 +1,156 @@
+// Package i18n provides gettext-style message lookup for CLI output, backed
+// by .po catalogs embedded at build time so translators can contribute new
+// locales without touching Go source.
+package i18n
+
+import (
+	"bufio"
+	"embed"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+//go:embed locales/*.po
+var catalogFS embed.FS
+
+const fallbackLanguage = "en"
+
+var (
+	mu       sync.RWMutex
+	active   = fallbackLanguage
+	catalogs = loadCatalogs()
+)
+
+// loadCatalogs parses every embedded locales/*.po file into msgid->msgstr
+// maps keyed by the locale's base filename (e.g. "es.po" -> "es").
+func loadCatalogs() map[string]map[string]string {
+	entries, err := catalogFS.ReadDir("locales")
+	if err != nil {
+		return map[string]map[string]string{}
+	}
+	result := make(map[string]map[string]string, len(entries))
+	for _, entry := range entries {
+		lang := strings.TrimSuffix(entry.Name(), ".po")
+		data, err := catalogFS.ReadFile("locales/" + entry.Name())
+		if err != nil {
+			continue
+		}
+		result[lang] = parsePO(data)
+	}
+	return result
+}
+
+// parsePO implements just enough of the .po format to read msgid/msgstr
+// pairs: one id and one (possibly multi-line, quoted) string per entry,
+// comments and the header entry (empty msgid) are skipped.
+func parsePO(data []byte) map[string]string {
+	catalog := make(map[string]string)
+	scanner := bufio.NewScanner(strings.NewReader(string(data)))
+	var msgid, msgstr string
+	var inMsgstr bool
+	flush := func() {
+		if msgid != "" {
+			catalog[msgid] = msgstr
+		}
+		msgid, msgstr = "", ""
+		inMsgstr = false
+	}
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "" || strings.HasPrefix(line, "#"):
+			continue
+		case strings.HasPrefix(line, "msgid "):
+			flush()
+			msgid = unquotePO(strings.TrimPrefix(line, "msgid "))
+		case strings.HasPrefix(line, "msgstr "):
+			inMsgstr = true
+			msgstr = unquotePO(strings.TrimPrefix(line, "msgstr "))
+		case strings.HasPrefix(line, `"`) && inMsgstr:
+			msgstr += unquotePO(line)
+		case strings.HasPrefix(line, `"`):
+			msgid += unquotePO(line)
+		}
+	}
+	flush()
+	delete(catalog, "")
+	return catalog
+}
+
+func unquotePO(s string) string {
+	unquoted, err := strconv.Unquote(s)
+	if err != nil {
+		return strings.Trim(s, `"`)
+	}
+	return unquoted
+}
+
+// Init resolves the active language with explicit > LC_MESSAGES > LANG >
+// English precedence and records it for subsequent T calls. explicit is the
+// value of the --language flag, empty if unset.
+func Init(explicit string) {
+	lang := explicit
+	if lang == "" {
+		lang = firstNonEmpty(os.Getenv("LC_MESSAGES"), os.Getenv("LANG"))
+	}
+	SetLanguage(normalizeLanguage(lang))
+}
+
+// SetLanguage sets the active language directly, e.g. for tests.
+func SetLanguage(lang string) {
+	mu.Lock()
+	defer mu.Unlock()
+	if lang == "" {
+		lang = fallbackLanguage
+	}
+	active = lang
+}
+
+// normalizeLanguage strips locale/encoding suffixes like "es_MX.UTF-8" down
+// to the catalog key "es".
+func normalizeLanguage(lang string) string {
+	lang = strings.SplitN(lang, ".", 2)[0]
+	lang = strings.SplitN(lang, "_", 2)[0]
+	return strings.ToLower(lang)
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// T looks up msgID in the active catalog, falling back to the English
+// catalog and then to msgID itself when no translation exists, formatting
+// the result with args via fmt.Sprintf when any are given.
+func T(msgID string, args ...interface{}) string {
+	mu.RLock()
+	lang := active
+	mu.RUnlock()
+
+	msg := lookup(lang, msgID)
+	if len(args) == 0 {
+		return msg
+	}
+	return fmt.Sprintf(msg, args...)
+}
+
+func lookup(lang, msgID string) string {
+	if catalog, ok := catalogs[lang]; ok {
+		if msg, ok := catalog[msgID]; ok && msg != "" {
+			return msg
+		}
+	}
+	if catalog, ok := catalogs[fallbackLanguage]; ok {
+		if msg, ok := catalog[msgID]; ok && msg != "" {
+			return msg
+		}
+	}
+	return msgID
+}
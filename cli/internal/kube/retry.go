@@ -0,0 +1,71 @@
+package kube
+
+import (
+	"context"
+	"time"
+
+	tenantv1alpha1 "github.com/rezenkai/multi-saas-crm/tenant-orchestrator/api/v1alpha1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	retryBaseBackoff = 100 * time.Millisecond
+	retryMaxBackoff  = 2 * time.Second
+	defaultRetries   = 5
+)
+
+// MutateOptions controls MutateTenantWithRetry's retry count and dry-run
+// behavior.
+type MutateOptions struct {
+	// MaxRetries bounds how many times a conflicting Update is retried.
+	// Zero uses defaultRetries.
+	MaxRetries int
+	// DryRun, when true, issues the Update with DryRunAll so the server-side
+	// admission result can be previewed without persisting anything.
+	DryRun bool
+}
+
+// MutateTenantWithRetry implements the client-go Get/mutate/Update-on-conflict
+// pattern for Tenants: it re-Gets and re-applies mutate whenever Update fails
+// with a Conflict, backing off exponentially between attempts. It returns the
+// Tenant as last written (or as last read, under --dry-run=server).
+func MutateTenantWithRetry(ctx context.Context, c client.Client, nn types.NamespacedName, opts MutateOptions, mutate func(*tenantv1alpha1.Tenant) error) (*tenantv1alpha1.Tenant, error) {
+	maxRetries := opts.MaxRetries
+	if maxRetries <= 0 {
+		maxRetries = defaultRetries
+	}
+	var updateOpts []client.UpdateOption
+	if opts.DryRun {
+		updateOpts = append(updateOpts, client.DryRunAll)
+	}
+
+	backoff := retryBaseBackoff
+	tenant := &tenantv1alpha1.Tenant{}
+	for attempt := 0; ; attempt++ {
+		tenant = &tenantv1alpha1.Tenant{}
+		if err := c.Get(ctx, nn, tenant); err != nil {
+			return nil, err
+		}
+		if err := mutate(tenant); err != nil {
+			return nil, err
+		}
+		err := c.Update(ctx, tenant, updateOpts...)
+		if err == nil {
+			return tenant, nil
+		}
+		if !apierrors.IsConflict(err) || attempt >= maxRetries {
+			return nil, err
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+		if backoff > retryMaxBackoff {
+			backoff = retryMaxBackoff
+		}
+	}
+}
@@ -0,0 +1,21 @@
+package kube
+
+import (
+	"context"
+
+	tenantv1alpha1 "github.com/rezenkai/multi-saas-crm/tenant-orchestrator/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// WatchTenant opens a watch scoped to the single named Tenant via a
+// metadata.name field selector, so `--wait` implementations can react to
+// status transitions instead of polling client.Get on a ticker.
+func WatchTenant(ctx context.Context, c client.WithWatch, nn types.NamespacedName) (watch.Interface, error) {
+	list := &tenantv1alpha1.TenantList{}
+	return c.Watch(ctx, list,
+		client.InNamespace(nn.Namespace),
+		client.MatchingFields{"metadata.name": nn.Name},
+	)
+}
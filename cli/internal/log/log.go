@@ -0,0 +1,114 @@
+// Package log provides the structured logger used across CLI commands so
+// output can be consumed by log aggregators instead of scraped off stdout.
+package log
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+)
+
+// Logger is the structured logging interface CLI commands log through
+// instead of fmt.Println/fmt.Printf.
+type Logger interface {
+	Debug(msg string, kv ...interface{})
+	Info(msg string, kv ...interface{})
+	Warn(msg string, kv ...interface{})
+	Error(msg string, kv ...interface{})
+	// With returns a Logger that prepends kv to every subsequent call,
+	// for attaching fields like tenant.name once per command.
+	With(kv ...interface{}) Logger
+}
+
+// New returns a Logger writing to out. format selects the wire format
+// ("json" for log aggregators, anything else for the human-readable
+// default). verbose controls whether Debug records are emitted.
+func New(out io.Writer, format string, verbose bool) Logger {
+	base := &baseLogger{out: out, verbose: verbose}
+	if format == "json" {
+		return &jsonLogger{baseLogger: base}
+	}
+	return &textLogger{baseLogger: base}
+}
+
+type baseLogger struct {
+	out     io.Writer
+	verbose bool
+	fields  []interface{}
+}
+
+func (b *baseLogger) withFields(kv ...interface{}) *baseLogger {
+	return &baseLogger{
+		out:     b.out,
+		verbose: b.verbose,
+		fields:  append(append([]interface{}{}, b.fields...), kv...),
+	}
+}
+
+type textLogger struct{ *baseLogger }
+
+func (l *textLogger) log(level, msg string, kv ...interface{}) {
+	if level == "debug" && !l.verbose {
+		return
+	}
+	line := fmt.Sprintf("%s [%s] %s", time.Now().Format(time.RFC3339), level, msg)
+	for _, field := range pairs(append(l.fields, kv...)) {
+		line += fmt.Sprintf(" %s=%v", field.key, field.value)
+	}
+	fmt.Fprintln(l.out, line)
+}
+
+func (l *textLogger) Debug(msg string, kv ...interface{}) { l.log("debug", msg, kv...) }
+func (l *textLogger) Info(msg string, kv ...interface{})  { l.log("info", msg, kv...) }
+func (l *textLogger) Warn(msg string, kv ...interface{})  { l.log("warn", msg, kv...) }
+func (l *textLogger) Error(msg string, kv ...interface{}) { l.log("error", msg, kv...) }
+func (l *textLogger) With(kv ...interface{}) Logger {
+	return &textLogger{baseLogger: l.withFields(kv...)}
+}
+
+type jsonLogger struct{ *baseLogger }
+
+func (l *jsonLogger) log(level, msg string, kv ...interface{}) {
+	if level == "debug" && !l.verbose {
+		return
+	}
+	entry := map[string]interface{}{
+		"timestamp": time.Now().Format(time.RFC3339),
+		"level":     level,
+		"message":   msg,
+	}
+	for _, field := range pairs(append(l.fields, kv...)) {
+		entry[field.key] = field.value
+	}
+	data, err := json.Marshal(entry)
+	if err != nil {
+		fmt.Fprintf(l.out, `{"level":"error","message":"failed to marshal log entry: %s"}`+"\n", err)
+		return
+	}
+	fmt.Fprintln(l.out, string(data))
+}
+
+func (l *jsonLogger) Debug(msg string, kv ...interface{}) { l.log("debug", msg, kv...) }
+func (l *jsonLogger) Info(msg string, kv ...interface{})  { l.log("info", msg, kv...) }
+func (l *jsonLogger) Warn(msg string, kv ...interface{})  { l.log("warn", msg, kv...) }
+func (l *jsonLogger) Error(msg string, kv ...interface{}) { l.log("error", msg, kv...) }
+func (l *jsonLogger) With(kv ...interface{}) Logger {
+	return &jsonLogger{baseLogger: l.withFields(kv...)}
+}
+
+type field struct {
+	key   string
+	value interface{}
+}
+
+// pairs interprets kv as alternating key/value arguments, dropping a
+// trailing key left without a value rather than panicking on it.
+func pairs(kv []interface{}) []field {
+	fields := make([]field, 0, len(kv)/2)
+	for i := 0; i+1 < len(kv); i += 2 {
+		key := fmt.Sprintf("%v", kv[i])
+		fields = append(fields, field{key: key, value: kv[i+1]})
+	}
+	return fields
+}
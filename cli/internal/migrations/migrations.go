@@ -0,0 +1,46 @@
+// Package migrations holds Go-coded migration steps for changes pure SQL
+// can't express: data backfills, per-tenant computed columns, or calls into
+// other services made while a migration is in flight. Each one claims a
+// numeric ID in the same sequence as the .sql files under
+// internal/commands/migrations/{postgres,mysql}; "db migrate" merges both
+// sources and runs them in ID order.
+package migrations
+
+import (
+	"database/sql"
+	"fmt"
+	"sort"
+)
+
+// Migration is a single Go-coded migration step. Up and Down run inside a
+// transaction opened on the tenant's database, the same as a .sql file's
+// contents would.
+type Migration struct {
+	ID          uint
+	Description string
+	Up          func(tx *sql.Tx) error
+	Down        func(tx *sql.Tx) error
+}
+
+var registry = make(map[uint]Migration)
+
+// RegisterMigration adds m to the shared registry. Call it from an init()
+// in the package that defines the migration, the same way database/sql
+// drivers register themselves. It panics on a duplicate ID, since that
+// means two migrations are claiming the same position in the sequence.
+func RegisterMigration(m Migration) {
+	if _, exists := registry[m.ID]; exists {
+		panic(fmt.Sprintf("migrations: migration %d already registered", m.ID))
+	}
+	registry[m.ID] = m
+}
+
+// All returns every registered migration, sorted by ID.
+func All() []Migration {
+	out := make([]Migration, 0, len(registry))
+	for _, m := range registry {
+		out = append(out, m)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ID < out[j].ID })
+	return out
+}
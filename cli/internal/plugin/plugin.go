@@ -0,0 +1,106 @@
+// Package plugin implements tenantctl's kubectl-style plugin mechanism:
+// binaries named "tenantctl-<name>" found on PATH are invoked as if they
+// were built-in subcommands.
+package plugin
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"syscall"
+)
+
+// PluginHandler locates and runs a plugin executable for an unrecognized
+// subcommand name.
+type PluginHandler interface {
+	// Lookup returns the full path of the "<prefix>-filename" executable
+	// for one of the handler's valid prefixes, if one exists on PATH.
+	Lookup(filename string) (string, bool)
+	// Execute replaces (or, on Windows, runs and waits for) the current
+	// process with executablePath, passing cmdArgs and environment.
+	Execute(executablePath string, cmdArgs, environment []string) error
+}
+
+// DefaultPluginHandler looks plugins up by joining the configured prefixes
+// to the candidate filename with a dash, e.g. prefix "tenantctl" + filename
+// "foo-bar" -> "tenantctl-foo-bar".
+type DefaultPluginHandler struct {
+	ValidPrefixes []string
+}
+
+// NewDefaultPluginHandler returns a DefaultPluginHandler for validPrefixes.
+func NewDefaultPluginHandler(validPrefixes []string) *DefaultPluginHandler {
+	return &DefaultPluginHandler{ValidPrefixes: validPrefixes}
+}
+
+// Lookup implements PluginHandler.
+func (h *DefaultPluginHandler) Lookup(filename string) (string, bool) {
+	for _, prefix := range h.ValidPrefixes {
+		path, err := exec.LookPath(fmt.Sprintf("%s-%s", prefix, filename))
+		if err != nil || path == "" {
+			continue
+		}
+		return path, true
+	}
+	return "", false
+}
+
+// Execute implements PluginHandler. On POSIX systems it replaces the current
+// process via exec(3) so the plugin inherits tenantctl's stdio and PID;
+// Windows has no equivalent syscall, so there it runs the plugin as a child
+// process and exits with its status.
+func (h *DefaultPluginHandler) Execute(executablePath string, cmdArgs, environment []string) error {
+	if runtime.GOOS != "windows" {
+		return syscall.Exec(executablePath, append([]string{executablePath}, cmdArgs...), environment)
+	}
+
+	cmd := exec.Command(executablePath, cmdArgs...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	cmd.Env = environment
+	if err := cmd.Run(); err != nil {
+		return err
+	}
+	os.Exit(0)
+	return nil
+}
+
+// HandlePluginCommand walks cmdArgs from longest to shortest (down to
+// minArgs words), looking for a matching "tenantctl-word-word-..." plugin,
+// and execs the first one found with the remaining args and environment. It
+// returns nil with no effect if no plugin matches, leaving normal cobra
+// "unknown command" handling to take over.
+func HandlePluginCommand(pluginHandler PluginHandler, cmdArgs []string, minArgs int, environment []string) error {
+	var remainingArgs []string
+	for _, arg := range cmdArgs {
+		if strings.HasPrefix(arg, "-") {
+			break
+		}
+		remainingArgs = append(remainingArgs, arg)
+	}
+	if len(remainingArgs) == 0 {
+		return nil
+	}
+
+	foundBinaryPath := ""
+	for len(remainingArgs) > 0 {
+		path, found := pluginHandler.Lookup(strings.Join(remainingArgs, "-"))
+		if !found {
+			remainingArgs = remainingArgs[:len(remainingArgs)-1]
+			if len(remainingArgs) < minArgs {
+				break
+			}
+			continue
+		}
+		foundBinaryPath = path
+		break
+	}
+	if foundBinaryPath == "" {
+		return nil
+	}
+
+	return pluginHandler.Execute(foundBinaryPath, cmdArgs[len(remainingArgs):], environment)
+}
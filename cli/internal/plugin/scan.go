@@ -0,0 +1,58 @@
+package plugin
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Candidate describes one "<prefix>-*" executable discovered by Scan on
+// PATH.
+type Candidate struct {
+	// Name is the plugin name with prefix stripped, e.g. "foo-bar" for
+	// "tenantctl-foo-bar".
+	Name string
+	// Path is the candidate's full filesystem path.
+	Path string
+	// Executable reports whether the file has an execute bit set.
+	Executable bool
+	// Shadowed reports whether an earlier (higher PATH-precedence)
+	// directory already yielded a candidate with this Name.
+	Shadowed bool
+}
+
+// Scan walks $PATH in order, looking for files named "prefix-*", the same
+// way DefaultPluginHandler.Lookup does, and reports every one it finds
+// along with whether it's executable and whether an earlier PATH entry
+// already claims the same plugin name -- mirroring `kubectl plugin list`.
+func Scan(prefix string) ([]Candidate, error) {
+	seen := map[string]bool{}
+	var candidates []Candidate
+	for _, dir := range filepath.SplitList(os.Getenv("PATH")) {
+		if dir == "" {
+			continue
+		}
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+		for _, entry := range entries {
+			if entry.IsDir() || !strings.HasPrefix(entry.Name(), prefix+"-") {
+				continue
+			}
+			info, err := entry.Info()
+			if err != nil {
+				continue
+			}
+			name := strings.TrimPrefix(entry.Name(), prefix+"-")
+			candidates = append(candidates, Candidate{
+				Name:       name,
+				Path:       filepath.Join(dir, entry.Name()),
+				Executable: info.Mode()&0o111 != 0,
+				Shadowed:   seen[entry.Name()],
+			})
+			seen[entry.Name()] = true
+		}
+	}
+	return candidates, nil
+}
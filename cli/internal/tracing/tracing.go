@@ -0,0 +1,62 @@
+// Package tracing wires CLI command execution into OpenTelemetry so
+// long-running --wait loops can be correlated with controller-side
+// reconciliation spans.
+package tracing
+
+import (
+	"context"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/rezenkai/multi-saas-crm/tenant-orchestrator/cli"
+
+// Init configures the global TracerProvider from OTEL_EXPORTER_OTLP_ENDPOINT.
+// When that env var is unset, it installs nothing and returns a no-op
+// shutdown func, so tracing is opt-in without commands needing to
+// special-case "disabled".
+func Init(ctx context.Context) (func(context.Context) error, error) {
+	endpoint := os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT")
+	if endpoint == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exporter, err := otlptracegrpc.New(ctx, otlptracegrpc.WithEndpoint(endpoint), otlptracegrpc.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+	res, err := resource.New(ctx, resource.WithAttributes(semconv.ServiceName("tenant-cli")))
+	if err != nil {
+		return nil, err
+	}
+	tp := sdktrace.NewTracerProvider(sdktrace.WithBatcher(exporter), sdktrace.WithResource(res))
+	otel.SetTracerProvider(tp)
+	return tp.Shutdown, nil
+}
+
+// StartCommandSpan opens the root span for a `tenant <verb>` invocation.
+func StartCommandSpan(ctx context.Context, verb string) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, "tenant."+verb)
+}
+
+// StartPollSpan opens a child span for a single --wait poll iteration.
+func StartPollSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// TraceID returns the hex-encoded trace ID of ctx's span, or "" if there is
+// none (tracing disabled, or called outside a command span).
+func TraceID(ctx context.Context) string {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.HasTraceID() {
+		return ""
+	}
+	return sc.TraceID().String()
+}
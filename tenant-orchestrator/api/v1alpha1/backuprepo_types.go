@@ -0,0 +1,63 @@
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:deepcopy
+type BackupRepoSpec struct {
+	// Backend selects the object-storage provider this repo writes to
+	// +kubebuilder:validation:Enum=s3;gcs;azure;minio
+	Backend string `json:"backend"`
+	// Bucket is the object-storage bucket (or container) backups are written to
+	Bucket string `json:"bucket"`
+	// Prefix is prepended to every object key written through this repo
+	Prefix string `json:"prefix,omitempty"`
+	// Region is the bucket's region, read by backends that need one (s3, gcs)
+	Region string `json:"region,omitempty"`
+	// Endpoint overrides the backend's default API endpoint, for
+	// S3-compatible services other than AWS (e.g. a self-hosted minio)
+	Endpoint string `json:"endpoint,omitempty"`
+	// CredentialsSecretRef names the Secret (and its namespace, since a
+	// cluster-scoped BackupRepo has none of its own) holding this backend's
+	// access credentials, replacing the hardcoded aws-credentials/
+	// azure-credentials/minio-credentials Secret names pkg/backup otherwise
+	// falls back to.
+	CredentialsSecretRef corev1.SecretReference `json:"credentialsSecretRef"`
+}
+
+// +kubebuilder:deepcopy
+type BackupRepoStatus struct {
+	// Phase is "Ready" once CredentialsSecretRef has been resolved, "Failed" otherwise
+	Phase string `json:"phase,omitempty"`
+	// Message carries the latest human-readable status detail, especially on failure
+	Message string `json:"message,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:printcolumn:name="Backend",type=string,JSONPath=`.spec.backend`
+// +kubebuilder:printcolumn:name="Bucket",type=string,JSONPath=`.spec.bucket`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// BackupRepo is the Schema for the backuprepos API. It is cluster-scoped,
+// mirroring Datastore: a storage backend's bucket/prefix/credentials are
+// declared once here, and any number of BackupSpec/TenantBackupSpec opt into
+// it via BackupRepoRef instead of repeating those details (and a hardcoded
+// credentials Secret name) on every tenant.
+type BackupRepo struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              BackupRepoSpec   `json:"spec,omitempty"`
+	Status            BackupRepoStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// BackupRepoList contains a list of BackupRepo
+type BackupRepoList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BackupRepo `json:"items"`
+}
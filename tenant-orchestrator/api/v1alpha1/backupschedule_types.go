@@ -0,0 +1,74 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:deepcopy
+// BackupScheduleSpec is modeled on batch/v1 CronJobSpec: Schedule/Paused
+// govern when Template is stamped out as a new TenantBackup, the same way a
+// CronJobSpec's JobTemplate is stamped out as a new Job. This is additive to
+// Tenant.Spec.Database.Backup.Schedule (still run by pkg/scheduler): that
+// path keeps working unchanged for tenants that don't adopt a
+// BackupSchedule, but retention and cron for tenants that do now live here
+// as a first-class, pausable object instead of fields buried in BackupSpec.
+type BackupScheduleSpec struct {
+	// TenantRef is the name of the Tenant each created TenantBackup is taken from
+	TenantRef string `json:"tenantRef"`
+	// Schedule is a cron expression governing when a new TenantBackup is created
+	Schedule string `json:"schedule"`
+	// Paused suspends creating new TenantBackups without deleting this object
+	// or any TenantBackup it already created
+	Paused bool `json:"paused,omitempty"`
+	// RetentionCount keeps the N most recent completed TenantBackups this
+	// schedule created regardless of age, overriding RetentionDuration for
+	// those N. Zero falls back to RetentionDuration alone.
+	RetentionCount int32 `json:"retentionCount,omitempty"`
+	// RetentionDuration keeps any completed TenantBackup this schedule
+	// created younger than this, even past RetentionCount. Zero with
+	// RetentionCount also zero disables pruning entirely.
+	RetentionDuration metav1.Duration `json:"retentionDuration,omitempty"`
+	// Template is the TenantBackupSpec stamped onto every TenantBackup this
+	// schedule creates; its TenantRef and Schedule fields are ignored in
+	// favor of this object's own.
+	Template TenantBackupSpec `json:"template"`
+}
+
+// +kubebuilder:deepcopy
+type BackupScheduleStatus struct {
+	// Phase is "Active" once Schedule has parsed (whether or not a TenantBackup has
+	// been created yet), "Paused" while Spec.Paused is set, or "Failed" if Schedule
+	// doesn't parse or a scheduled TenantBackup could not be created
+	// +kubebuilder:validation:Enum=Active;Paused;Failed
+	Phase string `json:"phase,omitempty"`
+	// LastScheduledTime is when this schedule last created a TenantBackup
+	LastScheduledTime *metav1.Time `json:"lastScheduledTime,omitempty"`
+	// NextScheduledTime is when this schedule will next create a TenantBackup
+	NextScheduledTime *metav1.Time `json:"nextScheduledTime,omitempty"`
+	// Message carries the latest human-readable status detail, especially on failure
+	Message string `json:"message,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced
+// +kubebuilder:printcolumn:name="Tenant",type=string,JSONPath=`.spec.tenantRef`
+// +kubebuilder:printcolumn:name="Schedule",type=string,JSONPath=`.spec.schedule`
+// +kubebuilder:printcolumn:name="Paused",type=boolean,JSONPath=`.spec.paused`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// BackupSchedule is the Schema for the backupschedules API
+type BackupSchedule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              BackupScheduleSpec   `json:"spec,omitempty"`
+	Status            BackupScheduleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// BackupScheduleList contains a list of BackupSchedule
+type BackupScheduleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BackupSchedule `json:"items"`
+}
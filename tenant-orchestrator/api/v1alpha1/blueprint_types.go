@@ -0,0 +1,71 @@
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// BlueprintActionName identifies one of the lifecycle hooks a Blueprint can
+// render a Job for.
+type BlueprintActionName string
+
+const (
+	BlueprintActionBackup   BlueprintActionName = "backup"
+	BlueprintActionRestore  BlueprintActionName = "restore"
+	BlueprintActionDelete   BlueprintActionName = "delete"
+	BlueprintActionPreHook  BlueprintActionName = "prehook"
+	BlueprintActionPostHook BlueprintActionName = "posthook"
+)
+
+// +kubebuilder:deepcopy
+type BlueprintSpec struct {
+	// Actions maps an action name (backup, restore, delete, prehook, posthook)
+	// to the ordered phases that implement it. An action a tenant never
+	// triggers (e.g. posthook) may simply be omitted.
+	Actions map[BlueprintActionName]BlueprintAction `json:"actions"`
+}
+
+// +kubebuilder:deepcopy
+type BlueprintAction struct {
+	// Phases run in order, each as its own container; all phases but the
+	// last run to completion as init containers, so an earlier phase
+	// failing aborts the ones after it.
+	Phases []BlueprintPhase `json:"phases"`
+}
+
+// +kubebuilder:deepcopy
+type BlueprintPhase struct {
+	// Name identifies the phase in status and logs
+	Name string `json:"name"`
+	// Image is the container image the phase runs
+	Image string `json:"image"`
+	// Command is rendered as a Go template over the render context before
+	// becoming the container's Command
+	Command []string `json:"command,omitempty"`
+	// Args is rendered as a Go template over the render context before
+	// becoming the container's Args
+	Args []string `json:"args,omitempty"`
+	// Env are passed through to the container unmodified; use SecretKeyRef
+	// rather than the template context for anything sensitive
+	Env []corev1.EnvVar `json:"env,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced
+// Blueprint is the Schema for the blueprints API. It's modeled after Kanister
+// blueprints: a catalog of templated Job phases per lifecycle action, keyed
+// by database engine (or a custom workload) so the backup/restore
+// controllers don't need a hardcoded branch per engine.
+type Blueprint struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              BlueprintSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// BlueprintList contains a list of Blueprint
+type BlueprintList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Blueprint `json:"items"`
+}
@@ -0,0 +1,57 @@
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:deepcopy
+type ClusterRegistrationSpec struct {
+	// ClusterName is the human-readable name member clusters are referenced by
+	// from TenantSpec.Placement.ClusterSelector
+	ClusterName string `json:"clusterName"`
+	// APIEndpoint is the member cluster's API server URL
+	APIEndpoint string `json:"apiEndpoint"`
+	// KubeconfigSecretRef references the Secret key holding a kubeconfig with
+	// credentials for APIEndpoint
+	KubeconfigSecretRef corev1.SecretKeySelector `json:"kubeconfigSecretRef"`
+	// Region is used for SpreadConstraints region-diversity scheduling
+	Region string `json:"region,omitempty"`
+	// Labels are matched against TenantSpec.Placement.ClusterSelector
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// +kubebuilder:deepcopy
+type ClusterRegistrationStatus struct {
+	// Ready reports whether the member cluster's client could be built and reached
+	Ready bool `json:"ready"`
+	// Message carries the latest human-readable status detail, especially on failure
+	Message string `json:"message,omitempty"`
+	// LastSeen is when the member cluster last responded to a reachability check
+	LastSeen *metav1.Time `json:"lastSeen,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:printcolumn:name="Endpoint",type=string,JSONPath=`.spec.apiEndpoint`
+// +kubebuilder:printcolumn:name="Region",type=string,JSONPath=`.spec.region`
+// +kubebuilder:printcolumn:name="Ready",type=boolean,JSONPath=`.status.ready`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// ClusterRegistration is the Schema for the clusterregistrations API. It is
+// cluster-scoped: a member cluster is registered once and selected by any
+// number of Tenants via TenantSpec.Placement.ClusterSelector.
+type ClusterRegistration struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              ClusterRegistrationSpec   `json:"spec,omitempty"`
+	Status            ClusterRegistrationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// ClusterRegistrationList contains a list of ClusterRegistration
+type ClusterRegistrationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterRegistration `json:"items"`
+}
@@ -0,0 +1,93 @@
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DatastoreMode selects whether a Datastore is carved up per-tenant or
+// dedicated to one tenant.
+type DatastoreMode string
+
+const (
+	// DatastoreModeShared carves a per-tenant schema/database out of one
+	// running engine, the Kamaji-style density play: hundreds of tenants
+	// behind a single Datastore.
+	DatastoreModeShared DatastoreMode = "shared"
+	// DatastoreModeDedicated reserves the whole Datastore for one tenant;
+	// the tenant controller keeps provisioning its own StatefulSet exactly
+	// as it does for a Tenant with no DatastoreRef at all, but records the
+	// assignment against the Datastore for inventory purposes.
+	DatastoreModeDedicated DatastoreMode = "dedicated"
+)
+
+// +kubebuilder:deepcopy
+type DatastoreSpec struct {
+	// Driver is the database engine this Datastore speaks
+	// +kubebuilder:validation:Enum=postgres;mysql;etcd
+	Driver string `json:"driver"`
+	// Mode selects shared (schema-per-tenant) or dedicated (whole-instance-per-tenant) allocation
+	// +kubebuilder:validation:Enum=shared;dedicated
+	Mode DatastoreMode `json:"mode"`
+	// Host is the engine's connection endpoint, e.g. a Service DNS name or external address
+	Host string `json:"host"`
+	// Port is the engine's connection port
+	Port int32 `json:"port"`
+	// AdminSecretRef names the Secret (and its namespace, since a
+	// cluster-scoped Datastore has none of its own) holding "username" and
+	// "password" keys for admin credentials used to carve a new tenant
+	// schema/database in Mode shared. Unused in Mode dedicated.
+	AdminSecretRef corev1.SecretReference `json:"adminSecretRef,omitempty"`
+	// TLS configures how the tenant controller connects to Host; leave nil to connect without TLS
+	TLS *DatastoreTLSSpec `json:"tls,omitempty"`
+	// MaxTenants is the declared upper bound on tenants for this Datastore in
+	// Mode shared, zero meaning unlimited. It is recorded only: no controller
+	// reads it, and Tenants referencing this Datastore are provisioned on it
+	// regardless of how many already are.
+	MaxTenants int32 `json:"maxTenants,omitempty"`
+}
+
+// +kubebuilder:deepcopy
+type DatastoreTLSSpec struct {
+	// SecretRef names the Secret and its namespace holding the CA bundle
+	// (and client cert/key, if the engine requires mTLS) to verify Host
+	SecretRef corev1.SecretReference `json:"secretRef"`
+}
+
+// +kubebuilder:deepcopy
+type DatastoreStatus struct {
+	// Phase is "Ready" once a connectivity check against Host:Port has succeeded, "Failed" otherwise
+	Phase string `json:"phase,omitempty"`
+	// Message carries the latest human-readable status detail, especially on failure
+	Message string `json:"message,omitempty"`
+	// TenantCount is how many Tenants currently reference this Datastore via DatastoreRef
+	TenantCount int32 `json:"tenantCount,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:printcolumn:name="Driver",type=string,JSONPath=`.spec.driver`
+// +kubebuilder:printcolumn:name="Mode",type=string,JSONPath=`.spec.mode`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Tenants",type=integer,JSONPath=`.status.tenantCount`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// Datastore is the Schema for the datastores API. It is cluster-scoped,
+// mirroring Kamaji's DataStore: a database engine's connection details and
+// TLS material are declared once here, and any number of Tenants opt into
+// it via DatabaseSpec.DatastoreRef instead of repeating those details (and
+// redeploying their own StatefulSet) in every Tenant.
+type Datastore struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              DatastoreSpec   `json:"spec,omitempty"`
+	Status            DatastoreStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// DatastoreList contains a list of Datastore
+type DatastoreList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Datastore `json:"items"`
+}
@@ -0,0 +1,6 @@
+package v1alpha1
+
+// Hub marks Tenant as the conversion hub other API versions convert
+// through, satisfying sigs.k8s.io/controller-runtime/pkg/conversion.Hub.
+// v1beta1.Tenant is the only spoke today; see its ConvertTo/ConvertFrom.
+func (*Tenant) Hub() {}
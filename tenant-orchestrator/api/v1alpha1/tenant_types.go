@@ -26,6 +26,227 @@ type TenantSpec struct {
     Domains []string `json:"domains,omitempty"`
     // Feature flags
     Features map[string]bool `json:"features,omitempty"`
+    // Placement controls scheduling this tenant across multiple member clusters.
+    // Leaving it unset keeps the tenant on the hub cluster only.
+    Placement *TenantPlacement `json:"placement,omitempty"`
+    // Ingress selects which ingress controller fronts this tenant's
+    // Domains. Leave unset to use the operator-wide default provider.
+    Ingress *IngressSpec `json:"ingress,omitempty"`
+    // ClientCertificates enables mTLS at the ingress and propagates the
+    // verified client cert to backend services. Leave nil to terminate TLS
+    // without requiring or forwarding a client certificate.
+    ClientCertificates *ClientCertificateSpec `json:"clientCertificates,omitempty"`
+    // TLS configures how Domains get a certificate and how HTTP/host
+    // redirects behave. Leave nil to keep the operator's previous
+    // hard-coded behavior: a letsencrypt-prod ClusterIssuer and a plain
+    // SSL redirect with no www/apex canonicalization.
+    TLS *TLSSpec `json:"tls,omitempty"`
+    // SecretRotation configures automatic rotation of this tenant's
+    // generated credentials (DB password, JWT signing key, admin bootstrap
+    // token, gateway API keys). Leave nil to generate each credential once
+    // and never rotate it.
+    SecretRotation *SecretRotationSpec `json:"secretRotation,omitempty"`
+    // Middlewares is the ordered Traefik middleware chain applied to this
+    // tenant's IngressRoute, ahead of the operator's own HTTPS redirect
+    // middleware. Only read when Ingress.Provider is "traefik-crd"; ignored
+    // by every other provider.
+    Middlewares []TenantMiddlewareSpec `json:"middlewares,omitempty"`
+    // Cordoned blocks creation of new Services (and their Deployments) for
+    // this tenant while leaving already-running ones untouched, Capsule-style.
+    // Use it to freeze a tenant's footprint (e.g. pending an incident review
+    // or an over-quota investigation) without the full Suspend operation's
+    // reconcile pause -- the database, existing services, and ingress keep
+    // reconciling normally.
+    Cordoned bool `json:"cordoned,omitempty"`
+}
+
+// +kubebuilder:deepcopy
+type SecretRotationSpec struct {
+    // Provider is the pkg/secrets.Provider that materializes credentials:
+    // "local" (default, a crypto/rand-backed Secret owned by the Tenant),
+    // "external-secrets", or "vault".
+    // +kubebuilder:validation:Enum=local;external-secrets;vault
+    Provider string `json:"provider,omitempty"`
+    // Interval is how often a new credential version is generated. Leave
+    // unset to generate a credential once and never rotate it.
+    Interval metav1.Duration `json:"interval,omitempty"`
+    // GracePeriod is how long the previous credential version keeps working
+    // after a rotation, giving already-running workloads time to pick up
+    // the new value before it stops accepting the old one.
+    GracePeriod metav1.Duration `json:"gracePeriod,omitempty"`
+}
+
+// +kubebuilder:deepcopy
+type TenantMiddlewareSpec struct {
+    // Name identifies this middleware within the chain. For a rendered
+    // (non-ExternalRef) entry it also becomes the generated Middleware
+    // object's name suffix, e.g. "<tenant>-<name>".
+    Name string `json:"name"`
+    // Type selects which Traefik middleware this entry renders as. Leave
+    // unset when ExternalRef is set instead.
+    // +kubebuilder:validation:Enum=rateLimit;ipAllowList;basicAuth;headers;redirectRegex
+    Type string `json:"type,omitempty"`
+    // RateLimit configures a rateLimit middleware. Read when Type is "rateLimit".
+    RateLimit *RateLimitMiddlewareSpec `json:"rateLimit,omitempty"`
+    // IPAllowList configures an ipAllowList middleware. Read when Type is "ipAllowList".
+    IPAllowList *IPAllowListMiddlewareSpec `json:"ipAllowList,omitempty"`
+    // BasicAuth configures a basicAuth middleware. Read when Type is "basicAuth".
+    BasicAuth *BasicAuthMiddlewareSpec `json:"basicAuth,omitempty"`
+    // Headers configures a headers middleware. Read when Type is "headers".
+    Headers *HeadersMiddlewareSpec `json:"headers,omitempty"`
+    // RedirectRegex configures a redirectRegex middleware. Read when Type is "redirectRegex".
+    RedirectRegex *RedirectRegexMiddlewareSpec `json:"redirectRegex,omitempty"`
+    // ExternalRef references a Middleware that already exists instead of
+    // rendering one from Type. Mutually exclusive with Type and the
+    // type-specific fields above. A namespace other than the tenant's own
+    // must appear in the reconciler's configured allow-list.
+    ExternalRef *MiddlewareRef `json:"externalRef,omitempty"`
+}
+
+// +kubebuilder:deepcopy
+type MiddlewareRef struct {
+    // Name of the Traefik Middleware
+    Name string `json:"name"`
+    // Namespace the Middleware lives in. Defaults to the tenant's own namespace.
+    Namespace string `json:"namespace,omitempty"`
+}
+
+// +kubebuilder:deepcopy
+type RateLimitMiddlewareSpec struct {
+    // Average is the steady-state requests-per-second limit
+    Average int64 `json:"average"`
+    // Burst is how far a client may exceed Average before being throttled
+    Burst int64 `json:"burst,omitempty"`
+}
+
+// +kubebuilder:deepcopy
+type IPAllowListMiddlewareSpec struct {
+    // SourceRange is the list of CIDRs allowed through; requests from every other source are rejected
+    SourceRange []string `json:"sourceRange"`
+}
+
+// +kubebuilder:deepcopy
+type BasicAuthMiddlewareSpec struct {
+    // SecretName names a Secret, in the tenant's namespace, holding
+    // htpasswd-formatted credentials under its "users" key.
+    SecretName string `json:"secretName"`
+}
+
+// +kubebuilder:deepcopy
+type HeadersMiddlewareSpec struct {
+    // CustomResponseHeaders are added to every response this route serves
+    CustomResponseHeaders map[string]string `json:"customResponseHeaders,omitempty"`
+    // FrameDeny sets X-Frame-Options: DENY
+    FrameDeny bool `json:"frameDeny,omitempty"`
+}
+
+// +kubebuilder:deepcopy
+type RedirectRegexMiddlewareSpec struct {
+    // Regex is matched against the request URL
+    Regex string `json:"regex"`
+    // Replacement is the substitution applied on match
+    Replacement string `json:"replacement"`
+    // Permanent sends a 301 instead of a 302
+    Permanent bool `json:"permanent,omitempty"`
+}
+
+// +kubebuilder:deepcopy
+type IngressSpec struct {
+    // Provider names the pkg/ingress.Provider that renders this tenant's
+    // routing objects: "nginx" (default), "traefik-crd", "apisix", or
+    // "kourier". Empty falls back to the operator's configured default.
+    // +kubebuilder:validation:Enum=nginx;traefik-crd;apisix;kourier
+    Provider string `json:"provider,omitempty"`
+}
+
+// +kubebuilder:deepcopy
+type TLSSpec struct {
+    // IssuerRef names the cert-manager Issuer or ClusterIssuer that signs
+    // this tenant's certificate. Mutually exclusive with BringYourOwnSecret;
+    // leave both unset to keep the default letsencrypt-prod ClusterIssuer.
+    IssuerRef *TLSIssuerRef `json:"issuerRef,omitempty"`
+    // BringYourOwnSecret names a pre-existing TLS Secret, in the tenant's
+    // namespace, to use instead of having cert-manager issue one. Mutually
+    // exclusive with IssuerRef.
+    BringYourOwnSecret string `json:"bringYourOwnSecret,omitempty"`
+    // FromToWWWRedirect canonicalizes apex<->www traffic onto one preferred
+    // host with a 308. Leave nil to serve both without redirecting.
+    FromToWWWRedirect *WWWRedirectSpec `json:"fromToWWWRedirect,omitempty"`
+    // SSLRedirect forces HTTP to HTTPS. Defaults to true when unset.
+    SSLRedirect *bool `json:"sslRedirect,omitempty"`
+    // ForceSSLRedirect additionally redirects requests that already arrived
+    // over TLS but terminated somewhere the ingress doesn't consider secure
+    // (e.g. behind a TLS-terminating load balancer it isn't aware of).
+    ForceSSLRedirect bool `json:"forceSSLRedirect,omitempty"`
+    // HSTS configures Strict-Transport-Security. Leave nil to disable it.
+    HSTS *HSTSSpec `json:"hsts,omitempty"`
+    // MinTLSVersion is the lowest TLS protocol version to accept, e.g.
+    // "TLSv1.2". Leave empty to use the ingress controller's default.
+    MinTLSVersion string `json:"minTLSVersion,omitempty"`
+    // Ciphers is an explicit OpenSSL cipher list. Leave empty to use the
+    // ingress controller's default.
+    Ciphers string `json:"ciphers,omitempty"`
+}
+
+// +kubebuilder:deepcopy
+type TLSIssuerRef struct {
+    // Name of the cert-manager Issuer or ClusterIssuer
+    Name string `json:"name"`
+    // Kind is "ClusterIssuer" (default) or "Issuer"
+    // +kubebuilder:validation:Enum=ClusterIssuer;Issuer
+    Kind string `json:"kind,omitempty"`
+}
+
+// +kubebuilder:deepcopy
+type WWWRedirectSpec struct {
+    // PreferredHost is "apex" or "www"; the other form 308-redirects to it.
+    // +kubebuilder:validation:Enum=apex;www
+    PreferredHost string `json:"preferredHost"`
+}
+
+// +kubebuilder:deepcopy
+type HSTSSpec struct {
+    // MaxAgeSeconds is the Strict-Transport-Security max-age. Zero uses the
+    // ingress controller's own default.
+    MaxAgeSeconds int32 `json:"maxAgeSeconds,omitempty"`
+    // IncludeSubDomains adds the includeSubDomains directive
+    IncludeSubDomains bool `json:"includeSubDomains,omitempty"`
+}
+
+// +kubebuilder:deepcopy
+type ClientCertificateSpec struct {
+    // SecretName names a Secret, in the tenant's namespace, holding a ca.crt
+    // entry used to verify client certificates at the ingress. tls.crt and
+    // tls.key, if present, are presented by the ingress to backend services
+    // so they can authorize the request off the same client cert.
+    SecretName string `json:"secretName"`
+    // VerifyClient controls how strictly the ingress enforces the client
+    // cert: "on" (required), "optional", "optional_no_ca", or "off".
+    // Defaults to "on" when unset.
+    // +kubebuilder:validation:Enum=on;optional;optional_no_ca;off
+    VerifyClient string `json:"verifyClient,omitempty"`
+}
+
+// +kubebuilder:deepcopy
+type TenantPlacement struct {
+    // ClusterSelector matches ClusterRegistrations this tenant may be placed on
+    ClusterSelector *metav1.LabelSelector `json:"clusterSelector,omitempty"`
+    // SpreadConstraints bounds how many of the matched clusters are actually used
+    SpreadConstraints SpreadConstraints `json:"spreadConstraints,omitempty"`
+    // ReplicaScheduling selects whether full replicas are duplicated onto every
+    // selected cluster or divided across them
+    // +kubebuilder:validation:Enum=Duplicated;Divided
+    ReplicaScheduling string `json:"replicaScheduling,omitempty"`
+}
+
+// +kubebuilder:deepcopy
+type SpreadConstraints struct {
+    // MinClusters is the minimum number of matched clusters placement requires
+    MinClusters int32 `json:"minClusters,omitempty"`
+    // MaxClusters caps how many matched clusters placement uses; 0 means unlimited
+    MaxClusters int32 `json:"maxClusters,omitempty"`
+    // RegionDiversity requires selected clusters to span distinct regions where possible
+    RegionDiversity bool `json:"regionDiversity,omitempty"`
 }
 
 // +kubebuilder:deepcopy
@@ -64,6 +285,49 @@ type ServiceSpec struct {
     Env []corev1.EnvVar `json:"env,omitempty"`
     // Service-specific configuration
     Config map[string]string `json:"config,omitempty"`
+    // Canary describes an in-progress staged rollout to a new Version; the CLI's
+    // `tenant upgrade --strategy=canary` driver sets and clears this field
+    Canary *CanarySpec `json:"canary,omitempty"`
+    // Sidecars are additional containers serviceDeployment appends to this
+    // service's pod spec, e.g. a log shipper or metrics exporter
+    Sidecars []corev1.Container `json:"sidecars,omitempty"`
+    // SidecarVolumes are Volumes Sidecars may reference. As with
+    // DatabaseSpec.SidecarVolumes, any backing Secret/ConfigMap must already
+    // exist in the tenant namespace.
+    SidecarVolumes []corev1.Volume `json:"sidecarVolumes,omitempty"`
+    // ComponentRef names a TenantDefinition component (matched by this
+    // ServiceSpec's Name) resolveComponent resolves env/ports/probes/volumes
+    // from, KubeBlocks-style. Leave unset, along with VersionRef, to build
+    // this service's pod spec entirely from the fields above, the way every
+    // service did before TenantDefinition/TenantVersion existed.
+    ComponentRef string `json:"componentRef,omitempty"`
+    // VersionRef names the TenantVersion resolveComponent resolves this
+    // component's image from. Required alongside ComponentRef; meaningless
+    // without it.
+    VersionRef string `json:"versionRef,omitempty"`
+    // Overrides layers tenant-specific values on top of the resolved
+    // ComponentRef/VersionRef before serviceDeployment renders them. Ignored
+    // when ComponentRef/VersionRef are unset.
+    Overrides *ServiceOverrides `json:"overrides,omitempty"`
+}
+
+// +kubebuilder:deepcopy
+type ServiceOverrides struct {
+    // Env is appended after the resolved component's own Env, so an entry
+    // here adds to (rather than replaces) the TenantDefinition's defaults
+    Env []corev1.EnvVar `json:"env,omitempty"`
+}
+
+// +kubebuilder:deepcopy
+type CanarySpec struct {
+    // TargetVersion is the version being rolled out
+    TargetVersion string `json:"targetVersion"`
+    // Weight is the current percentage of replicas running TargetVersion
+    Weight int32 `json:"weight"`
+    // Steps is the total number of promotion steps the rollout is divided into
+    Steps int32 `json:"steps"`
+    // StepDuration is how long each step bakes before the gate is re-checked
+    StepDuration metav1.Duration `json:"stepDuration"`
 }
 
 // +kubebuilder:deepcopy
@@ -77,6 +341,55 @@ type DatabaseSpec struct {
     PoolSize int32 `json:"poolSize,omitempty"`
     // Backup configuration
     Backup BackupSpec `json:"backup,omitempty"`
+    // HighAvailability enables a Patroni-managed multi-replica cluster instead
+    // of the default single-node database. Leave nil to keep the existing
+    // single-replica behavior.
+    HighAvailability *HighAvailabilitySpec `json:"highAvailability,omitempty"`
+    // BlueprintRef names a Blueprint in the tenant's namespace that renders
+    // backup/restore/delete Jobs for this database. Leave empty to use the
+    // built-in blueprint matching Type (see pkg/blueprint.Builtin), which
+    // reproduces today's hardcoded pg_dump/mysqldump behavior.
+    BlueprintRef string `json:"blueprintRef,omitempty"`
+    // Sidecars are additional containers databaseStatefulSet appends to the
+    // database pod spec, e.g. a postgres-exporter or log shipper. Merged
+    // with the operator-wide default sidecar list (see
+    // controllers.defaultDatabaseSidecars), not a replacement for it.
+    Sidecars []corev1.Container `json:"sidecars,omitempty"`
+    // SidecarVolumes are Volumes Sidecars may reference. Any Secret or
+    // ConfigMap these volumes source from is expected to already exist in
+    // the tenant namespace (created by the platform out-of-band); the
+    // Tenant CR does not create or own arbitrary Secret/ConfigMap content
+    // on a sidecar's behalf.
+    SidecarVolumes []corev1.Volume `json:"sidecarVolumes,omitempty"`
+    // DatastoreRef names a cluster-scoped Datastore this tenant's database
+    // lives on instead of a StatefulSet the tenant controller provisions
+    // itself. In a shared Datastore the controller carves out a per-tenant
+    // schema/database; in a dedicated one it still provisions a StatefulSet
+    // exactly as when DatastoreRef is empty, but records the assignment
+    // against the Datastore. Leave empty to keep the existing
+    // one-StatefulSet-per-tenant behavior with Type/Version/HighAvailability
+    // above describing it directly.
+    DatastoreRef string `json:"datastoreRef,omitempty"`
+}
+
+// +kubebuilder:deepcopy
+type HighAvailabilitySpec struct {
+    // Replicas is the total number of database pods Patroni manages,
+    // including the primary. Must be at least 2 to provide failover.
+    // +kubebuilder:validation:Minimum=2
+    Replicas int32 `json:"replicas,omitempty"`
+    // SynchronousReplication requires at least one replica to confirm a
+    // write before it's acknowledged, trading write latency for zero data
+    // loss on failover.
+    SynchronousReplication bool `json:"synchronousReplication,omitempty"`
+    // FailoverMode selects how Patroni reacts to a primary failure:
+    // "automatic" (the default) lets Patroni promote a replica on its own,
+    // "manual" requires an operator to run a Patroni switchover.
+    // +kubebuilder:validation:Enum=automatic;manual
+    FailoverMode string `json:"failoverMode,omitempty"`
+    // MinSyncReplicas is how many streaming replicas must be caught up
+    // with the primary for the tenant's database to be reported healthy.
+    MinSyncReplicas int32 `json:"minSyncReplicas,omitempty"`
 }
 
 // +kubebuilder:deepcopy
@@ -87,12 +400,76 @@ type BackupSpec struct {
     Schedule string `json:"schedule,omitempty"`
     // Retention days
     RetentionDays int32 `json:"retentionDays,omitempty"`
+    // RetentionCount keeps the N most recent completed backups regardless of
+    // age, overriding RetentionDays for those N. Zero falls back to the plain
+    // RetentionDays cutoff.
+    RetentionCount int32 `json:"retentionCount,omitempty"`
+    // RetentionDuration additionally keeps any completed backup younger than
+    // this, even past RetentionCount. Zero disables the extra window.
+    RetentionDuration metav1.Duration `json:"retentionDuration,omitempty"`
+    // StorageBackend selects the object-storage provider backups are written to.
+    // Ignored once BackupRepoRef is set; BackupRepoRef's own Backend wins.
+    // +kubebuilder:validation:Enum=s3;gcs;azure;minio
+    StorageBackend string `json:"storageBackend,omitempty"`
+    // Bucket is the object-storage bucket (or container) backups are written to.
+    // Ignored once BackupRepoRef is set.
+    Bucket string `json:"bucket,omitempty"`
+    // Prefix is prepended to every object key written for this tenant.
+    // Ignored once BackupRepoRef is set; BackupRepoRef's own Prefix wins.
+    Prefix string `json:"prefix,omitempty"`
+    // BackupRepoRef names a cluster-scoped BackupRepo supplying the storage
+    // backend, bucket, prefix, and credentials in one shared object instead
+    // of repeating them (and a hardcoded credentials Secret name) on every
+    // tenant. When set, it supersedes StorageBackend/Bucket/Prefix above.
+    BackupRepoRef string `json:"backupRepoRef,omitempty"`
+    // EncryptionKeyRef references a Secret key holding the server-side encryption key
+    EncryptionKeyRef *corev1.SecretKeySelector `json:"encryptionKeyRef,omitempty"`
+    // EncryptionAlgorithm names the cipher EncryptionKeyRef's key is used
+    // with when a backend has no native server-side encryption of its own.
+    // It is recorded only: no backup or restore Job reads it.
+    // +kubebuilder:default=AES-256-CFB
+    EncryptionAlgorithm string `json:"encryptionAlgorithm,omitempty"`
+    // WALArchiving enables continuous WAL shipping so backups support point-in-time restore
+    WALArchiving bool `json:"walArchiving,omitempty"`
+    // Mode selects how base backups are captured: "logical" (pg_dump/mysqldump, the
+    // default), "physical" (pg_basebackup paired with the WAL stream WALArchiving
+    // ships, required for true point-in-time restore), "snapshot" (a storage-layer
+    // point-in-time copy, e.g. an RDS/Cloud SQL snapshot, taken outside this Job
+    // pipeline and recorded here for inventory), or "volume-snapshot" (a CSI
+    // VolumeSnapshot of the database PVC)
+    // +kubebuilder:validation:Enum=logical;physical;snapshot;volume-snapshot
+    Mode string `json:"mode,omitempty"`
+    // WAL configures the continuous WAL archiver sidecar; only read when WALArchiving is true
+    WAL *WALArchivingSpec `json:"wal,omitempty"`
+    // Parallelism is the number of parallel worker processes used to take the
+    // backup. Only honored in Mode physical, where pg_basebackup streams
+    // tablespaces concurrently; Mode logical's plain-text pg_dump/mysqldump
+    // output doesn't support it. Zero uses the tool's own default (1).
+    Parallelism int32 `json:"parallelism,omitempty"`
+    // Compression selects the artifact's compression. Only honored in Mode
+    // physical, where it's passed straight to pg_basebackup; Mode logical's
+    // plain-text output is never compressed.
+    // +kubebuilder:validation:Enum=gzip;none
+    // +kubebuilder:default=gzip
+    Compression string `json:"compression,omitempty"`
+}
+
+// +kubebuilder:deepcopy
+type WALArchivingSpec struct {
+    // ArchiveInterval is how often the sidecar ships the currently-open WAL segment
+    ArchiveInterval metav1.Duration `json:"archiveInterval,omitempty"`
+    // RetentionDays is how long archived WAL segments and base backups are kept before pruning
+    RetentionDays int32 `json:"retentionDays,omitempty"`
+    // Bucket is the object-storage bucket WAL segments and base backups are shipped to
+    Bucket string `json:"bucket,omitempty"`
+    // Prefix is prepended to every WAL segment and base-backup object key
+    Prefix string `json:"prefix,omitempty"`
 }
 
 // +kubebuilder:deepcopy
 type TenantStatus struct {
     // Current phase of the tenant
-    // +kubebuilder:validation:Enum=Pending;Provisioning;Active;Failed;Terminating
+    // +kubebuilder:validation:Enum=Pending;Provisioning;Active;Failed;Terminating;Suspended;Cordoned
     Phase string `json:"phase"`
     // Conditions represent the latest available observations
     Conditions []metav1.Condition `json:"conditions,omitempty"`
@@ -106,6 +483,77 @@ type TenantStatus struct {
     LastReconciled *metav1.Time `json:"lastReconciled,omitempty"`
     // Tenant URL
     URL string `json:"url,omitempty"`
+    // ClusterStatuses reports per-member-cluster placement status, populated
+    // when Spec.Placement is set
+    ClusterStatuses []ClusterStatus `json:"clusterStatuses,omitempty"`
+    // UpgradeHistory records each `tenant upgrade` attempt so a failed or
+    // in-progress rollout can be rolled back to the versions it started from
+    UpgradeHistory []UpgradeRecord `json:"upgradeHistory,omitempty"`
+    // TLS reports the certificate/redirect configuration actually applied
+    TLS TLSStatus `json:"tls,omitempty"`
+    // LastRotated records, per credential name (e.g. "db-password",
+    // "jwt-signing-key", "admin-bootstrap-token", "gateway-api-key"), when
+    // pkg/secrets last generated a new version of it.
+    LastRotated map[string]metav1.Time `json:"lastRotated,omitempty"`
+    // OperationHistory records the latest TenantOperations the controller
+    // has observed for this tenant, newest first, bounded by the
+    // MAX_TENANT_OPERATION_HISTORY env var (default 10).
+    OperationHistory []TenantOperationRecord `json:"operationHistory,omitempty"`
+}
+
+// +kubebuilder:deepcopy
+type TenantOperationRecord struct {
+    // Name is the TenantOperation object's name
+    Name string `json:"name"`
+    // Type mirrors TenantOperationSpec.Type as of the last observation
+    Type string `json:"type"`
+    // Phase mirrors TenantOperationStatus.Phase as of the last observation
+    Phase string `json:"phase"`
+    // ObservedAt is when the controller last reflected this operation's status here
+    ObservedAt metav1.Time `json:"observedAt"`
+}
+
+// +kubebuilder:deepcopy
+type TLSStatus struct {
+    // IssuerRef mirrors Spec.TLS.IssuerRef once applied; nil when using
+    // BringYourOwnSecret or the default letsencrypt-prod ClusterIssuer.
+    IssuerRef *TLSIssuerRef `json:"issuerRef,omitempty"`
+    // SecretName is the TLS secret actually in use: either
+    // Spec.TLS.BringYourOwnSecret or the cert-manager-issued "<tenant>-tls".
+    SecretName string `json:"secretName,omitempty"`
+    // CertificateExpiry is copied from the cert-manager Certificate
+    // resource's status.notAfter, so operators can alert on it without
+    // needing direct access to cert-manager's own CRDs.
+    CertificateExpiry *metav1.Time `json:"certificateExpiry,omitempty"`
+    // Validated reports whether the last reconcile's Spec.TLS passed
+    // validation (e.g. not both BringYourOwnSecret and IssuerRef set).
+    Validated bool `json:"validated,omitempty"`
+}
+
+// +kubebuilder:deepcopy
+type UpgradeRecord struct {
+    // Timestamp is when this upgrade attempt started
+    Timestamp metav1.Time `json:"timestamp"`
+    // Strategy is the rollout strategy used (rolling, recreate, canary, blue-green)
+    Strategy string `json:"strategy"`
+    // PreviousVersions snapshots each upgraded service's version before this attempt
+    PreviousVersions map[string]string `json:"previousVersions"`
+    // Services lists the service names this upgrade attempt touched
+    Services []string `json:"services"`
+    // RolledBack is set once a failed health gate or timeout reverts PreviousVersions
+    RolledBack bool `json:"rolledBack"`
+}
+
+// +kubebuilder:deepcopy
+type ClusterStatus struct {
+    // Name is the ClusterRegistration's ClusterName
+    Name string `json:"name"`
+    // Ready reports whether this tenant's resources were reconciled successfully on the cluster
+    Ready bool `json:"ready"`
+    // Message carries the latest human-readable status detail, especially on failure
+    Message string `json:"message,omitempty"`
+    // LastSync is when this cluster was last reconciled
+    LastSync *metav1.Time `json:"lastSync,omitempty"`
 }
 
 // +kubebuilder:deepcopy
@@ -116,6 +564,12 @@ type ServiceStatus struct {
     Version     string       `json:"version"`
     Endpoints   []string     `json:"endpoints,omitempty"`
     LastUpdated *metav1.Time `json:"lastUpdated,omitempty"`
+    // DefinitionGeneration is the TenantDefinition.Generation actually
+    // reconciled into this service's pod spec, set only when ComponentRef is set
+    DefinitionGeneration int64 `json:"definitionGeneration,omitempty"`
+    // VersionGeneration is the TenantVersion.Generation actually reconciled
+    // into this service's pod spec, set only when VersionRef is set
+    VersionGeneration int64 `json:"versionGeneration,omitempty"`
 }
 
 // +kubebuilder:deepcopy
@@ -123,7 +577,66 @@ type DatabaseStatus struct {
     Ready          bool         `json:"ready"`
     ConnectionURL  string       `json:"connectionUrl,omitempty"`
     MigrationsRun  bool         `json:"migrationsRun"`
+    // LastBackupTime is superseded by Backups below, which records a full
+    // history entry instead of just a timestamp; kept for any existing
+    // readers and left unset going forward.
     LastBackupTime *metav1.Time `json:"lastBackupTime,omitempty"`
+    // PITRWindowStart is the earliest timestamp a point-in-time restore can currently target
+    PITRWindowStart *metav1.Time `json:"pitrWindowStart,omitempty"`
+    // PITRWindowEnd is the latest timestamp a point-in-time restore can currently target
+    PITRWindowEnd *metav1.Time `json:"pitrWindowEnd,omitempty"`
+    // LastWALArchiveTime is when the WAL archiver sidecar last shipped a segment
+    LastWALArchiveTime *metav1.Time `json:"lastWalArchiveTime,omitempty"`
+    // PrimaryPod is the name of the pod Patroni currently holds the DCS
+    // leader lock for. Only set when HighAvailability is enabled.
+    PrimaryPod string `json:"primaryPod,omitempty"`
+    // ReplicaPods lists the pods Patroni currently reports as streaming
+    // replicas. Only set when HighAvailability is enabled.
+    ReplicaPods []string `json:"replicaPods,omitempty"`
+    // NextScheduledBackup is when the scheduler will next create a backup for
+    // this tenant. Only set when Spec.Database.Backup.Schedule is non-empty.
+    NextScheduledBackup *metav1.Time `json:"nextScheduledBackup,omitempty"`
+    // LastSuccessfulBackup is when a TenantBackup for this tenant last
+    // completed successfully.
+    LastSuccessfulBackup *metav1.Time `json:"lastSuccessfulBackup,omitempty"`
+    // BackupCount is the number of TenantBackups that have completed
+    // successfully for this tenant.
+    BackupCount int32 `json:"backupCount,omitempty"`
+    // Sidecars lists the names of the containers actually injected into the
+    // database pod, combining the operator-wide default sidecar list with
+    // Spec.Database.Sidecars.
+    Sidecars []string `json:"sidecars,omitempty"`
+    // DatastoreName is the Datastore this tenant's database was assigned to,
+    // set when Spec.Database.DatastoreRef is non-empty.
+    DatastoreName string `json:"datastoreName,omitempty"`
+    // SchemaChecksum is a hash of the schema-provisioning script last
+    // applied against DatastoreName in Mode shared, so the controller can
+    // tell whether it needs to reapply it. Unset in Mode dedicated.
+    SchemaChecksum string `json:"schemaChecksum,omitempty"`
+    // Backups is this tenant's completed-backup history, newest first,
+    // bounded by the MAX_TENANT_BACKUP_HISTORY env var (default 10),
+    // mirroring OperationHistory's cap above. Superseded LastBackupTime.
+    Backups []BackupRecord `json:"backups,omitempty"`
+}
+
+// +kubebuilder:deepcopy
+// BackupRecord is one entry in DatabaseStatus.Backups, recorded when a
+// TenantBackup for this tenant completes.
+type BackupRecord struct {
+    // Name is the TenantBackup object's name
+    Name string `json:"name"`
+    // Method mirrors TenantBackupSpec.Mode as of this backup
+    Method string `json:"method"`
+    // StartTime is when the backup Job started running
+    StartTime *metav1.Time `json:"startTime,omitempty"`
+    // CompletionTime is when the backup Job finished
+    CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+    // SizeBytes is the size of the resulting artifact
+    SizeBytes int64 `json:"sizeBytes,omitempty"`
+    // Path is the artifact's fully-qualified location in its storage backend
+    Path string `json:"path,omitempty"`
+    // Checksum is a content checksum of the resulting artifact
+    Checksum string `json:"checksum,omitempty"`
 }
 
 // +kubebuilder:deepcopy
@@ -174,6 +687,28 @@ func addKnownTypes(scheme *runtime.Scheme) error {
     scheme.AddKnownTypes(SchemeGroupVersion,
         &Tenant{},
         &TenantList{},
+        &TenantBackup{},
+        &TenantBackupList{},
+        &TenantRestore{},
+        &TenantRestoreList{},
+        &ClusterRegistration{},
+        &ClusterRegistrationList{},
+        &Blueprint{},
+        &BlueprintList{},
+        &TenantOperation{},
+        &TenantOperationList{},
+        &TenantOpsRequest{},
+        &TenantOpsRequestList{},
+        &Datastore{},
+        &DatastoreList{},
+        &BackupRepo{},
+        &BackupRepoList{},
+        &BackupSchedule{},
+        &BackupScheduleList{},
+        &TenantDefinition{},
+        &TenantDefinitionList{},
+        &TenantVersion{},
+        &TenantVersionList{},
     )
     metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
     return nil
@@ -190,207 +725,3 @@ func init() {
     utilruntime.Must(AddToScheme(Scheme))
 }
 
-// DeepCopyInto is a manual deepcopy function for Tenant.
-func (in *Tenant) DeepCopyInto(out *Tenant) {
-    *out = *in
-    out.TypeMeta = in.TypeMeta
-    in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-    in.Spec.DeepCopyInto(&out.Spec)
-    in.Status.DeepCopyInto(&out.Status)
-}
-
-// DeepCopy creates a deep copy of the Tenant.
-func (in *Tenant) DeepCopy() *Tenant {
-    if in == nil {
-        return nil
-    }
-    out := new(Tenant)
-    in.DeepCopyInto(out)
-    return out
-}
-
-// DeepCopyObject implements the runtime.Object interface for Tenant.
-func (in *Tenant) DeepCopyObject() runtime.Object {
-    if c := in.DeepCopy(); c != nil {
-        return c
-    }
-    return nil
-}
-
-// DeepCopyInto is a manual deepcopy function for TenantList.
-func (in *TenantList) DeepCopyInto(out *TenantList) {
-    *out = *in
-    out.TypeMeta = in.TypeMeta
-    in.ListMeta.DeepCopyInto(&out.ListMeta)
-    if in.Items != nil {
-        inItems, outItems := &in.Items, &out.Items
-        *outItems = make([]Tenant, len(*inItems))
-        for i := range *inItems {
-            (*inItems)[i].DeepCopyInto(&(*outItems)[i])
-        }
-    }
-}
-
-// DeepCopy creates a deep copy of the TenantList.
-func (in *TenantList) DeepCopy() *TenantList {
-    if in == nil {
-        return nil
-    }
-    out := new(TenantList)
-    in.DeepCopyInto(out)
-    return out
-}
-
-// DeepCopyObject implements the runtime.Object interface for TenantList.
-func (in *TenantList) DeepCopyObject() runtime.Object {
-    if c := in.DeepCopy(); c != nil {
-        return c
-    }
-    return nil
-}
-
-// DeepCopyInto is a manual deepcopy function for TenantSpec.
-func (in *TenantSpec) DeepCopyInto(out *TenantSpec) {
-    *out = *in
-    out.OrganizationName = in.OrganizationName
-    out.Tier = in.Tier
-    out.Resources.DeepCopyInto(&out.Resources)
-    if in.Services != nil {
-        out.Services = make([]ServiceSpec, len(in.Services))
-        for i := range in.Services {
-            in.Services[i].DeepCopyInto(&out.Services[i])
-        }
-    }
-    out.Database.DeepCopyInto(&out.Database)
-    if in.Domains != nil {
-        out.Domains = make([]string, len(in.Domains))
-        copy(out.Domains, in.Domains)
-    }
-    if in.Features != nil {
-        out.Features = make(map[string]bool, len(in.Features))
-        for k, v := range in.Features {
-            out.Features[k] = v
-        }
-    }
-}
-
-// DeepCopyInto is a manual deepcopy function for TenantStatus.
-func (in *TenantStatus) DeepCopyInto(out *TenantStatus) {
-    *out = *in
-    out.Phase = in.Phase
-    if in.Conditions != nil {
-        out.Conditions = make([]metav1.Condition, len(in.Conditions))
-        for i := range in.Conditions {
-            in.Conditions[i].DeepCopyInto(&out.Conditions[i])
-        }
-    }
-    if in.Services != nil {
-        out.Services = make([]ServiceStatus, len(in.Services))
-        for i := range in.Services {
-            in.Services[i].DeepCopyInto(&out.Services[i])
-        }
-    }
-    out.DatabaseStatus.DeepCopyInto(&out.DatabaseStatus)
-    out.ResourceMetrics.DeepCopyInto(&out.ResourceMetrics)
-    if in.LastReconciled != nil {
-        out.LastReconciled = in.LastReconciled.DeepCopy()
-    }
-    out.URL = in.URL
-}
-
-// DeepCopyInto is a manual deepcopy function for ResourceSpec.
-func (in *ResourceSpec) DeepCopyInto(out *ResourceSpec) {
-    *out = *in
-    out.CPU.DeepCopyInto(&out.CPU)
-    out.Memory.DeepCopyInto(&out.Memory)
-    out.Storage.DeepCopyInto(&out.Storage)
-}
-
-// DeepCopyInto is a manual deepcopy function for ResourceQuantity.
-func (in *ResourceQuantity) DeepCopyInto(out *ResourceQuantity) {
-    *out = *in
-    out.Request = in.Request
-    out.Limit = in.Limit
-}
-
-// DeepCopyInto is a manual deepcopy function for StorageSpec.
-func (in *StorageSpec) DeepCopyInto(out *StorageSpec) {
-    *out = *in
-    out.Size = in.Size
-    out.StorageClass = in.StorageClass
-}
-
-// DeepCopyInto is a manual deepcopy function for ServiceSpec.
-func (in *ServiceSpec) DeepCopyInto(out *ServiceSpec) {
-    *out = *in
-    out.Name = in.Name
-    out.Version = in.Version
-    out.Replicas = in.Replicas
-    if in.Env != nil {
-        out.Env = make([]corev1.EnvVar, len(in.Env))
-        for i := range in.Env {
-            in.Env[i].DeepCopyInto(&out.Env[i])
-        }
-    }
-    if in.Config != nil {
-        out.Config = make(map[string]string, len(in.Config))
-        for k, v := range in.Config {
-            out.Config[k] = v
-        }
-    }
-}
-
-// DeepCopyInto is a manual deepcopy function for DatabaseSpec.
-func (in *DatabaseSpec) DeepCopyInto(out *DatabaseSpec) {
-    *out = *in
-    out.Type = in.Type
-    out.Version = in.Version
-    out.PoolSize = in.PoolSize
-    out.Backup.DeepCopyInto(&out.Backup)
-}
-
-// DeepCopyInto is a manual deepcopy function for BackupSpec.
-func (in *BackupSpec) DeepCopyInto(out *BackupSpec) {
-    *out = *in
-    out.Enabled = in.Enabled
-    out.Schedule = in.Schedule
-    out.RetentionDays = in.RetentionDays
-}
-
-// DeepCopyInto is a manual deepcopy function for ServiceStatus.
-func (in *ServiceStatus) DeepCopyInto(out *ServiceStatus) {
-    *out = *in
-    out.Name = in.Name
-    out.Ready = in.Ready
-    out.Replicas = in.Replicas
-    out.Version = in.Version
-    if in.Endpoints != nil {
-        out.Endpoints = make([]string, len(in.Endpoints))
-        copy(out.Endpoints, in.Endpoints)
-    }
-    if in.LastUpdated != nil {
-        out.LastUpdated = in.LastUpdated.DeepCopy()
-    }
-}
-
-// DeepCopyInto is a manual deepcopy function for DatabaseStatus.
-func (in *DatabaseStatus) DeepCopyInto(out *DatabaseStatus) {
-    *out = *in
-    out.Ready = in.Ready
-    out.ConnectionURL = in.ConnectionURL
-    out.MigrationsRun = in.MigrationsRun
-    if in.LastBackupTime != nil {
-        out.LastBackupTime = in.LastBackupTime.DeepCopy()
-    }
-}
-
-// DeepCopyInto is a manual deepcopy function for ResourceMetrics.
-func (in *ResourceMetrics) DeepCopyInto(out *ResourceMetrics) {
-    *out = *in
-    out.CPUUsage = in.CPUUsage
-    out.MemoryUsage = in.MemoryUsage
-    out.StorageUsage = in.StorageUsage
-    if in.UpdatedAt != nil {
-        out.UpdatedAt = in.UpdatedAt.DeepCopy()
-    }
-}
\ No newline at end of file
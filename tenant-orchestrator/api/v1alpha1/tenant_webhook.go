@@ -0,0 +1,15 @@
+package v1alpha1
+
+import (
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// SetupWebhookWithManager registers Tenant's webhook server, which serves
+// the /convert endpoint controller-runtime wires up automatically because
+// Tenant implements conversion.Hub (see tenant_conversion.go) and v1beta1's
+// Tenant implements conversion.Convertible against it.
+func (in *Tenant) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(in).
+		Complete()
+}
@@ -0,0 +1,104 @@
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TenantBackupPhase describes where a TenantBackup is in its lifecycle.
+type TenantBackupPhase string
+
+const (
+	TenantBackupPhasePending   TenantBackupPhase = "Pending"
+	TenantBackupPhaseRunning   TenantBackupPhase = "Running"
+	TenantBackupPhaseCompleted TenantBackupPhase = "Completed"
+	TenantBackupPhaseFailed    TenantBackupPhase = "Failed"
+)
+
+// +kubebuilder:deepcopy
+type TenantBackupSpec struct {
+	// TenantRef is the name of the Tenant this backup is taken from
+	TenantRef string `json:"tenantRef"`
+	// Schedule is an optional cron expression; when set the backup is recreated on this cadence
+	Schedule string `json:"schedule,omitempty"`
+	// StorageBackend selects the object-storage provider the artifact is uploaded to.
+	// Ignored once BackupRepoRef is set; BackupRepoRef's own Backend wins.
+	// +kubebuilder:validation:Enum=s3;gcs;azure;minio
+	StorageBackend string `json:"storageBackend,omitempty"`
+	// Bucket is the object-storage bucket (or container) the artifact is uploaded to.
+	// Ignored once BackupRepoRef is set.
+	Bucket string `json:"bucket,omitempty"`
+	// Prefix is prepended to the object key of the uploaded artifact.
+	// Ignored once BackupRepoRef is set; BackupRepoRef's own Prefix wins.
+	Prefix string `json:"prefix,omitempty"`
+	// BackupRepoRef names a cluster-scoped BackupRepo supplying the storage
+	// backend, bucket, prefix, and credentials in one shared object; see
+	// BackupSpec.BackupRepoRef. When set, it supersedes StorageBackend/Bucket/Prefix above.
+	BackupRepoRef string `json:"backupRepoRef,omitempty"`
+	// EncryptionKeyRef references a Secret key holding the server-side encryption key
+	EncryptionKeyRef *corev1.SecretKeySelector `json:"encryptionKeyRef,omitempty"`
+	// EncryptionAlgorithm names the cipher EncryptionKeyRef's key is used
+	// with when a backend has no native server-side encryption of its own.
+	// It is recorded only: no backup or restore Job reads it.
+	// +kubebuilder:default=AES-256-CFB
+	EncryptionAlgorithm string `json:"encryptionAlgorithm,omitempty"`
+	// WALArchiving records whether this backup was taken with continuous WAL shipping enabled,
+	// making it eligible as a PITR base for TenantRestore
+	WALArchiving bool `json:"walArchiving,omitempty"`
+	// Mode records how the artifact was captured: "logical" (pg_dump/mysqldump, the
+	// default), "physical" (pg_basebackup), "snapshot" (a storage-layer snapshot), or
+	// "volume-snapshot" (a CSI VolumeSnapshot of the database PVC), mirroring
+	// Tenant.Spec.Database.Backup.Mode
+	// +kubebuilder:validation:Enum=logical;physical;snapshot;volume-snapshot
+	Mode string `json:"mode,omitempty"`
+	// Parallelism mirrors BackupSpec.Parallelism: honored in Mode physical only
+	Parallelism int32 `json:"parallelism,omitempty"`
+	// Compression mirrors BackupSpec.Compression: honored in Mode physical only
+	// +kubebuilder:validation:Enum=gzip;none
+	// +kubebuilder:default=gzip
+	Compression string `json:"compression,omitempty"`
+}
+
+// +kubebuilder:deepcopy
+type TenantBackupStatus struct {
+	// Phase is the current lifecycle phase of the backup
+	// +kubebuilder:validation:Enum=Pending;Running;Completed;Failed
+	Phase TenantBackupPhase `json:"phase,omitempty"`
+	// StartTime is when the backup Job started running
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// CompletionTime is when the backup Job finished
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+	// SizeBytes is the size of the resulting artifact
+	SizeBytes int64 `json:"sizeBytes,omitempty"`
+	// Checksum is a content checksum of the resulting artifact
+	Checksum string `json:"checksum,omitempty"`
+	// BackendLocation is the fully-qualified location of the artifact in the storage backend
+	BackendLocation string `json:"backendLocation,omitempty"`
+	// WALPosition is the WAL LSN (or binlog position) the backup was taken at, used as the
+	// PITR base offset when a TenantRestore replays WAL up to a target timestamp
+	WALPosition string `json:"walPosition,omitempty"`
+	// Message carries the latest human-readable status detail, especially on failure
+	Message string `json:"message,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced
+// +kubebuilder:printcolumn:name="Tenant",type=string,JSONPath=`.spec.tenantRef`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// TenantBackup is the Schema for the tenantbackups API
+type TenantBackup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              TenantBackupSpec   `json:"spec,omitempty"`
+	Status            TenantBackupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// TenantBackupList contains a list of TenantBackup
+type TenantBackupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TenantBackup `json:"items"`
+}
@@ -0,0 +1,72 @@
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:deepcopy
+type ComponentDefinition struct {
+	// Name identifies this component; a Tenant opts into it by setting
+	// ServiceSpec.Name to the same value alongside ComponentRef
+	Name string `json:"name"`
+	// Type is a free-form label for the component's role (e.g. "api",
+	// "worker"), not otherwise interpreted by the controller
+	Type string `json:"type,omitempty"`
+	// Env are the component's default environment variables; resolveComponent
+	// appends a ServiceSpec's own Env and any Overrides.Env after these, so a
+	// tenant can add to but not remove a definition default
+	Env []corev1.EnvVar `json:"env,omitempty"`
+	// Ports are the container ports serviceDeployment renders onto the pod
+	Ports []corev1.ContainerPort `json:"ports,omitempty"`
+	// LivenessProbe, if set, is rendered onto the resolved container as-is
+	LivenessProbe *corev1.Probe `json:"livenessProbe,omitempty"`
+	// ReadinessProbe, if set, is rendered onto the resolved container as-is
+	ReadinessProbe *corev1.Probe `json:"readinessProbe,omitempty"`
+	// Volumes are added to the pod spec alongside any ServiceSpec.SidecarVolumes
+	Volumes []corev1.Volume `json:"volumes,omitempty"`
+	// VolumeMounts are added to the resolved container
+	VolumeMounts []corev1.VolumeMount `json:"volumeMounts,omitempty"`
+}
+
+// +kubebuilder:deepcopy
+type TenantDefinitionSpec struct {
+	// Components lists the components this TenantDefinition makes available;
+	// a ServiceSpec's ComponentRef/Name pair must match one entry here
+	Components []ComponentDefinition `json:"components"`
+}
+
+// +kubebuilder:deepcopy
+type TenantDefinitionStatus struct {
+	// Phase is "Ready" once this TenantDefinition has passed validation, "Failed" otherwise
+	Phase string `json:"phase,omitempty"`
+	// Message carries the latest human-readable status detail, especially on failure
+	Message string `json:"message,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// TenantDefinition is the Schema for the tenantdefinitions API. It is
+// cluster-scoped, KubeBlocks-style: the shape of each component a Tenant can
+// run (env, ports, probes, volumes) is declared once here, and any number of
+// Tenants reference a component by name via ServiceSpec.ComponentRef instead
+// of repeating that shape in every Tenant. Pair with a TenantVersion (which
+// supplies the image) to resolve a component's effective pod spec; see
+// resolveComponent.
+type TenantDefinition struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              TenantDefinitionSpec   `json:"spec,omitempty"`
+	Status            TenantDefinitionStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// TenantDefinitionList contains a list of TenantDefinition
+type TenantDefinitionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TenantDefinition `json:"items"`
+}
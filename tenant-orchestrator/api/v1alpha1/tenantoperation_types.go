@@ -0,0 +1,90 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TenantOperationType is the lifecycle verb a TenantOperation drives.
+type TenantOperationType string
+
+const (
+	TenantOperationProvision TenantOperationType = "Provision"
+	TenantOperationUpgrade   TenantOperationType = "Upgrade"
+	TenantOperationMigrate   TenantOperationType = "Migrate"
+	TenantOperationBackup    TenantOperationType = "Backup"
+	TenantOperationRestore   TenantOperationType = "Restore"
+	TenantOperationSuspend   TenantOperationType = "Suspend"
+	TenantOperationResume    TenantOperationType = "Resume"
+	TenantOperationDelete    TenantOperationType = "Delete"
+)
+
+// TenantOperationPhase describes where a TenantOperation is in its lifecycle.
+type TenantOperationPhase string
+
+const (
+	TenantOperationPhasePending   TenantOperationPhase = "Pending"
+	TenantOperationPhaseRunning   TenantOperationPhase = "Running"
+	TenantOperationPhaseCompleted TenantOperationPhase = "Completed"
+	TenantOperationPhaseFailed    TenantOperationPhase = "Failed"
+)
+
+// +kubebuilder:deepcopy
+type TenantOperationSpec struct {
+	// TenantRef is the name of the Tenant this operation acts on
+	TenantRef string `json:"tenantRef"`
+	// Type selects which lifecycle verb this operation performs
+	// +kubebuilder:validation:Enum=Provision;Upgrade;Migrate;Backup;Restore;Suspend;Resume;Delete
+	Type TenantOperationType `json:"type"`
+	// Parameters carries verb-specific arguments as opaque key/value pairs,
+	// e.g. "service"/"version" for Upgrade or "sourceBackupRef" for Restore,
+	// so this CRD doesn't need a typed field added for every new verb.
+	Parameters map[string]string `json:"parameters,omitempty"`
+	// RetryLimit caps how many times the controller retries a failed
+	// operation before leaving it in phase Failed. Zero means no retries.
+	RetryLimit int32 `json:"retryLimit,omitempty"`
+}
+
+// +kubebuilder:deepcopy
+type TenantOperationStatus struct {
+	// Phase is the current lifecycle phase of the operation
+	// +kubebuilder:validation:Enum=Pending;Running;Completed;Failed
+	Phase TenantOperationPhase `json:"phase,omitempty"`
+	// StartTime is when the controller began acting on this operation
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// CompletionTime is when the operation reached Completed or Failed
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+	// RetryCount is how many times this operation has been retried so far
+	RetryCount int32 `json:"retryCount,omitempty"`
+	// Conditions represent the latest available observations of this operation's state
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// Message carries the latest human-readable status detail, especially on failure
+	Message string `json:"message,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced
+// +kubebuilder:printcolumn:name="Tenant",type=string,JSONPath=`.spec.tenantRef`
+// +kubebuilder:printcolumn:name="Type",type=string,JSONPath=`.spec.type`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// TenantOperation is the Schema for the tenantoperations API. It models one
+// explicit lifecycle verb applied to a Tenant -- mirroring the operation
+// object CAP-operator calls a CAPTenantOperation -- so that driving a tenant
+// through Provision/Upgrade/Migrate/Backup/Restore/Suspend/Resume/Delete
+// leaves a declarative, retryable, auditable trail instead of requiring a
+// direct mutation of TenantSpec.
+type TenantOperation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              TenantOperationSpec   `json:"spec,omitempty"`
+	Status            TenantOperationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// TenantOperationList contains a list of TenantOperation
+type TenantOperationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TenantOperation `json:"items"`
+}
@@ -0,0 +1,182 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TenantOpsRequestType is the typed mutation a TenantOpsRequest applies to a
+// Tenant, mirroring KubeBlocks' OpsRequest op types.
+type TenantOpsRequestType string
+
+const (
+	VerticalScalingOpsType   TenantOpsRequestType = "VerticalScaling"
+	HorizontalScalingOpsType TenantOpsRequestType = "HorizontalScaling"
+	VolumeExpansionOpsType   TenantOpsRequestType = "VolumeExpansion"
+	ReconfigureOpsType       TenantOpsRequestType = "Reconfigure"
+	VersionUpgradeOpsType    TenantOpsRequestType = "VersionUpgrade"
+	RestartOpsType           TenantOpsRequestType = "Restart"
+	SwitchoverOpsType        TenantOpsRequestType = "Switchover"
+	ExposeOpsType            TenantOpsRequestType = "Expose"
+)
+
+// TenantOpsRequestPhase is where a TenantOpsRequest is in its lifecycle,
+// matching KubeBlocks' OpsRequest phases.
+type TenantOpsRequestPhase string
+
+const (
+	OpsPhasePending    TenantOpsRequestPhase = "Pending"
+	OpsPhaseCreating   TenantOpsRequestPhase = "Creating"
+	OpsPhaseRunning    TenantOpsRequestPhase = "Running"
+	OpsPhaseCancelling TenantOpsRequestPhase = "Cancelling"
+	OpsPhaseCancelled  TenantOpsRequestPhase = "Cancelled"
+	OpsPhaseSucceed    TenantOpsRequestPhase = "Succeed"
+	OpsPhaseFailed     TenantOpsRequestPhase = "Failed"
+)
+
+// Condition types set on TenantOpsRequest.Status.Conditions, named after
+// KubeBlocks' own OpsRequest condition vocabulary so anyone who has operated
+// a KubeBlocks cluster recognizes the state machine immediately.
+const (
+	OpsConditionTypeProgressing = "Progressing"
+	OpsConditionTypeValidated   = "Validated"
+	OpsConditionTypeSucceed     = "Succeed"
+	OpsConditionTypeFailed      = "Failed"
+	OpsConditionTypeCancelled   = "Cancelled"
+)
+
+// +kubebuilder:deepcopy
+type TenantOpsRequestSpec struct {
+	// TenantRef is the name of the Tenant this request mutates
+	TenantRef string `json:"tenantRef"`
+	// Type selects which typed mutation this request applies
+	// +kubebuilder:validation:Enum=VerticalScaling;HorizontalScaling;VolumeExpansion;Reconfigure;VersionUpgrade;Restart;Switchover;Expose
+	Type TenantOpsRequestType `json:"type"`
+	// ServiceName is the Tenant.Spec.Services entry this request targets.
+	// Required for every Type except VolumeExpansion, which resizes the
+	// tenant's shared database storage.
+	ServiceName string `json:"serviceName,omitempty"`
+	// VerticalScaling changes a service's CPU/Memory request+limit. Required when Type is VerticalScaling.
+	VerticalScaling *VerticalScalingOpsSpec `json:"verticalScaling,omitempty"`
+	// HorizontalScaling changes a service's replica count. Required when Type is HorizontalScaling.
+	HorizontalScaling *HorizontalScalingOpsSpec `json:"horizontalScaling,omitempty"`
+	// VolumeExpansion grows the tenant database's persistent volume. Required when Type is VolumeExpansion.
+	VolumeExpansion *VolumeExpansionOpsSpec `json:"volumeExpansion,omitempty"`
+	// Reconfigure merges new entries into a service's Config. Required when Type is Reconfigure.
+	Reconfigure *ReconfigureOpsSpec `json:"reconfigure,omitempty"`
+	// VersionUpgrade bumps a service's Version. Required when Type is VersionUpgrade.
+	VersionUpgrade *VersionUpgradeOpsSpec `json:"versionUpgrade,omitempty"`
+	// Switchover promotes a new Patroni replica to primary on a
+	// high-availability database. Required when Type is Switchover.
+	Switchover *SwitchoverOpsSpec `json:"switchover,omitempty"`
+	// Expose appends Domains to the tenant's ingress. Required when Type is Expose.
+	Expose *ExposeOpsSpec `json:"expose,omitempty"`
+	// Cancel requests cancellation of an in-flight request. KubeBlocks lets an
+	// OpsRequest be cancelled the same way: set the field, don't delete the object.
+	Cancel bool `json:"cancel,omitempty"`
+}
+
+// +kubebuilder:deepcopy
+type VerticalScalingOpsSpec struct {
+	// CPU is the new request+limit; leave either half empty to keep it unchanged
+	CPU ResourceQuantity `json:"cpu,omitempty"`
+	// Memory is the new request+limit; leave either half empty to keep it unchanged
+	Memory ResourceQuantity `json:"memory,omitempty"`
+}
+
+// +kubebuilder:deepcopy
+type HorizontalScalingOpsSpec struct {
+	// Replicas is the new replica count
+	Replicas int32 `json:"replicas"`
+}
+
+// +kubebuilder:deepcopy
+type VolumeExpansionOpsSpec struct {
+	// Storage is the new persistent volume size, e.g. "20Gi". Must be larger
+	// than the tenant's current Spec.Resources.Storage.Size: Kubernetes
+	// doesn't support shrinking a bound PVC.
+	Storage string `json:"storage"`
+}
+
+// +kubebuilder:deepcopy
+type ReconfigureOpsSpec struct {
+	// Config entries are merged into the service's existing Config, overwriting any matching keys
+	Config map[string]string `json:"config"`
+}
+
+// +kubebuilder:deepcopy
+type VersionUpgradeOpsSpec struct {
+	// Version is the new service version
+	Version string `json:"version"`
+}
+
+// +kubebuilder:deepcopy
+type SwitchoverOpsSpec struct {
+	// NewPrimary names the Patroni member to promote; leave empty to let
+	// Patroni elect one on its own.
+	NewPrimary string `json:"newPrimary,omitempty"`
+}
+
+// +kubebuilder:deepcopy
+type ExposeOpsSpec struct {
+	// Domains are appended to Tenant.Spec.Domains
+	Domains []string `json:"domains"`
+}
+
+// +kubebuilder:deepcopy
+type TenantOpsRequestStatus struct {
+	// Phase is the current lifecycle phase of the request
+	// +kubebuilder:validation:Enum=Pending;Creating;Running;Cancelling;Cancelled;Succeed;Failed
+	Phase TenantOpsRequestPhase `json:"phase,omitempty"`
+	// Conditions carry the Progressing/Validated/Succeed/Failed/Cancelled trail for this request
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// Progress is a human-readable "done/total" summary of ComponentProgress
+	Progress string `json:"progress,omitempty"`
+	// ComponentProgress records the per-service outcome of this request,
+	// since a single request may touch more than one underlying resource
+	// (e.g. VerticalScaling patches both a Deployment's resources and the
+	// Tenant's own Spec.Services entry).
+	ComponentProgress []OpsComponentProgress `json:"componentProgress,omitempty"`
+	// StartTime is when the controller began acting on this request
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// CompletionTime is when the request reached Succeed, Failed, or Cancelled
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+}
+
+// +kubebuilder:deepcopy
+type OpsComponentProgress struct {
+	// ServiceName is the Tenant.Spec.Services entry this progress entry covers
+	ServiceName string `json:"serviceName"`
+	// Status is "Succeed" or "Failed"
+	Status string `json:"status"`
+	// Message carries failure detail, empty on success
+	Message string `json:"message,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,shortName=tops
+// +kubebuilder:printcolumn:name="Tenant",type=string,JSONPath=`.spec.tenantRef`
+// +kubebuilder:printcolumn:name="Type",type=string,JSONPath=`.spec.type`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// TenantOpsRequest is the Schema for the tenantopsrequests API. Modeled on
+// KubeBlocks' OpsRequest, it lets an operator (human or automation) declare
+// one typed mutation against a Tenant -- a vertical/horizontal scale, a
+// volume grow, a config change, a version bump, a restart, a database
+// switchover, or a new exposed domain -- without editing TenantSpec
+// directly, so the change can be validated against the tenant's Tier
+// envelope, tracked to completion or failure, and cancelled mid-flight.
+type TenantOpsRequest struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              TenantOpsRequestSpec   `json:"spec,omitempty"`
+	Status            TenantOpsRequestStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// TenantOpsRequestList contains a list of TenantOpsRequest
+type TenantOpsRequestList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TenantOpsRequest `json:"items"`
+}
@@ -0,0 +1,54 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:deepcopy
+type TenantRestoreSpec struct {
+	// SourceBackupRef is the name of the TenantBackup to restore from
+	SourceBackupRef string `json:"sourceBackupRef"`
+	// TenantRef is the name of the Tenant to restore into
+	TenantRef string `json:"tenantRef"`
+	// PointInTime, when set, requests WAL replay up to this timestamp instead of restoring
+	// the backup artifact as-is. SourceBackupRef must have WALArchiving enabled.
+	PointInTime *metav1.Time `json:"pointInTime,omitempty"`
+}
+
+// +kubebuilder:deepcopy
+type TenantRestoreStatus struct {
+	// Phase is the current lifecycle phase of the restore, reusing the TenantBackup phase enum
+	// +kubebuilder:validation:Enum=Pending;Running;Completed;Failed
+	Phase TenantBackupPhase `json:"phase,omitempty"`
+	// StartTime is when the restore Job started running
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// CompletionTime is when the restore Job finished
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+	// Progress is an approximate completion percentage, 0-100
+	Progress int32 `json:"progress,omitempty"`
+	// Message carries the latest human-readable status detail, especially on failure
+	Message string `json:"message,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced
+// +kubebuilder:printcolumn:name="Tenant",type=string,JSONPath=`.spec.tenantRef`
+// +kubebuilder:printcolumn:name="Source",type=string,JSONPath=`.spec.sourceBackupRef`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// TenantRestore is the Schema for the tenantrestores API
+type TenantRestore struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              TenantRestoreSpec   `json:"spec,omitempty"`
+	Status            TenantRestoreStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// TenantRestoreList contains a list of TenantRestore
+type TenantRestoreList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TenantRestore `json:"items"`
+}
@@ -0,0 +1,60 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:deepcopy
+type ComponentVersion struct {
+	// Name matches a ComponentDefinition.Name in the TenantVersion's DefinitionRef
+	Name string `json:"name"`
+	// Image is the full image reference (including tag/digest) resolveComponent
+	// renders onto the component's container, replacing the hardcoded
+	// rezenkai/<service>:<version> image used when ComponentRef is unset
+	Image string `json:"image"`
+}
+
+// +kubebuilder:deepcopy
+type TenantVersionSpec struct {
+	// DefinitionRef names the TenantDefinition this version supplies images
+	// for; resolveComponent rejects a ServiceSpec whose VersionRef points at
+	// a TenantVersion with a different DefinitionRef than its ComponentRef
+	DefinitionRef string `json:"definitionRef"`
+	// Components lists the image for each component this version covers;
+	// a component absent here has no image available under this version
+	Components []ComponentVersion `json:"components"`
+}
+
+// +kubebuilder:deepcopy
+type TenantVersionStatus struct {
+	// Phase is "Ready" once this TenantVersion's DefinitionRef has resolved and
+	// declares every component listed in Components, "Failed" otherwise
+	Phase string `json:"phase,omitempty"`
+	// Message carries the latest human-readable status detail, especially on failure
+	Message string `json:"message,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:printcolumn:name="Definition",type=string,JSONPath=`.spec.definitionRef`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// TenantVersion is the Schema for the tenantversions API. It is
+// cluster-scoped: editing one TenantVersion's Components rolls a new image
+// out to every Tenant whose ServiceSpec references it via VersionRef,
+// enabling fleet-wide rollouts without touching individual Tenant objects.
+type TenantVersion struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              TenantVersionSpec   `json:"spec,omitempty"`
+	Status            TenantVersionStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// TenantVersionList contains a list of TenantVersion
+type TenantVersionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TenantVersion `json:"items"`
+}
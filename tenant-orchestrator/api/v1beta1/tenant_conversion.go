@@ -0,0 +1,74 @@
+package v1beta1
+
+import (
+	alpha "github.com/rezenkai/multi-saas-crm/tenant-orchestrator/api/v1alpha1"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+)
+
+// ConvertTo converts src (v1beta1, the spoke) to the v1alpha1 hub dstRaw,
+// satisfying sigs.k8s.io/controller-runtime/pkg/conversion.Convertible.
+// TierRef.Class and any per-service Resources.Services override have no
+// v1alpha1 equivalent and are dropped; ConvertFrom documents how they're
+// reconstructed (best-effort) on the way back.
+func (src *Tenant) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*alpha.Tenant)
+
+	dst.ObjectMeta = src.ObjectMeta
+
+	dst.Spec.OrganizationName = src.Spec.OrganizationName
+	dst.Spec.Tier = src.Spec.TierRef.Name
+	dst.Spec.Resources = src.Spec.Resources.Database
+	dst.Spec.Services = src.Spec.Services
+	dst.Spec.Database = src.Spec.Database
+	dst.Spec.Domains = src.Spec.Domains
+	dst.Spec.Features = src.Spec.Features
+	dst.Spec.Placement = src.Spec.Placement
+	dst.Spec.Ingress = src.Spec.Ingress
+	dst.Spec.ClientCertificates = src.Spec.ClientCertificates
+	dst.Spec.TLS = src.Spec.TLS
+	dst.Spec.SecretRotation = src.Spec.SecretRotation
+	dst.Spec.Middlewares = src.Spec.Middlewares
+	dst.Spec.Cordoned = src.Spec.Cordoned
+
+	dst.Status = src.Status
+
+	return nil
+}
+
+// ConvertFrom converts the v1alpha1 hub srcRaw to dst (v1beta1, the spoke),
+// satisfying conversion.Convertible. Spec.Resources becomes both
+// ComponentResources.Database and every Services entry (one per
+// src.Spec.Services, keyed by name), matching ComponentResources' doc
+// comment: v1alpha1 never had per-service resources, so every service
+// starts out with the tenant's one shared allocation until a v1beta1 client
+// overrides an individual entry.
+func (dst *Tenant) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*alpha.Tenant)
+
+	dst.ObjectMeta = src.ObjectMeta
+
+	dst.Spec.OrganizationName = src.Spec.OrganizationName
+	dst.Spec.TierRef = TierReference{Name: src.Spec.Tier}
+	dst.Spec.Resources = ComponentResources{Database: src.Spec.Resources}
+	if len(src.Spec.Services) > 0 {
+		dst.Spec.Resources.Services = make(map[string]alpha.ResourceSpec, len(src.Spec.Services))
+		for _, svc := range src.Spec.Services {
+			dst.Spec.Resources.Services[svc.Name] = src.Spec.Resources
+		}
+	}
+	dst.Spec.Services = src.Spec.Services
+	dst.Spec.Database = src.Spec.Database
+	dst.Spec.Domains = src.Spec.Domains
+	dst.Spec.Features = src.Spec.Features
+	dst.Spec.Placement = src.Spec.Placement
+	dst.Spec.Ingress = src.Spec.Ingress
+	dst.Spec.ClientCertificates = src.Spec.ClientCertificates
+	dst.Spec.TLS = src.Spec.TLS
+	dst.Spec.SecretRotation = src.Spec.SecretRotation
+	dst.Spec.Middlewares = src.Spec.Middlewares
+	dst.Spec.Cordoned = src.Spec.Cordoned
+
+	dst.Status = src.Status
+
+	return nil
+}
@@ -0,0 +1,146 @@
+package v1beta1
+
+import (
+	alpha "github.com/rezenkai/multi-saas-crm/tenant-orchestrator/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+)
+
+// +kubebuilder:deepcopy
+// TierReference replaces v1alpha1 TenantSpec.Tier's plain string with a
+// structured reference, so a tier can later grow a Class grouping (e.g.
+// "shared" vs "dedicated" host pools) without another field rename.
+type TierReference struct {
+	// Name is the tier name, as v1alpha1's Tier field held it directly.
+	// +kubebuilder:validation:Enum=starter;professional;enterprise
+	Name string `json:"name"`
+	// Class optionally pins this tenant onto a named ClusterTierClass-style
+	// pool instead of leaving the host pool implicit in Name alone. Empty
+	// preserves v1alpha1's implicit one-tier-one-pool behavior.
+	Class string `json:"class,omitempty"`
+}
+
+// +kubebuilder:deepcopy
+// ComponentResources replaces v1alpha1 TenantSpec's single Resources block,
+// shared by the database and every service alike, with a per-component
+// split -- the same shape KubeBlocks/TiDB-operator use for multi-component
+// workloads. Converting from v1alpha1 copies its one ResourceSpec into both
+// Database and every entry of Services; converting back only has Database
+// to fall back to, so per-service overrides made under v1beta1 are lost on
+// a round trip through v1alpha1 (documented on TenantSpec.Resources below).
+type ComponentResources struct {
+	// Database is the resource allocation for the tenant's database workload
+	Database alpha.ResourceSpec `json:"database"`
+	// Services maps a ServiceSpec.Name to its own resource allocation.
+	// A service missing from this map falls back to Database's allocation,
+	// matching v1alpha1's one-block-for-everything behavior.
+	Services map[string]alpha.ResourceSpec `json:"services,omitempty"`
+}
+
+// +kubebuilder:deepcopy
+type TenantSpec struct {
+	// OrganizationName of the tenant organization
+	OrganizationName string `json:"organizationName"`
+	// TierRef determines resource allocation, replacing v1alpha1's plain Tier string
+	TierRef TierReference `json:"tierRef"`
+	// Resources configuration, split per component. See ComponentResources'
+	// doc comment for how this behaves across a v1alpha1 conversion round trip.
+	Resources ComponentResources `json:"resources"`
+	// Services to enable for this tenant
+	Services []alpha.ServiceSpec `json:"services"`
+	// Database configuration
+	Database alpha.DatabaseSpec `json:"database"`
+	// Domain configuration
+	Domains []string `json:"domains,omitempty"`
+	// Feature flags
+	Features map[string]bool `json:"features,omitempty"`
+	// Placement controls scheduling this tenant across multiple member clusters.
+	// Leaving it unset keeps the tenant on the hub cluster only.
+	Placement *alpha.TenantPlacement `json:"placement,omitempty"`
+	// Ingress selects which ingress controller fronts this tenant's
+	// Domains. Leave unset to use the operator-wide default provider.
+	Ingress *alpha.IngressSpec `json:"ingress,omitempty"`
+	// ClientCertificates enables mTLS at the ingress and propagates the
+	// verified client cert to backend services. Leave nil to terminate TLS
+	// without requiring or forwarding a client certificate.
+	ClientCertificates *alpha.ClientCertificateSpec `json:"clientCertificates,omitempty"`
+	// TLS configures how Domains get a certificate and how HTTP/host
+	// redirects behave. Leave nil to keep the operator's previous
+	// hard-coded behavior: a letsencrypt-prod ClusterIssuer and a plain
+	// SSL redirect with no www/apex canonicalization.
+	TLS *alpha.TLSSpec `json:"tls,omitempty"`
+	// SecretRotation configures automatic rotation of this tenant's
+	// generated credentials (DB password, JWT signing key, admin bootstrap
+	// token, gateway API keys). Leave nil to generate each credential once
+	// and never rotate it.
+	SecretRotation *alpha.SecretRotationSpec `json:"secretRotation,omitempty"`
+	// Middlewares is the ordered Traefik middleware chain applied to this
+	// tenant's IngressRoute, ahead of the operator's own HTTPS redirect
+	// middleware. Only read when Ingress.Provider is "traefik-crd"; ignored
+	// by every other provider.
+	Middlewares []alpha.TenantMiddlewareSpec `json:"middlewares,omitempty"`
+	// Cordoned blocks creation of new Services for this tenant while
+	// leaving already-running ones untouched, Capsule-style.
+	Cordoned bool `json:"cordoned,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced
+// +kubebuilder:printcolumn:name="Organization",type=string,JSONPath=`.spec.organizationName`
+// +kubebuilder:printcolumn:name="Tier",type=string,JSONPath=`.spec.tierRef.name`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// Tenant is the Schema for the tenants API. v1alpha1.Tenant is this type's
+// conversion hub; see tenant_conversion.go for the ConvertTo/ConvertFrom
+// pair that lets existing v1alpha1 Tenants upgrade transparently.
+type Tenant struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              TenantSpec         `json:"spec,omitempty"`
+	Status            alpha.TenantStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// TenantList contains a list of Tenant
+type TenantList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Tenant `json:"items"`
+}
+
+// SchemeGroupVersion is the group version used to register these objects
+var SchemeGroupVersion = schema.GroupVersion{Group: "multi-saas-crm.rezenkai.com", Version: "v1beta1"}
+
+// SchemeBuilder is used to register CRD types
+var SchemeBuilder = runtime.NewSchemeBuilder(addKnownTypes)
+
+// AddToScheme adds the CRD types to a scheme
+var AddToScheme = SchemeBuilder.AddToScheme
+
+// Scheme defines methods for serializing and deserializing API objects.
+var Scheme = runtime.NewScheme()
+
+// addKnownTypes adds the set of types defined in this package to the supplied scheme.
+func addKnownTypes(scheme *runtime.Scheme) error {
+	scheme.AddKnownTypes(SchemeGroupVersion,
+		&Tenant{},
+		&TenantList{},
+	)
+	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
+	return nil
+}
+
+func init() {
+	// Add the core Kubernetes types to the scheme
+	utilruntime.Must(clientgoscheme.AddToScheme(Scheme))
+
+	// Register our known types
+	SchemeBuilder.Register(addKnownTypes)
+
+	// Add our types to the scheme
+	utilruntime.Must(AddToScheme(Scheme))
+}
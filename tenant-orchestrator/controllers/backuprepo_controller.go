@@ -0,0 +1,66 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	tenantv1alpha1 "github.com/rezenkai/multi-saas-crm/tenant-orchestrator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// BackupRepoReconciler reflects whether a BackupRepo's CredentialsSecretRef
+// actually resolves. Resolving the Secret's contents into an upload/download
+// container happens in pkg/backup at Job-build time, since that's driven by
+// TenantBackup/TenantRestore reconciliation, not this one.
+type BackupRepoReconciler struct {
+	client.Client
+	Scheme        *runtime.Scheme
+	EventRecorder record.EventRecorder
+}
+
+// +kubebuilder:rbac:groups=tenant.rezenkai.com,resources=backuprepos,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=tenant.rezenkai.com,resources=backuprepos/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch
+
+func (r *BackupRepoReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	repo := &tenantv1alpha1.BackupRepo{}
+	if err := r.Get(ctx, req.NamespacedName, repo); err != nil {
+		if errors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	secretRef := repo.Spec.CredentialsSecretRef
+	secret := &corev1.Secret{}
+	err := r.Get(ctx, types.NamespacedName{Name: secretRef.Name, Namespace: secretRef.Namespace}, secret)
+	switch {
+	case errors.IsNotFound(err):
+		repo.Status.Phase = "Failed"
+		repo.Status.Message = fmt.Sprintf("credentials secret %s/%s not found", secretRef.Namespace, secretRef.Name)
+		r.EventRecorder.Event(repo, corev1.EventTypeWarning, "CredentialsMissing", repo.Status.Message)
+	case err != nil:
+		return ctrl.Result{}, err
+	default:
+		repo.Status.Phase = "Ready"
+		repo.Status.Message = ""
+	}
+
+	if err := r.Status().Update(ctx, repo); err != nil {
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{RequeueAfter: 5 * time.Minute}, nil
+}
+
+func (r *BackupRepoReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&tenantv1alpha1.BackupRepo{}).
+		Complete(r)
+}
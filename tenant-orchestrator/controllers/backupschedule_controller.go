@@ -0,0 +1,156 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	tenantv1alpha1 "github.com/rezenkai/multi-saas-crm/tenant-orchestrator/api/v1alpha1"
+	"github.com/robfig/cron/v3"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// backupScheduleLabel names the BackupSchedule a TenantBackup was created
+// from, so retention pruning and listing don't need an index lookup.
+const backupScheduleLabel = "tenant.rezenkai.com/backup-schedule"
+
+// BackupScheduleReconciler stamps out TenantBackups for its Spec.TenantRef on
+// Spec.Schedule's cron cadence and prunes the ones it created past
+// Spec.RetentionCount/RetentionDuration, the same two jobs
+// pkg/scheduler.runBackup/runRetentionPrune perform for
+// Tenant.Spec.Database.Backup, but as a dedicated, pausable object.
+type BackupScheduleReconciler struct {
+	client.Client
+	Scheme        *runtime.Scheme
+	EventRecorder record.EventRecorder
+}
+
+// +kubebuilder:rbac:groups=tenant.rezenkai.com,resources=backupschedules,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=tenant.rezenkai.com,resources=backupschedules/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=tenant.rezenkai.com,resources=tenantbackups,verbs=get;list;watch;create;update;patch;delete
+
+func (r *BackupScheduleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	bs := &tenantv1alpha1.BackupSchedule{}
+	if err := r.Get(ctx, req.NamespacedName, bs); err != nil {
+		if errors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	sched, err := cron.ParseStandard(bs.Spec.Schedule)
+	if err != nil {
+		bs.Status.Phase = "Failed"
+		bs.Status.Message = fmt.Sprintf("invalid schedule %q: %v", bs.Spec.Schedule, err)
+		return ctrl.Result{}, r.Status().Update(ctx, bs)
+	}
+
+	if bs.Spec.Paused {
+		bs.Status.Phase = "Paused"
+		bs.Status.Message = ""
+		return ctrl.Result{RequeueAfter: time.Minute}, r.Status().Update(ctx, bs)
+	}
+
+	reference := bs.CreationTimestamp.Time
+	if bs.Status.LastScheduledTime != nil {
+		reference = bs.Status.LastScheduledTime.Time
+	}
+	now := time.Now()
+	if sched.Next(reference).Before(now) || sched.Next(reference).Equal(now) {
+		if err := r.createBackup(ctx, bs); err != nil {
+			bs.Status.Phase = "Failed"
+			bs.Status.Message = fmt.Sprintf("failed to create scheduled backup: %v", err)
+			return ctrl.Result{}, r.Status().Update(ctx, bs)
+		}
+		bs.Status.LastScheduledTime = &metav1.Time{Time: now}
+	}
+
+	if err := r.pruneExpired(ctx, bs); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	next := metav1.Time{Time: sched.Next(now)}
+	bs.Status.Phase = "Active"
+	bs.Status.Message = ""
+	bs.Status.NextScheduledTime = &next
+	return ctrl.Result{RequeueAfter: time.Minute}, r.Status().Update(ctx, bs)
+}
+
+// createBackup stamps Spec.Template into a new TenantBackup, owned by bs so
+// it's both watchable via Owns and garbage-collected with it.
+func (r *BackupScheduleReconciler) createBackup(ctx context.Context, bs *tenantv1alpha1.BackupSchedule) error {
+	var spec tenantv1alpha1.TenantBackupSpec
+	bs.Spec.Template.DeepCopyInto(&spec)
+	spec.TenantRef = bs.Spec.TenantRef
+	spec.Schedule = ""
+	tb := &tenantv1alpha1.TenantBackup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-%s", bs.Name, time.Now().Format("20060102-150405")),
+			Namespace: bs.Namespace,
+			Labels:    map[string]string{backupScheduleLabel: bs.Name},
+		},
+		Spec: spec,
+	}
+	if err := controllerutil.SetControllerReference(bs, tb, r.Scheme); err != nil {
+		return err
+	}
+	if err := r.Create(ctx, tb); err != nil {
+		return err
+	}
+	r.EventRecorder.Event(bs, corev1.EventTypeNormal, "BackupCreated", fmt.Sprintf("TenantBackup %s created", tb.Name))
+	return nil
+}
+
+// pruneExpired deletes completed TenantBackups bs created that exceed its
+// retention policy, mirroring scheduler.runRetentionPrune's RetentionCount/
+// RetentionDuration precedence.
+func (r *BackupScheduleReconciler) pruneExpired(ctx context.Context, bs *tenantv1alpha1.BackupSchedule) error {
+	backups := &tenantv1alpha1.TenantBackupList{}
+	if err := r.List(ctx, backups, client.InNamespace(bs.Namespace), client.MatchingLabels{backupScheduleLabel: bs.Name}); err != nil {
+		return fmt.Errorf("failed to list backups for retention prune: %w", err)
+	}
+
+	var completed []*tenantv1alpha1.TenantBackup
+	for i := range backups.Items {
+		tb := &backups.Items[i]
+		if tb.Status.Phase != tenantv1alpha1.TenantBackupPhaseCompleted {
+			continue
+		}
+		completed = append(completed, tb)
+	}
+	sort.Slice(completed, func(i, j int) bool {
+		return completed[i].CreationTimestamp.After(completed[j].CreationTimestamp.Time)
+	})
+
+	durationCutoff := time.Now().Add(-bs.Spec.RetentionDuration.Duration)
+	for i, tb := range completed {
+		if bs.Spec.RetentionCount > 0 && i < int(bs.Spec.RetentionCount) {
+			continue
+		}
+		if bs.Spec.RetentionDuration.Duration > 0 && tb.CreationTimestamp.Time.After(durationCutoff) {
+			continue
+		}
+		if bs.Spec.RetentionCount == 0 && bs.Spec.RetentionDuration.Duration == 0 {
+			continue
+		}
+		if err := r.Delete(ctx, tb); err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("failed to prune expired backup %s: %w", tb.Name, err)
+		}
+	}
+	return nil
+}
+
+func (r *BackupScheduleReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&tenantv1alpha1.BackupSchedule{}).
+		Owns(&tenantv1alpha1.TenantBackup{}).
+		Complete(r)
+}
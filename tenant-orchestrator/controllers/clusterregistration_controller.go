@@ -0,0 +1,84 @@
+package controllers
+
+import (
+	"context"
+	"time"
+
+	tenantv1alpha1 "github.com/rezenkai/multi-saas-crm/tenant-orchestrator/api/v1alpha1"
+	"github.com/rezenkai/multi-saas-crm/tenant-orchestrator/pkg/discovery"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+const clusterRegistrationFinalizer = "tenant.rezenkai.com/cluster-finalizer"
+
+// ClusterRegistrationReconciler keeps discovery.Client's per-cluster
+// clients in sync with the registered ClusterRegistration objects.
+type ClusterRegistrationReconciler struct {
+	client.Client
+	Scheme        *runtime.Scheme
+	Discovery     *discovery.Client
+	EventRecorder record.EventRecorder
+}
+
+// +kubebuilder:rbac:groups=tenant.rezenkai.com,resources=clusterregistrations,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=tenant.rezenkai.com,resources=clusterregistrations/status,verbs=get;update;patch
+
+func (r *ClusterRegistrationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	reg := &tenantv1alpha1.ClusterRegistration{}
+	if err := r.Get(ctx, req.NamespacedName, reg); err != nil {
+		if errors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	if !reg.DeletionTimestamp.IsZero() {
+		if controllerutil.ContainsFinalizer(reg, clusterRegistrationFinalizer) {
+			r.Discovery.DeregisterCluster(reg.Spec.ClusterName)
+			controllerutil.RemoveFinalizer(reg, clusterRegistrationFinalizer)
+			if err := r.Update(ctx, reg); err != nil {
+				return ctrl.Result{}, err
+			}
+		}
+		return ctrl.Result{}, nil
+	}
+
+	if !controllerutil.ContainsFinalizer(reg, clusterRegistrationFinalizer) {
+		controllerutil.AddFinalizer(reg, clusterRegistrationFinalizer)
+		if err := r.Update(ctx, reg); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{Requeue: true}, nil
+	}
+
+	if err := r.Discovery.RegisterCluster(ctx, reg); err != nil {
+		reg.Status.Ready = false
+		reg.Status.Message = err.Error()
+		r.EventRecorder.Event(reg, corev1.EventTypeWarning, "ClusterUnreachable", err.Error())
+		if statusErr := r.Status().Update(ctx, reg); statusErr != nil {
+			return ctrl.Result{}, statusErr
+		}
+		return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
+	}
+
+	reg.Status.Ready = true
+	reg.Status.Message = ""
+	reg.Status.LastSeen = &metav1.Time{Time: time.Now()}
+	if err := r.Status().Update(ctx, reg); err != nil {
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{RequeueAfter: 5 * time.Minute}, nil
+}
+
+func (r *ClusterRegistrationReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&tenantv1alpha1.ClusterRegistration{}).
+		Complete(r)
+}
@@ -0,0 +1,75 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	tenantv1alpha1 "github.com/rezenkai/multi-saas-crm/tenant-orchestrator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DatastoreReconciler reflects a Datastore's reachability and how many
+// Tenants currently reference it; actually carving a tenant's
+// schema/database happens in TenantReconciler.reconcileSharedDatastoreSchema,
+// since that's driven by Tenant reconciliation, not this one.
+type DatastoreReconciler struct {
+	client.Client
+	Scheme        *runtime.Scheme
+	EventRecorder record.EventRecorder
+}
+
+// +kubebuilder:rbac:groups=tenant.rezenkai.com,resources=datastores,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=tenant.rezenkai.com,resources=datastores/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=tenant.rezenkai.com,resources=tenants,verbs=get;list;watch
+
+func (r *DatastoreReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	ds := &tenantv1alpha1.Datastore{}
+	if err := r.Get(ctx, req.NamespacedName, ds); err != nil {
+		if errors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	addr := fmt.Sprintf("%s:%d", ds.Spec.Host, ds.Spec.Port)
+	conn, err := net.DialTimeout("tcp", addr, 5*time.Second)
+	if err != nil {
+		ds.Status.Phase = "Failed"
+		ds.Status.Message = fmt.Sprintf("failed to reach %s: %v", addr, err)
+		r.EventRecorder.Event(ds, corev1.EventTypeWarning, "DatastoreUnreachable", ds.Status.Message)
+	} else {
+		conn.Close()
+		ds.Status.Phase = "Ready"
+		ds.Status.Message = ""
+	}
+
+	tenants := &tenantv1alpha1.TenantList{}
+	if err := r.List(ctx, tenants); err != nil {
+		return ctrl.Result{}, err
+	}
+	var count int32
+	for _, t := range tenants.Items {
+		if t.Spec.Database.DatastoreRef == ds.Name {
+			count++
+		}
+	}
+	ds.Status.TenantCount = count
+
+	if err := r.Status().Update(ctx, ds); err != nil {
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{RequeueAfter: 5 * time.Minute}, nil
+}
+
+func (r *DatastoreReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&tenantv1alpha1.Datastore{}).
+		Complete(r)
+}
@@ -1,28 +1,43 @@
 package controllers
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"math"
+	"os"
+	"reflect"
+	"strings"
 	"time"
 
 	tenantv1alpha1 "github.com/rezenkai/multi-saas-crm/tenant-orchestrator/api/v1alpha1"
+	"github.com/rezenkai/multi-saas-crm/tenant-orchestrator/pkg/backup"
 	"github.com/rezenkai/multi-saas-crm/tenant-orchestrator/pkg/discovery"
 	"github.com/rezenkai/multi-saas-crm/tenant-orchestrator/pkg/health"
+	"github.com/rezenkai/multi-saas-crm/tenant-orchestrator/pkg/ingress"
+	"github.com/rezenkai/multi-saas-crm/tenant-orchestrator/pkg/scheduler"
+	"github.com/rezenkai/multi-saas-crm/tenant-orchestrator/pkg/secrets"
 	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
-	networkingv1 "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 )
@@ -31,15 +46,206 @@ const (
 	tenantFinalizer = "tenant.rezenkai.com/finalizer"
 	ownerKey        = ".metadata.controller"
 	apiVersion      = "tenant.rezenkai.com/v1alpha1"
+
+	// spiloImage is the Patroni-bundled Postgres image used for HA database
+	// StatefulSets. Only the Postgres major version varies per tenant.
+	spiloImage = "registry.opensource.zalan.do/acid/spilo-15"
+	// defaultPatroniEtcdEndpoints is used when PATRONI_ETCD_ENDPOINTS isn't
+	// set in the operator's environment: a single etcd cluster shared across
+	// all HA tenants, namespaced by Patroni scope rather than one etcd
+	// sub-cluster per tenant.
+	defaultPatroniEtcdEndpoints = "etcd.patroni-system.svc.cluster.local:2379"
 )
 
+// patroniEtcdEndpoints returns the shared etcd DCS endpoints Patroni uses to
+// elect a leader and store cluster state, configurable per-operator via
+// PATRONI_ETCD_ENDPOINTS.
+func patroniEtcdEndpoints() string {
+	if v := os.Getenv("PATRONI_ETCD_ENDPOINTS"); v != "" {
+		return v
+	}
+	return defaultPatroniEtcdEndpoints
+}
+
+// specHashAnnotation records a hash of a managed child object's full
+// desired content (its typed Spec plus its own annotations), so
+// reconciliation can detect drift across every field the operator manages
+// -- env vars, resources, probes, volumes, sidecars, container counts,
+// Service/Ingress annotations -- not just a Deployment's first container
+// image and replica count.
+const specHashAnnotation = "tenant.multisaas.io/spec-hash"
+
+// specHash hashes obj's desired content: its Spec field (found via
+// reflection so this works for any typed object this operator manages --
+// Deployment, StatefulSet, Service, Ingress, ... -- without a type switch
+// that has to be extended per kind) plus its own annotations other than
+// specHashAnnotation itself, the way pkg/ingress's unstructured objects
+// carry annotations pkg/ingress/nginx.go sets directly on the object rather
+// than in a nested Spec field. For unstructured.Unstructured it hashes the
+// "spec" map instead, since that's what pkg/ingress's Traefik/APISIX/Kourier
+// builders populate.
+func specHash(obj client.Object) (string, error) {
+	annotations := obj.GetAnnotations()
+	if annotations != nil {
+		stripped := make(map[string]string, len(annotations))
+		for k, v := range annotations {
+			if k != specHashAnnotation {
+				stripped[k] = v
+			}
+		}
+		annotations = stripped
+	}
+	hashable := map[string]interface{}{"annotations": annotations}
+	if u, ok := obj.(*unstructured.Unstructured); ok {
+		spec, _, _ := unstructured.NestedMap(u.Object, "spec")
+		hashable["spec"] = spec
+	} else {
+		field := reflect.ValueOf(obj).Elem().FieldByName("Spec")
+		if !field.IsValid() {
+			return "", fmt.Errorf("specHash: %T has no Spec field", obj)
+		}
+		hashable["spec"] = field.Interface()
+	}
+	data, err := json.Marshal(hashable)
+	if err != nil {
+		return "", fmt.Errorf("failed to hash %T: %w", obj, err)
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// newEmptyLike returns a zero-value object of obj's concrete type, for
+// applyWithSpecHash's Get target when the caller (reconcileIngress, looping
+// over whichever object kinds the chosen ingress.Provider built) doesn't
+// know that type ahead of time. unstructured.Unstructured needs its GVK
+// carried over explicitly, since client.Get resolves the object kind from it.
+func newEmptyLike(obj client.Object) client.Object {
+	if u, ok := obj.(*unstructured.Unstructured); ok {
+		empty := &unstructured.Unstructured{}
+		empty.SetGroupVersionKind(u.GroupVersionKind())
+		return empty
+	}
+	return reflect.New(reflect.TypeOf(obj).Elem()).Interface().(client.Object)
+}
+
+// applyWithSpecHash stamps desired's specHash annotation, sets tenant as its
+// controller, and creates it if absent or patches it in place if its live
+// specHash differs -- so a managed object converges back to its desired
+// spec even after an external edit, the way the old image+replica-count-only
+// deploymentEqual never did for Services, Ingresses, or any field beyond a
+// Deployment's first container. found must be a pointer to a zero-value
+// object of desired's concrete type; it's populated by the Get this performs
+// the way callers previously did their own Get before deciding whether to
+// create or update.
+func (r *TenantReconciler) applyWithSpecHash(ctx context.Context, tenant *tenantv1alpha1.Tenant, desired, found client.Object) error {
+	hash, err := specHash(desired)
+	if err != nil {
+		return err
+	}
+	annotations := desired.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[specHashAnnotation] = hash
+	desired.SetAnnotations(annotations)
+	if err := controllerutil.SetControllerReference(tenant, desired, r.Scheme); err != nil {
+		return err
+	}
+	getErr := r.Get(ctx, types.NamespacedName{Name: desired.GetName(), Namespace: desired.GetNamespace()}, found)
+	switch {
+	case errors.IsNotFound(getErr):
+		return r.Create(ctx, desired)
+	case getErr != nil:
+		return getErr
+	case found.GetAnnotations()[specHashAnnotation] != hash:
+		return r.updateWithSpecHash(ctx, desired, found)
+	default:
+		return nil
+	}
+}
+
+// updateWithSpecHash sends the actual Update once applyWithSpecHash has
+// decided found's specHash is stale. Most object kinds can just take a
+// full-object Update of desired, but StatefulSet and Service both reject or
+// corrupt that: the API server forbids changing anything in a StatefulSet's
+// spec besides Replicas/Template/UpdateStrategy/
+// PersistentVolumeClaimRetentionPolicy/MinReadySeconds (a freshly-built
+// desired object also won't round-trip server-side defaulting applied to
+// VolumeClaimTemplates at creation, so even a no-op field trips "Forbidden:
+// updates to statefulset spec... are forbidden"), and a full Service Update
+// clears the server-assigned, immutable ClusterIP/ClusterIPs the moment the
+// hash changes. Both get a narrower patch that only carries over the
+// mutable fields onto the live object instead.
+func (r *TenantReconciler) updateWithSpecHash(ctx context.Context, desired, found client.Object) error {
+	switch d := desired.(type) {
+	case *appsv1.StatefulSet:
+		f := found.(*appsv1.StatefulSet)
+		f.Spec.Replicas = d.Spec.Replicas
+		f.Spec.Template = d.Spec.Template
+		f.Spec.UpdateStrategy = d.Spec.UpdateStrategy
+		f.Spec.PersistentVolumeClaimRetentionPolicy = d.Spec.PersistentVolumeClaimRetentionPolicy
+		f.Spec.MinReadySeconds = d.Spec.MinReadySeconds
+		f.SetAnnotations(d.GetAnnotations())
+		return r.Update(ctx, f)
+	case *corev1.Service:
+		f := found.(*corev1.Service)
+		d.Spec.ClusterIP = f.Spec.ClusterIP
+		d.Spec.ClusterIPs = f.Spec.ClusterIPs
+		d.SetResourceVersion(f.GetResourceVersion())
+		return r.Update(ctx, d)
+	default:
+		desired.SetResourceVersion(found.GetResourceVersion())
+		return r.Update(ctx, desired)
+	}
+}
+
+// defaultDatabaseSidecars returns the cluster-wide sidecar containers a
+// platform team forces into every tenant's database pod (e.g. a
+// postgres-exporter and a Fluent Bit shipper), configured via
+// DEFAULT_DB_SIDECARS as a JSON-encoded []corev1.Container. Unset or
+// unparsable returns nil, so a tenant without the env var behaves exactly as
+// it did before Sidecars existed.
+func defaultDatabaseSidecars(ctx context.Context) []corev1.Container {
+	raw := os.Getenv("DEFAULT_DB_SIDECARS")
+	if raw == "" {
+		return nil
+	}
+	var sidecars []corev1.Container
+	if err := json.Unmarshal([]byte(raw), &sidecars); err != nil {
+		log.FromContext(ctx).Error(err, "failed to parse DEFAULT_DB_SIDECARS")
+		return nil
+	}
+	return sidecars
+}
+
+// mergedDatabaseSidecars combines the cluster-wide default sidecars with
+// tenant's own Spec.Database.Sidecars, in that order, so a per-tenant sidecar
+// can override a default's behavior (e.g. a differently-configured exporter)
+// simply by appearing later in the pod's container list.
+func mergedDatabaseSidecars(ctx context.Context, tenant *tenantv1alpha1.Tenant) []corev1.Container {
+	var sidecars []corev1.Container
+	sidecars = append(sidecars, defaultDatabaseSidecars(ctx)...)
+	sidecars = append(sidecars, tenant.Spec.Database.Sidecars...)
+	return sidecars
+}
+
 // TenantReconciler reconciles a Tenant object
 type TenantReconciler struct {
 	client.Client
 	Scheme        *runtime.Scheme
 	Discovery     *discovery.Client
 	HealthMonitor *health.Monitor
+	Scheduler     *scheduler.Scheduler
 	EventRecorder record.EventRecorder
+	// DefaultIngressProvider is the pkg/ingress.Provider name used for
+	// tenants that don't set Spec.Ingress.Provider themselves. Empty falls
+	// back to ingress.DefaultProviderName (nginx).
+	DefaultIngressProvider string
+	// AllowedMiddlewareNamespaces lists the namespaces, beyond a tenant's own,
+	// a Spec.Middlewares ExternalRef may point a Traefik Middleware at. A
+	// cross-namespace reference outside this list is rejected, so one
+	// tenant can't silently wire itself up to another tenant's middleware.
+	AllowedMiddlewareNamespaces []string
 }
 
 // +kubebuilder:rbac:groups=tenant.rezenkai.com,resources=tenants,verbs=get;list;watch;create;update;patch;delete
@@ -47,8 +253,13 @@ type TenantReconciler struct {
 // +kubebuilder:rbac:groups=tenant.rezenkai.com,resources=tenants/finalizers,verbs=update
 // +kubebuilder:rbac:groups=apps,resources=deployments;statefulsets,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=core,resources=services;configmaps;secrets;persistentvolumeclaims,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=core,resources=pods,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,resources=namespaces,verbs=get;update
+// +kubebuilder:rbac:groups=core,resources=resourcequotas,verbs=get;list;watch;create;update;patch
 // +kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=tenant.rezenkai.com,resources=datastores,verbs=get;list;watch
+// +kubebuilder:rbac:groups=tenant.rezenkai.com,resources=tenantdefinitions;tenantversions,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 
 func (r *TenantReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -78,6 +289,20 @@ func (r *TenantReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 		}
 	}
 
+	// A TenantOperation of type Suspend sets tenantSuspendedAnnotation to
+	// pause spec reconciliation without tearing anything down; a Resume
+	// operation clears it and lets the next reconcile pick back up where it
+	// left off.
+	if tenant.Annotations[tenantSuspendedAnnotation] == "true" {
+		tenant.Status.Phase = "Suspended"
+		tenant.Status.LastReconciled = &metav1.Time{Time: time.Now()}
+		if err := r.Status().Update(ctx, tenant); err != nil {
+			log.Error(err, "Failed to update Tenant status")
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{}, nil
+	}
+
 	// Reconcile tenant resources
 	result, err := r.reconcileTenant(ctx, tenant)
 	if err != nil {
@@ -85,6 +310,12 @@ func (r *TenantReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 		return result, err
 	}
 
+	// Keep the scheduler's job list for this tenant in sync with its spec
+	if err := r.Scheduler.RegisterTenant(tenant); err != nil {
+		log.Error(err, "Failed to register scheduled jobs")
+		r.EventRecorder.Event(tenant, corev1.EventTypeWarning, "SchedulerError", err.Error())
+	}
+
 	// Update status
 	tenant.Status.LastReconciled = &metav1.Time{Time: time.Now()}
 	if err := r.Status().Update(ctx, tenant); err != nil {
@@ -109,8 +340,17 @@ func (r *TenantReconciler) reconcileTenant(ctx context.Context, tenant *tenantv1
 		return ctrl.Result{}, err
 	}
 
+	// Materialize/rotate generated credentials before anything that consumes
+	// them, so a rotation's new credentials-hash is ready by the time the
+	// database StatefulSet and service Deployments are built.
+	creds, err := r.reconcileSecrets(ctx, tenant)
+	if err != nil {
+		log.Error(err, "Failed to reconcile secrets")
+		return ctrl.Result{RequeueAfter: 30 * time.Second}, err
+	}
+
 	// Deploy database
-	if err := r.reconcileDatabase(ctx, tenant); err != nil {
+	if err := r.reconcileDatabase(ctx, tenant, creds); err != nil {
 		meta.SetStatusCondition(&tenant.Status.Conditions, metav1.Condition{
 			Type:    "DatabaseReady",
 			Status:  metav1.ConditionFalse,
@@ -120,25 +360,37 @@ func (r *TenantReconciler) reconcileTenant(ctx context.Context, tenant *tenantv1
 		return ctrl.Result{RequeueAfter: 30 * time.Second}, err
 	}
 
-	// Deploy services
+	// Deploy services. A cordoned tenant rejects new ones (their Deployment
+	// doesn't exist yet) but keeps reconciling ones already running.
 	for _, svc := range tenant.Spec.Services {
-		if err := r.reconcileService(ctx, tenant, svc); err != nil {
+		if tenant.Spec.Cordoned {
+			exists, err := r.serviceDeploymentExists(ctx, tenant, svc)
+			if err != nil {
+				return ctrl.Result{}, err
+			}
+			if !exists {
+				log.Info("Tenant is cordoned, skipping new service", "service", svc.Name)
+				continue
+			}
+		}
+		if err := r.reconcileService(ctx, tenant, svc, creds.hash); err != nil {
 			log.Error(err, "Failed to reconcile service", "service", svc.Name)
 			return ctrl.Result{RequeueAfter: 30 * time.Second}, err
 		}
 	}
 
-	// Configure backups
+	// Mirror the tenant's ResourceQuota onto its namespace as annotations,
+	// and into Status.ResourceMetrics, so usage is visible via kubectl
+	// custom-columns without hitting the metrics API.
+	if err := r.reconcileQuota(ctx, tenant); err != nil {
+		log.Error(err, "Failed to reconcile resource quota")
+		r.EventRecorder.Event(tenant, corev1.EventTypeWarning, "QuotaError", err.Error())
+	}
+
+	// Backups are configured declaratively via TenantBackup/TenantRestore CRs
+	// (see TenantBackupReconciler/TenantRestoreReconciler); here we just reflect
+	// whether the tenant is opted in.
 	if tenant.Spec.Database.Backup.Enabled {
-		if err := r.reconcileBackup(ctx, tenant); err != nil {
-			meta.SetStatusCondition(&tenant.Status.Conditions, metav1.Condition{
-				Type:    "BackupReady",
-				Status:  metav1.ConditionFalse,
-				Reason:  "BackupError",
-				Message: err.Error(),
-			})
-			return ctrl.Result{RequeueAfter: 30 * time.Second}, err
-		}
 		meta.SetStatusCondition(&tenant.Status.Conditions, metav1.Condition{
 			Type:    "BackupReady",
 			Status:  metav1.ConditionTrue,
@@ -152,13 +404,22 @@ func (r *TenantReconciler) reconcileTenant(ctx context.Context, tenant *tenantv1
 		return ctrl.Result{RequeueAfter: 30 * time.Second}, err
 	}
 
+	// Fan out to member clusters if this tenant is multi-cluster placed
+	if err := r.reconcilePlacement(ctx, tenant); err != nil {
+		log.Error(err, "Failed to reconcile placement")
+		return ctrl.Result{RequeueAfter: 30 * time.Second}, err
+	}
+
 	// Run health checks
 	healthy, err := r.HealthMonitor.CheckTenantHealth(ctx, tenant)
 	if err != nil {
 		log.Error(err, "Health check failed")
 		return ctrl.Result{RequeueAfter: 1 * time.Minute}, nil
 	}
-	if healthy {
+	switch {
+	case tenant.Spec.Cordoned:
+		tenant.Status.Phase = "Cordoned"
+	case healthy:
 		tenant.Status.Phase = "Active"
 		r.EventRecorder.Event(tenant, corev1.EventTypeNormal, "Active", "Tenant is active and healthy")
 	}
@@ -188,7 +449,349 @@ func (r *TenantReconciler) ensureNamespace(ctx context.Context, tenant *tenantv1
 	return nil
 }
 
-func (r *TenantReconciler) reconcileDatabase(ctx context.Context, tenant *tenantv1alpha1.Tenant) error {
+// serviceDeploymentExists reports whether svc's Deployment has already been
+// created, letting the cordoned-tenant path in reconcileTenant tell a "new"
+// service (rejected) from one that's already running (left alone).
+func (r *TenantReconciler) serviceDeploymentExists(ctx context.Context, tenant *tenantv1alpha1.Tenant, svc tenantv1alpha1.ServiceSpec) (bool, error) {
+	found := &appsv1.Deployment{}
+	err := r.Get(ctx, types.NamespacedName{
+		Name:      fmt.Sprintf("%s-%s", tenant.Name, svc.Name),
+		Namespace: fmt.Sprintf("tenant-%s", tenant.Name),
+	}, found)
+	switch {
+	case errors.IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, err
+	default:
+		return true, nil
+	}
+}
+
+// quotaAnnotationPrefix and usedAnnotationPrefix namespace a tenant's
+// ResourceQuota hard limits and observed usage onto its namespace, so both
+// are visible via `kubectl get ns -o custom-columns` without hitting the
+// metrics API.
+const (
+	quotaAnnotationPrefix = "quota.multi-saas-crm.rezenkai.com"
+	usedAnnotationPrefix  = "used.multi-saas-crm.rezenkai.com"
+)
+
+// reconcileQuota mirrors tenant's Resources as hard limits on a ResourceQuota
+// in its namespace, then copies that ResourceQuota's hard/used fields onto
+// the namespace as annotations and into Status.ResourceMetrics. Status.Used
+// is only as fresh as the apiserver's own quota-usage tracking, which is
+// updated synchronously with every admission into the namespace, so this
+// never needs its own metrics-API client.
+func (r *TenantReconciler) reconcileQuota(ctx context.Context, tenant *tenantv1alpha1.Tenant) error {
+	nsName := fmt.Sprintf("tenant-%s", tenant.Name)
+	quota := &corev1.ResourceQuota{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-quota", tenant.Name),
+			Namespace: nsName,
+		},
+		Spec: corev1.ResourceQuotaSpec{
+			Hard: corev1.ResourceList{
+				corev1.ResourceRequestsCPU:     resource.MustParse(tenant.Spec.Resources.CPU.Request),
+				corev1.ResourceLimitsCPU:       resource.MustParse(tenant.Spec.Resources.CPU.Limit),
+				corev1.ResourceRequestsMemory:  resource.MustParse(tenant.Spec.Resources.Memory.Request),
+				corev1.ResourceLimitsMemory:    resource.MustParse(tenant.Spec.Resources.Memory.Limit),
+				corev1.ResourceRequestsStorage: resource.MustParse(tenant.Spec.Resources.Storage.Size),
+			},
+		},
+	}
+	found := &corev1.ResourceQuota{}
+	if err := r.applyWithSpecHash(ctx, tenant, quota, found); err != nil {
+		return fmt.Errorf("failed to reconcile resource quota: %w", err)
+	}
+
+	ns := &corev1.Namespace{}
+	if err := r.Get(ctx, types.NamespacedName{Name: nsName}, ns); err != nil {
+		return fmt.Errorf("failed to get namespace %s for quota annotations: %w", nsName, err)
+	}
+	if ns.Annotations == nil {
+		ns.Annotations = map[string]string{}
+	}
+	for name, qty := range quota.Spec.Hard {
+		ns.Annotations[fmt.Sprintf("%s/hard-%s", quotaAnnotationPrefix, name)] = qty.String()
+	}
+	for name, qty := range found.Status.Used {
+		ns.Annotations[fmt.Sprintf("%s/used-%s", usedAnnotationPrefix, name)] = qty.String()
+	}
+	if err := r.Update(ctx, ns); err != nil {
+		return fmt.Errorf("failed to annotate namespace %s with quota usage: %w", nsName, err)
+	}
+
+	if cpu, ok := found.Status.Used[corev1.ResourceRequestsCPU]; ok {
+		tenant.Status.ResourceMetrics.CPUUsage = cpu.String()
+	}
+	if mem, ok := found.Status.Used[corev1.ResourceRequestsMemory]; ok {
+		tenant.Status.ResourceMetrics.MemoryUsage = mem.String()
+	}
+	if storage, ok := found.Status.Used[corev1.ResourceRequestsStorage]; ok {
+		tenant.Status.ResourceMetrics.StorageUsage = storage.String()
+	}
+	tenant.Status.ResourceMetrics.UpdatedAt = &metav1.Time{Time: time.Now()}
+	return nil
+}
+
+// reconcilePlacement selects member clusters matching Spec.Placement and
+// fans out the tenant namespace to each, aggregating the result back into
+// Status.ClusterStatuses. It is a no-op when Placement is unset, leaving the
+// tenant on the hub cluster only.
+func (r *TenantReconciler) reconcilePlacement(ctx context.Context, tenant *tenantv1alpha1.Tenant) error {
+	placement := tenant.Spec.Placement
+	if placement == nil {
+		return nil
+	}
+
+	registrations := &tenantv1alpha1.ClusterRegistrationList{}
+	if err := r.List(ctx, registrations); err != nil {
+		return fmt.Errorf("failed to list cluster registrations: %w", err)
+	}
+
+	selector := labels.Everything()
+	if placement.ClusterSelector != nil {
+		sel, err := metav1.LabelSelectorAsSelector(placement.ClusterSelector)
+		if err != nil {
+			return fmt.Errorf("invalid cluster selector: %w", err)
+		}
+		selector = sel
+	}
+
+	var matched []tenantv1alpha1.ClusterRegistration
+	seenRegions := map[string]bool{}
+	for _, reg := range registrations.Items {
+		if !selector.Matches(labels.Set(reg.Spec.Labels)) {
+			continue
+		}
+		if placement.SpreadConstraints.RegionDiversity && reg.Spec.Region != "" && seenRegions[reg.Spec.Region] {
+			continue
+		}
+		matched = append(matched, reg)
+		seenRegions[reg.Spec.Region] = true
+		if max := placement.SpreadConstraints.MaxClusters; max > 0 && int32(len(matched)) >= max {
+			break
+		}
+	}
+
+	if min := placement.SpreadConstraints.MinClusters; min > 0 && int32(len(matched)) < min {
+		return fmt.Errorf("placement requires at least %d clusters but only %d matched the selector", min, len(matched))
+	}
+
+	namespace := fmt.Sprintf("tenant-%s", tenant.Name)
+	statuses := make([]tenantv1alpha1.ClusterStatus, 0, len(matched))
+	for _, reg := range matched {
+		status := tenantv1alpha1.ClusterStatus{Name: reg.Spec.ClusterName, LastSync: &metav1.Time{Time: time.Now()}}
+
+		memberClient, ok := r.Discovery.ClusterClient(reg.Spec.ClusterName)
+		if !ok {
+			status.Message = "cluster client not yet registered"
+			statuses = append(statuses, status)
+			continue
+		}
+
+		ns := &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: namespace,
+				Labels: map[string]string{
+					"tenant.rezenkai.com/name": tenant.Name,
+					"tenant.rezenkai.com/tier": tenant.Spec.Tier,
+				},
+			},
+		}
+		if err := memberClient.Create(ctx, ns); err != nil && !errors.IsAlreadyExists(err) {
+			status.Message = err.Error()
+			statuses = append(statuses, status)
+			continue
+		}
+		status.Ready = true
+		statuses = append(statuses, status)
+	}
+	tenant.Status.ClusterStatuses = statuses
+	return nil
+}
+
+// credentialsHashAnnotation is bumped onto a workload's pod template
+// whenever reconcileSecrets rotates a generated credential, so even
+// credentials the pod doesn't read via a watched Secret (ESO/Vault-backed
+// ones) still trigger a rolling update when they change.
+const credentialsHashAnnotation = "secrets.multi-saas-crm.io/credentials-hash"
+
+// credentialSet is what reconcileSecrets hands back to reconcileDatabase and
+// reconcileService: the database password value (needed to populate the
+// combined db-credentials Secret) and a hash summarizing every generated
+// credential (needed to roll workloads on rotation).
+type credentialSet struct {
+	password []byte
+	hash     string
+}
+
+// credentialDue reports whether kind should be (re)generated this
+// reconcile: the first time it's ever seen, or once
+// Spec.SecretRotation.Interval has elapsed since it was last rotated.
+func credentialDue(tenant *tenantv1alpha1.Tenant, kind secrets.Kind) bool {
+	last, rotatedBefore := tenant.Status.LastRotated[string(kind)]
+	if !rotatedBefore {
+		return true
+	}
+	rot := tenant.Spec.SecretRotation
+	return rot != nil && rot.Interval.Duration > 0 && time.Since(last.Time) >= rot.Interval.Duration
+}
+
+// markRotated records that kind was just (re)generated.
+func markRotated(tenant *tenantv1alpha1.Tenant, kind secrets.Kind) {
+	if tenant.Status.LastRotated == nil {
+		tenant.Status.LastRotated = map[string]metav1.Time{}
+	}
+	tenant.Status.LastRotated[string(kind)] = metav1.Time{Time: time.Now()}
+}
+
+// reconcileSecrets materializes/rotates every credential this operator
+// generates for tenant via its configured secrets.Provider: the database
+// password (folded into reconcileDatabase's combined Secret) plus a JWT
+// signing key, admin bootstrap token, and gateway API key (each their own
+// Secret/ExternalSecret).
+func (r *TenantReconciler) reconcileSecrets(ctx context.Context, tenant *tenantv1alpha1.Tenant) (credentialSet, error) {
+	providerName := ""
+	if rot := tenant.Spec.SecretRotation; rot != nil {
+		providerName = rot.Provider
+	}
+	provider, err := secrets.New(providerName)
+	if err != nil {
+		return credentialSet{}, fmt.Errorf("failed to resolve secret provider: %w", err)
+	}
+	password, passwordHash, err := r.reconcilePassword(ctx, tenant, provider)
+	if err != nil {
+		return credentialSet{}, err
+	}
+	otherHash, err := r.reconcileGeneratedSecrets(ctx, tenant, provider)
+	if err != nil {
+		return credentialSet{}, err
+	}
+	return credentialSet{
+		password: password,
+		hash:     secrets.HashValue([]byte(passwordHash + otherHash)),
+	}, nil
+}
+
+// reconcilePassword materializes the database password via provider. It
+// deliberately doesn't create a Secret of its own for the local provider:
+// the value is folded into reconcileDatabase's combined db-credentials
+// Secret instead, so username/password/database keep living together the
+// way they always have. For the external-secrets provider it does create
+// the ExternalSecret that syncs "password" into that same Secret name; for
+// vault, the value lives only in Vault and this Secret's password key is
+// left to whatever already reconciled it (e.g. ESO, or the Vault Agent
+// Injector sidecar at the pod level).
+func (r *TenantReconciler) reconcilePassword(ctx context.Context, tenant *tenantv1alpha1.Tenant, provider secrets.Provider) ([]byte, string, error) {
+	req := secrets.Request{
+		Kind:      secrets.KindDatabasePassword,
+		Name:      fmt.Sprintf("%s-db-credentials", tenant.Name),
+		Namespace: fmt.Sprintf("tenant-%s", tenant.Name),
+		Key:       "password",
+	}
+	regenerate := credentialDue(tenant, req.Kind)
+	obj, hash, err := provider.Materialize(ctx, req, regenerate)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to materialize %s: %w", req.Kind, err)
+	}
+	if secret, ok := obj.(*corev1.Secret); ok {
+		markRotated(tenant, req.Kind)
+		return secret.Data[req.Key], hash, nil
+	}
+	if obj != nil {
+		if err := controllerutil.SetControllerReference(tenant, obj, r.Scheme); err != nil {
+			return nil, "", err
+		}
+		if err := r.Create(ctx, obj); err != nil && !errors.IsAlreadyExists(err) {
+			return nil, "", err
+		}
+		markRotated(tenant, req.Kind)
+	}
+	existing := &corev1.Secret{}
+	if err := r.Get(ctx, types.NamespacedName{Name: req.Name, Namespace: req.Namespace}, existing); err == nil {
+		return existing.Data[req.Key], secrets.HashValue(existing.Data[req.Key]), nil
+	}
+	return nil, "", nil
+}
+
+// reconcileGeneratedSecrets materializes/rotates the JWT signing key, admin
+// bootstrap token, and gateway API key, each as its own Secret (or
+// ExternalSecret), and returns a combined hash of all three.
+func (r *TenantReconciler) reconcileGeneratedSecrets(ctx context.Context, tenant *tenantv1alpha1.Tenant, provider secrets.Provider) (string, error) {
+	ns := fmt.Sprintf("tenant-%s", tenant.Name)
+	reqs := []secrets.Request{
+		{Kind: secrets.KindJWTSigningKey, Name: fmt.Sprintf("%s-jwt-signing-key", tenant.Name), Namespace: ns, Key: "signing-key"},
+		{Kind: secrets.KindAdminBootstrapToken, Name: fmt.Sprintf("%s-admin-bootstrap", tenant.Name), Namespace: ns, Key: "token"},
+		{Kind: secrets.KindGatewayAPIKey, Name: fmt.Sprintf("%s-gateway-api-key", tenant.Name), Namespace: ns, Key: "api-key"},
+	}
+	var hashes []string
+	for _, req := range reqs {
+		regenerate := credentialDue(tenant, req.Kind)
+		obj, hash, err := provider.Materialize(ctx, req, regenerate)
+		if err != nil {
+			return "", fmt.Errorf("failed to materialize %s: %w", req.Kind, err)
+		}
+		if obj != nil {
+			if err := controllerutil.SetControllerReference(tenant, obj, r.Scheme); err != nil {
+				return "", err
+			}
+			secret, isSecret := obj.(*corev1.Secret)
+			if !isSecret {
+				if err := r.Create(ctx, obj); err != nil && !errors.IsAlreadyExists(err) {
+					return "", err
+				}
+			} else {
+				found := &corev1.Secret{}
+				getErr := r.Get(ctx, types.NamespacedName{Name: secret.Name, Namespace: secret.Namespace}, found)
+				switch {
+				case errors.IsNotFound(getErr):
+					if err := r.Create(ctx, secret); err != nil {
+						return "", err
+					}
+				case getErr != nil:
+					return "", getErr
+				default:
+					found.Data = secret.Data
+					if err := r.Update(ctx, found); err != nil {
+						return "", err
+					}
+				}
+			}
+			markRotated(tenant, req.Kind)
+		} else {
+			// Not due for rotation: Materialize returned no object and an
+			// empty hash (see pkg/secrets/local.go), so fall back to hashing
+			// the existing Secret's value instead, the same way
+			// reconcilePassword already does -- otherwise the combined hash
+			// would spuriously flip to a hash of empty strings the moment
+			// nothing is due, triggering an unwanted pod rollout even though
+			// no credential actually changed.
+			existing := &corev1.Secret{}
+			if err := r.Get(ctx, types.NamespacedName{Name: req.Name, Namespace: req.Namespace}, existing); err == nil {
+				hash = secrets.HashValue(existing.Data[req.Key])
+			}
+		}
+		hashes = append(hashes, hash)
+	}
+	return secrets.HashValue([]byte(strings.Join(hashes, ","))), nil
+}
+
+func (r *TenantReconciler) reconcileDatabase(ctx context.Context, tenant *tenantv1alpha1.Tenant, creds credentialSet) error {
+	if tenant.Spec.Database.DatastoreRef != "" {
+		ds := &tenantv1alpha1.Datastore{}
+		if err := r.Get(ctx, types.NamespacedName{Name: tenant.Spec.Database.DatastoreRef}, ds); err != nil {
+			return fmt.Errorf("failed to get datastore %q: %w", tenant.Spec.Database.DatastoreRef, err)
+		}
+		tenant.Status.DatabaseStatus.DatastoreName = ds.Name
+		if ds.Spec.Mode == tenantv1alpha1.DatastoreModeShared {
+			return r.reconcileSharedDatastoreSchema(ctx, tenant, ds, creds)
+		}
+		// DatastoreModeDedicated falls through to the StatefulSet-per-tenant
+		// flow below, same as a Tenant with no DatastoreRef at all.
+	}
+
 	// Create database secret
 	secret := &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
@@ -198,116 +801,199 @@ func (r *TenantReconciler) reconcileDatabase(ctx context.Context, tenant *tenant
 		Type: corev1.SecretTypeOpaque,
 		Data: map[string][]byte{
 			"username": []byte(fmt.Sprintf("tenant_%s", tenant.Name)),
-			"password": []byte(generatePassword()),
+			"password": creds.password,
 			"database": []byte(fmt.Sprintf("tenant_%s_db", tenant.Name)),
 		},
 	}
 	if err := controllerutil.SetControllerReference(tenant, secret, r.Scheme); err != nil {
 		return err
 	}
-	if err := r.Create(ctx, secret); err != nil && !errors.IsAlreadyExists(err) {
-		return err
+	foundSecret := &corev1.Secret{}
+	getErr := r.Get(ctx, types.NamespacedName{Name: secret.Name, Namespace: secret.Namespace}, foundSecret)
+	switch {
+	case errors.IsNotFound(getErr):
+		if err := r.Create(ctx, secret); err != nil {
+			return err
+		}
+	case getErr != nil:
+		return getErr
+	case len(creds.password) > 0 && !bytes.Equal(foundSecret.Data["password"], creds.password):
+		foundSecret.Data = secret.Data
+		if err := r.Update(ctx, foundSecret); err != nil {
+			return err
+		}
+	}
+
+	ha := tenant.Spec.Database.HighAvailability
+	if ha != nil && tenant.Spec.Database.Type == "postgres" {
+		if err := r.reconcilePatroniConfigMap(ctx, tenant); err != nil {
+			return err
+		}
+		if err := r.reconcileHAServices(ctx, tenant); err != nil {
+			return err
+		}
 	}
 
 	// Deploy database StatefulSet
-	statefulSet := r.databaseStatefulSet(tenant)
-	if err := controllerutil.SetControllerReference(tenant, statefulSet, r.Scheme); err != nil {
+	statefulSet := r.databaseStatefulSet(ctx, tenant, creds.hash)
+	found := &appsv1.StatefulSet{}
+	if err := r.applyWithSpecHash(ctx, tenant, statefulSet, found); err != nil {
 		return err
 	}
-	found := &appsv1.StatefulSet{}
-	err := r.Get(ctx, types.NamespacedName{Name: statefulSet.Name, Namespace: statefulSet.Namespace}, found)
-	if err != nil && errors.IsNotFound(err) {
+	if found.GetResourceVersion() == "" {
 		log.FromContext(ctx).Info("Creating database StatefulSet", "Name", statefulSet.Name)
-		err = r.Create(ctx, statefulSet)
-		if err != nil {
-			return err
-		}
-	} else if err != nil {
-		return err
+	}
+
+	if ha != nil && tenant.Spec.Database.Type == "postgres" {
+		r.reflectHAStatus(ctx, tenant)
 	}
 
 	// Update database status
-	tenant.Status.DatabaseStatus.ConnectionURL = fmt.Sprintf("%s-db-svc.tenant-%s.svc.cluster.local:5432/%s",
-		tenant.Name, tenant.Name, fmt.Sprintf("tenant_%s_db", tenant.Name))
+	if tenant.Spec.Database.Type == "mssql" {
+		tenant.Status.DatabaseStatus.ConnectionURL = fmt.Sprintf("sqlserver://%s:%s@%s-db-svc.tenant-%s.svc.cluster.local:1433?database=%s",
+			secret.Data["username"], secret.Data["password"], tenant.Name, tenant.Name, secret.Data["database"])
+	} else {
+		tenant.Status.DatabaseStatus.ConnectionURL = fmt.Sprintf("%s-db-svc.tenant-%s.svc.cluster.local:5432/%s",
+			tenant.Name, tenant.Name, fmt.Sprintf("tenant_%s_db", tenant.Name))
+	}
 	meta.SetStatusCondition(&tenant.Status.Conditions, metav1.Condition{
 		Type:    "DatabaseReady",
 		Status:  metav1.ConditionTrue,
 		Reason:  "DatabaseProvisioned",
 		Message: "Database is provisioned and ready",
 	})
+
+	sidecars := mergedDatabaseSidecars(ctx, tenant)
+	sidecarNames := make([]string, 0, len(sidecars))
+	for _, c := range sidecars {
+		sidecarNames = append(sidecarNames, c.Name)
+	}
+	tenant.Status.DatabaseStatus.Sidecars = sidecarNames
+	meta.SetStatusCondition(&tenant.Status.Conditions, metav1.Condition{
+		Type:    "SidecarsInjected",
+		Status:  metav1.ConditionTrue,
+		Reason:  "SidecarsReconciled",
+		Message: fmt.Sprintf("%d sidecar containers injected into the database pod", len(sidecarNames)),
+	})
 	return nil
 }
 
-func (r *TenantReconciler) reconcileBackup(ctx context.Context, tenant *tenantv1alpha1.Tenant) error {
-	log := log.FromContext(ctx).WithValues("tenant", tenant.Name)
-
-	// Handle manual backup request
-	if backupName, ok := tenant.Annotations["tenant.rezenkai.com/backup-request"]; ok {
-		log.Info("Processing backup request", "backup", backupName)
-		job := r.createBackupJob(tenant, backupName)
-		if err := controllerutil.SetOwnerReference(tenant, job, r.Scheme); err != nil {
-			return err
-		}
-		if err := r.Create(ctx, job); err != nil && !errors.IsAlreadyExists(err) {
-			return err
-		}
-		delete(tenant.Annotations, "tenant.rezenkai.com/backup-request")
-		if err := r.Update(ctx, tenant); err != nil {
-			return err
-		}
-		tenant.Status.DatabaseStatus.LastBackupTime = &metav1.Time{Time: time.Now()}
-		if err := r.Status().Update(ctx, tenant); err != nil {
-			return err
-		}
-		r.EventRecorder.Event(tenant, corev1.EventTypeNormal, "BackupStarted", fmt.Sprintf("Backup %s started", backupName))
+// reconcileSharedDatastoreSchema carves a per-tenant schema/database out of
+// a DatastoreModeShared Datastore via a one-shot provisioning Job, instead
+// of the StatefulSet-per-tenant flow reconcileDatabase otherwise runs. This
+// is the density play DatastoreRef exists for: hundreds of tenants behind
+// one running engine.
+func (r *TenantReconciler) reconcileSharedDatastoreSchema(ctx context.Context, tenant *tenantv1alpha1.Tenant, ds *tenantv1alpha1.Datastore, creds credentialSet) error {
+	adminSecretName, err := r.mirrorDatastoreAdminSecret(ctx, tenant, ds)
+	if err != nil {
+		return fmt.Errorf("failed to mirror datastore admin secret: %w", err)
 	}
 
-	// Handle restore request
-	if restoreName, ok := tenant.Annotations["tenant.rezenkai.com/restore-request"]; ok {
-		log.Info("Processing restore request", "restore", restoreName)
-		job := r.createRestoreJob(tenant, restoreName)
-		if err := controllerutil.SetOwnerReference(tenant, job, r.Scheme); err != nil {
-			return err
-		}
-		if err := r.Create(ctx, job); err != nil && !errors.IsAlreadyExists(err) {
-			return err
-		}
-		delete(tenant.Annotations, "tenant.rezenkai.com/restore-request")
-		if err := r.Update(ctx, tenant); err != nil {
+	script := datastoreSchemaScript(ds.Spec.Driver, tenant.Name)
+	sum := sha256.Sum256([]byte(script))
+	checksum := hex.EncodeToString(sum[:])
+
+	if tenant.Status.DatabaseStatus.SchemaChecksum != checksum {
+		job := datastoreSchemaJob(tenant, ds, adminSecretName, script, checksum)
+		if err := controllerutil.SetControllerReference(tenant, job, r.Scheme); err != nil {
 			return err
 		}
-		tenant.Status.DatabaseStatus.LastRestoreTime = &metav1.Time{Time: time.Now()}
-		if err := r.Status().Update(ctx, tenant); err != nil {
+		found := &batchv1.Job{}
+		err := r.Get(ctx, types.NamespacedName{Name: job.Name, Namespace: job.Namespace}, found)
+		switch {
+		case errors.IsNotFound(err):
+			if err := r.Create(ctx, job); err != nil {
+				return err
+			}
+			return fmt.Errorf("schema provisioning job %q created, waiting for it to complete", job.Name)
+		case err != nil:
 			return err
+		case found.Status.Succeeded == 0:
+			return fmt.Errorf("schema provisioning job %q has not completed yet", job.Name)
 		}
-		r.EventRecorder.Event(tenant, corev1.EventTypeNormal, "RestoreStarted", fmt.Sprintf("Restore %s started", restoreName))
+		tenant.Status.DatabaseStatus.SchemaChecksum = checksum
 	}
 
+	tenant.Status.DatabaseStatus.ConnectionURL = fmt.Sprintf("%s:%d/%s", ds.Spec.Host, ds.Spec.Port, fmt.Sprintf("tenant_%s_db", tenant.Name))
+	meta.SetStatusCondition(&tenant.Status.Conditions, metav1.Condition{
+		Type:    "DatabaseReady",
+		Status:  metav1.ConditionTrue,
+		Reason:  "SchemaProvisioned",
+		Message: fmt.Sprintf("Schema carved out of shared datastore %q", ds.Name),
+	})
 	return nil
 }
 
-func (r *TenantReconciler) createBackupJob(tenant *tenantv1alpha1.Tenant, backupName string) *batchv1.Job {
-	labels := map[string]string{"app": "backup", "tenant": tenant.Name}
-	dbType := tenant.Spec.Database.Type
-	command := []string{"pg_dump"}
-	args := []string{
-		"-h", fmt.Sprintf("%s-db-svc", tenant.Name),
-		"-U", fmt.Sprintf("tenant_%s", tenant.Name),
-		"-d", fmt.Sprintf("tenant_%s_db", tenant.Name),
-		"--file", fmt.Sprintf("/backup/%s.sql", backupName),
+// mirrorDatastoreAdminSecret copies ds.Spec.AdminSecretRef into tenant's own
+// namespace so the schema-provisioning Job can mount it: a Job's
+// SecretKeyRef can only ever resolve within its own namespace, but a
+// cluster-scoped Datastore's AdminSecretRef can live anywhere. Returns the
+// mirrored Secret's name.
+func (r *TenantReconciler) mirrorDatastoreAdminSecret(ctx context.Context, tenant *tenantv1alpha1.Tenant, ds *tenantv1alpha1.Datastore) (string, error) {
+	source := &corev1.Secret{}
+	if err := r.Get(ctx, types.NamespacedName{Name: ds.Spec.AdminSecretRef.Name, Namespace: ds.Spec.AdminSecretRef.Namespace}, source); err != nil {
+		return "", fmt.Errorf("failed to get admin secret %q/%q: %w", ds.Spec.AdminSecretRef.Namespace, ds.Spec.AdminSecretRef.Name, err)
 	}
-	if dbType == "mysql" {
-		command = []string{"mysqldump"}
-		args = []string{
-			"-h", fmt.Sprintf("%s-db-svc", tenant.Name),
-			"-u", fmt.Sprintf("tenant_%s", tenant.Name),
-			"--databases", fmt.Sprintf("tenant_%s_db", tenant.Name),
-			"--result-file", fmt.Sprintf("/backup/%s.sql", backupName),
+
+	mirror := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-datastore-admin", ds.Name),
+			Namespace: fmt.Sprintf("tenant-%s", tenant.Name),
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: source.Data,
+	}
+	if err := controllerutil.SetControllerReference(tenant, mirror, r.Scheme); err != nil {
+		return "", err
+	}
+	found := &corev1.Secret{}
+	err := r.Get(ctx, types.NamespacedName{Name: mirror.Name, Namespace: mirror.Namespace}, found)
+	switch {
+	case errors.IsNotFound(err):
+		if err := r.Create(ctx, mirror); err != nil {
+			return "", err
+		}
+	case err != nil:
+		return "", err
+	case !bytes.Equal(found.Data["username"], source.Data["username"]) || !bytes.Equal(found.Data["password"], source.Data["password"]):
+		found.Data = mirror.Data
+		if err := r.Update(ctx, found); err != nil {
+			return "", err
 		}
 	}
+	return mirror.Name, nil
+}
+
+// datastoreSchemaScript renders the idempotent SQL a schema-provisioning Job
+// runs against a DatastoreModeShared Datastore to carve out tenantName's own
+// database and login role, safe to reapply whenever the rendered script
+// (and therefore its checksum) doesn't change.
+func datastoreSchemaScript(driver, tenantName string) string {
+	dbName := fmt.Sprintf("tenant_%s_db", tenantName)
+	roleName := fmt.Sprintf("tenant_%s", tenantName)
+	if driver == "mysql" {
+		return fmt.Sprintf("CREATE DATABASE IF NOT EXISTS %s; CREATE USER IF NOT EXISTS '%s'@'%%'; GRANT ALL PRIVILEGES ON %s.* TO '%s'@'%%';",
+			dbName, roleName, dbName, roleName)
+	}
+	return fmt.Sprintf("DO $$ BEGIN CREATE ROLE %s LOGIN; EXCEPTION WHEN duplicate_object THEN NULL; END $$; "+
+		"CREATE DATABASE %s OWNER %s;", roleName, dbName, roleName)
+}
+
+// datastoreSchemaJob renders the one-shot Job that runs script against
+// ds using its AdminSecretRef credentials, named after checksum so a
+// changed script gets a fresh Job rather than trying to mutate a completed
+// one.
+func datastoreSchemaJob(tenant *tenantv1alpha1.Tenant, ds *tenantv1alpha1.Datastore, adminSecretName, script, checksum string) *batchv1.Job {
+	labels := map[string]string{"app": "datastore-schema", "tenant": tenant.Name}
+	command := []string{"psql", "-h", ds.Spec.Host, "-p", fmt.Sprintf("%d", ds.Spec.Port), "-U", "$(ADMIN_USERNAME)", "-c", script}
+	passwordEnvName := "PGPASSWORD"
+	if ds.Spec.Driver == "mysql" {
+		command = []string{"mysql", "-h", ds.Spec.Host, "-P", fmt.Sprintf("%d", ds.Spec.Port), "-u", "$(ADMIN_USERNAME)", "-e", script}
+		passwordEnvName = "MYSQL_PWD"
+	}
 	return &batchv1.Job{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      fmt.Sprintf("%s-backup-%s", tenant.Name, backupName),
+			Name:      fmt.Sprintf("%s-schema-%s", tenant.Name, checksum[:8]),
 			Namespace: fmt.Sprintf("tenant-%s", tenant.Name),
 			Labels:    labels,
 		},
@@ -318,64 +1004,117 @@ func (r *TenantReconciler) createBackupJob(tenant *tenantv1alpha1.Tenant, backup
 					RestartPolicy: corev1.RestartPolicyOnFailure,
 					Containers: []corev1.Container{
 						{
-							Name:    "backup",
-							Image:   fmt.Sprintf("%s:%s", dbType, tenant.Spec.Database.Version),
-							Command: command,
-							Args:    args,
+							Name:    "provision-schema",
+							Image:   fmt.Sprintf("%s:latest", ds.Spec.Driver),
+							Command: []string{"sh", "-c"},
+							Args:    []string{strings.Join(command, " ")},
 							Env: []corev1.EnvVar{
 								{
-									Name: "PGPASSWORD",
+									Name: "ADMIN_USERNAME",
 									ValueFrom: &corev1.EnvVarSource{
 										SecretKeyRef: &corev1.SecretKeySelector{
-											LocalObjectReference: corev1.LocalObjectReference{Name: fmt.Sprintf("%s-db-credentials", tenant.Name)},
+											LocalObjectReference: corev1.LocalObjectReference{Name: adminSecretName},
+											Key:                  "username",
+										},
+									},
+								},
+								{
+									Name: passwordEnvName,
+									ValueFrom: &corev1.EnvVarSource{
+										SecretKeyRef: &corev1.SecretKeySelector{
+											LocalObjectReference: corev1.LocalObjectReference{Name: adminSecretName},
 											Key:                  "password",
 										},
 									},
 								},
 							},
-							VolumeMounts: []corev1.VolumeMount{{Name: "backup-vol", MountPath: "/backup"}},
-						},
-						{
-							Name:    "uploader",
-							Image:   "amazon/aws-cli:latest",
-							Command: []string{"aws", "s3", "cp", fmt.Sprintf("/backup/%s.sql", backupName), fmt.Sprintf("s3://multi-saas-crm-backups/%s/%s.sql", tenant.Name, backupName)},
-							Env: []corev1.EnvVar{
-								{Name: "AWS_ACCESS_KEY_ID", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "aws-credentials"}, Key: "access-key-id"}}},
-								{Name: "AWS_SECRET_ACCESS_KEY", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "aws-credentials"}, Key: "secret-access-key"}}},
-							},
-							VolumeMounts: []corev1.VolumeMount{{Name: "backup-vol", MountPath: "/backup"}},
 						},
 					},
-					Volumes: []corev1.Volume{
-						{Name: "backup-vol", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}},
-					},
 				},
 			},
 		},
 	}
 }
 
-func (r *TenantReconciler) createRestoreJob(tenant *tenantv1alpha1.Tenant, restoreName string) *batchv1.Job {
-	labels := map[string]string{"app": "restore", "tenant": tenant.Name}
+// physicalArtifactExt is the file extension a Mode physical backup's
+// pg_basebackup tarball is written under: compressed unless compression is
+// explicitly "none". Mode logical's extension comes from backup.ArtifactExt
+// instead, since its plain-text output is never compressed.
+func physicalArtifactExt(compression string) string {
+	if compression == "none" {
+		return "tar"
+	}
+	return "tar.gz"
+}
+
+// artifactExtFor is backup.ArtifactExt(mode) with Mode physical additionally
+// honoring compression, since its extension isn't fixed the way logical's is.
+func artifactExtFor(mode, compression string) string {
+	if mode == "physical" {
+		return physicalArtifactExt(compression)
+	}
+	return backup.ArtifactExt(mode)
+}
+
+// buildBackupJob renders the Job that dumps a tenant's database (pg_dump or
+// mysqldump, or pg_basebackup for a postgres tenant in Mode physical) and
+// streams it to spec's storage backend. It is shared by
+// TenantBackupReconciler, which owns the Job and drives TenantBackup.Status
+// from its outcome.
+func buildBackupJob(tenant *tenantv1alpha1.Tenant, backupName string, spec tenantv1alpha1.TenantBackupSpec) *batchv1.Job {
+	labels := map[string]string{"app": "backup", "tenant": tenant.Name}
 	dbType := tenant.Spec.Database.Type
-	command := []string{"pg_restore"}
+	physical := spec.Mode == "physical" && dbType != "mysql"
+
+	localDir := fmt.Sprintf("/backup/%s", backupName)
+	localPath := fmt.Sprintf("%s.%s", localDir, backup.ArtifactExt(spec.Mode))
+	command := []string{"pg_dump"}
 	args := []string{
 		"-h", fmt.Sprintf("%s-db-svc", tenant.Name),
 		"-U", fmt.Sprintf("tenant_%s", tenant.Name),
 		"-d", fmt.Sprintf("tenant_%s_db", tenant.Name),
-		fmt.Sprintf("/backup/%s.sql", restoreName),
+		"--file", localPath,
 	}
-	if dbType == "mysql" {
-		command = []string{"mysql"}
+	// uploadPath is what gets shipped to the storage backend; it differs
+	// from localPath in physical mode because pg_basebackup with -Ft -z
+	// writes its tarball inside the -D directory rather than to a path of
+	// our choosing.
+	uploadPath := localPath
+	switch {
+	case dbType == "mysql":
+		command = []string{"mysqldump"}
 		args = []string{
 			"-h", fmt.Sprintf("%s-db-svc", tenant.Name),
 			"-u", fmt.Sprintf("tenant_%s", tenant.Name),
-			fmt.Sprintf("tenant_%s_db", tenant.Name),
+			"--databases", fmt.Sprintf("tenant_%s_db", tenant.Name),
+			"--result-file", localPath,
 		}
+	case physical:
+		// Physical mode takes a pg_basebackup instead of a pg_dump, pairing
+		// it with the WAL stream the wal-archiver sidecar (see
+		// databaseStatefulSet) ships continuously, so the two can be
+		// replayed forward to any point in time on restore.
+		command = []string{"pg_basebackup"}
+		args = []string{
+			"-h", fmt.Sprintf("%s-db-svc", tenant.Name),
+			"-U", fmt.Sprintf("tenant_%s", tenant.Name),
+			"-D", localDir,
+			"-Ft", "-X", "stream",
+		}
+		if spec.Compression != "none" {
+			args = append(args, "-z")
+		}
+		if spec.Parallelism > 0 {
+			args = append(args, "-j", fmt.Sprintf("%d", spec.Parallelism))
+		}
+		uploadPath = fmt.Sprintf("%s/base.%s", localDir, physicalArtifactExt(spec.Compression))
 	}
+	artifactExt := artifactExtFor(spec.Mode, spec.Compression)
+	backend := backup.Backend(spec.StorageBackend)
+	objectKey := backup.ObjectKey(spec.Prefix, tenant.Name, backupName, artifactExt)
 	return &batchv1.Job{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      fmt.Sprintf("%s-restore-%s", tenant.Name, restoreName),
+			Name:      fmt.Sprintf("%s-backup-%s", tenant.Name, backupName),
 			Namespace: fmt.Sprintf("tenant-%s", tenant.Name),
 			Labels:    labels,
 		},
@@ -386,17 +1125,7 @@ func (r *TenantReconciler) createRestoreJob(tenant *tenantv1alpha1.Tenant, resto
 					RestartPolicy: corev1.RestartPolicyOnFailure,
 					Containers: []corev1.Container{
 						{
-							Name:    "downloader",
-							Image:   "amazon/aws-cli:latest",
-							Command: []string{"aws", "s3", "cp", fmt.Sprintf("s3://multi-saas-crm-backups/%s/%s.sql", tenant.Name, restoreName), fmt.Sprintf("/backup/%s.sql", restoreName)},
-							Env: []corev1.EnvVar{
-								{Name: "AWS_ACCESS_KEY_ID", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "aws-credentials"}, Key: "access-key-id"}}},
-								{Name: "AWS_SECRET_ACCESS_KEY", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "aws-credentials"}, Key: "secret-access-key"}}},
-							},
-							VolumeMounts: []corev1.VolumeMount{{Name: "backup-vol", MountPath: "/backup"}},
-						},
-						{
-							Name:    "restore",
+							Name:    "backup",
 							Image:   fmt.Sprintf("%s:%s", dbType, tenant.Spec.Database.Version),
 							Command: command,
 							Args:    args,
@@ -413,6 +1142,7 @@ func (r *TenantReconciler) createRestoreJob(tenant *tenantv1alpha1.Tenant, resto
 							},
 							VolumeMounts: []corev1.VolumeMount{{Name: "backup-vol", MountPath: "/backup"}},
 						},
+						backup.BuildUploadContainer(backend, spec.Bucket, objectKey, uploadPath, spec.EncryptionKeyRef),
 					},
 					Volumes: []corev1.Volume{
 						{Name: "backup-vol", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}},
@@ -423,40 +1153,168 @@ func (r *TenantReconciler) createRestoreJob(tenant *tenantv1alpha1.Tenant, resto
 	}
 }
 
-func (r *TenantReconciler) reconcileService(ctx context.Context, tenant *tenantv1alpha1.Tenant, svc tenantv1alpha1.ServiceSpec) error {
-	deployment := r.serviceDeployment(tenant, svc)
-	if err := controllerutil.SetControllerReference(tenant, deployment, r.Scheme); err != nil {
-		return err
+// buildRestoreJob renders the Job that restores a tenant's database from a
+// previously uploaded artifact, described by backupSpec. When pointInTime is
+// set, WAL is replayed up to that timestamp after the base artifact loads;
+// backupSpec.WALArchiving must be true for this to have an effect.
+// backupSpec.Mode == "physical" delegates to buildPhysicalRestoreJob, since a
+// pg_basebackup artifact restores very differently from a pg_dump one.
+func buildRestoreJob(tenant *tenantv1alpha1.Tenant, restoreName string, backupSpec tenantv1alpha1.TenantBackupSpec, pointInTime *metav1.Time) *batchv1.Job {
+	if backupSpec.Mode == "physical" {
+		return buildPhysicalRestoreJob(tenant, restoreName, backupSpec, pointInTime)
+	}
+	labels := map[string]string{"app": "restore", "tenant": tenant.Name}
+	dbType := tenant.Spec.Database.Type
+	localPath := fmt.Sprintf("/backup/%s.sql", restoreName)
+	command := []string{"pg_restore"}
+	args := []string{
+		"-h", fmt.Sprintf("%s-db-svc", tenant.Name),
+		"-U", fmt.Sprintf("tenant_%s", tenant.Name),
+		"-d", fmt.Sprintf("tenant_%s_db", tenant.Name),
+		localPath,
+	}
+	if dbType == "mysql" {
+		command = []string{"mysql"}
+		args = []string{
+			"-h", fmt.Sprintf("%s-db-svc", tenant.Name),
+			"-u", fmt.Sprintf("tenant_%s", tenant.Name),
+			fmt.Sprintf("tenant_%s_db", tenant.Name),
+		}
+	}
+	restoreEnv := []corev1.EnvVar{
+		{
+			Name: "PGPASSWORD",
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: fmt.Sprintf("%s-db-credentials", tenant.Name)},
+					Key:                  "password",
+				},
+			},
+		},
+	}
+	if pointInTime != nil && backupSpec.WALArchiving {
+		restoreEnv = append(restoreEnv, corev1.EnvVar{Name: "PITR_TARGET_TIME", Value: pointInTime.Format(time.RFC3339)})
+		args = append(args, "--recovery-target-time", pointInTime.Format(time.RFC3339))
+	}
+	backend := backup.Backend(backupSpec.StorageBackend)
+	objectKey := backup.ObjectKey(backupSpec.Prefix, tenant.Name, restoreName, backup.ArtifactExt(backupSpec.Mode))
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-restore-%s", tenant.Name, restoreName),
+			Namespace: fmt.Sprintf("tenant-%s", tenant.Name),
+			Labels:    labels,
+		},
+		Spec: batchv1.JobSpec{
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec: corev1.PodSpec{
+					RestartPolicy: corev1.RestartPolicyOnFailure,
+					Containers: []corev1.Container{
+						backup.BuildDownloadContainer(backend, backupSpec.Bucket, objectKey, localPath),
+						{
+							Name:         "restore",
+							Image:        fmt.Sprintf("%s:%s", dbType, tenant.Spec.Database.Version),
+							Command:      command,
+							Args:         args,
+							Env:          restoreEnv,
+							VolumeMounts: []corev1.VolumeMount{{Name: "backup-vol", MountPath: "/backup"}},
+						},
+					},
+					Volumes: []corev1.Volume{
+						{Name: "backup-vol", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}},
+					},
+				},
+			},
+		},
+	}
+}
+
+// buildPhysicalRestoreJob renders the restore Job for a backupSpec.Mode ==
+// "physical" backup: it downloads the pg_basebackup tarball via wal-g, and,
+// when pointInTime is set, has wal-g replay WAL from the archive up to that
+// timestamp (via RESTORE_COMMAND/RECOVERY_TARGET_TIME) before promoting.
+func buildPhysicalRestoreJob(tenant *tenantv1alpha1.Tenant, restoreName string, backupSpec tenantv1alpha1.TenantBackupSpec, pointInTime *metav1.Time) *batchv1.Job {
+	labels := map[string]string{"app": "restore", "tenant": tenant.Name}
+	ext := physicalArtifactExt(backupSpec.Compression)
+	localPath := fmt.Sprintf("/backup/%s.%s", restoreName, ext)
+	backend := backup.Backend(backupSpec.StorageBackend)
+	objectKey := backup.ObjectKey(backupSpec.Prefix, tenant.Name, restoreName, ext)
+
+	restoreEnv := []corev1.EnvVar{
+		{Name: "WALG_S3_PREFIX", Value: fmt.Sprintf("s3://%s/%s/%s", backupSpec.Bucket, backupSpec.Prefix, tenant.Name)},
 	}
+	if pointInTime != nil {
+		restoreEnv = append(restoreEnv,
+			corev1.EnvVar{Name: "RESTORE_COMMAND", Value: "wal-g wal-fetch %f %p"},
+			corev1.EnvVar{Name: "RECOVERY_TARGET_TIME", Value: pointInTime.Format(time.RFC3339)},
+		)
+	}
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-restore-%s", tenant.Name, restoreName),
+			Namespace: fmt.Sprintf("tenant-%s", tenant.Name),
+			Labels:    labels,
+		},
+		Spec: batchv1.JobSpec{
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec: corev1.PodSpec{
+					RestartPolicy: corev1.RestartPolicyOnFailure,
+					Containers: []corev1.Container{
+						backup.BuildDownloadContainer(backend, backupSpec.Bucket, objectKey, localPath),
+						{
+							Name:         "restore",
+							Image:        "wal-g/wal-g:latest",
+							Command:      []string{"wal-g", "backup-fetch", "/backup/restored", restoreName},
+							Env:          restoreEnv,
+							VolumeMounts: []corev1.VolumeMount{{Name: "backup-vol", MountPath: "/backup"}},
+						},
+					},
+					Volumes: []corev1.Volume{
+						{Name: "backup-vol", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *TenantReconciler) reconcileService(ctx context.Context, tenant *tenantv1alpha1.Tenant, svc tenantv1alpha1.ServiceSpec, credentialsHash string) error {
+	resolved, err := r.resolveComponent(ctx, svc)
+	if err != nil {
+		return fmt.Errorf("failed to resolve component for service %s: %w", svc.Name, err)
+	}
+	deployment := r.serviceDeployment(tenant, svc, credentialsHash, resolved)
 	found := &appsv1.Deployment{}
-	err := r.Get(ctx, types.NamespacedName{Name: deployment.Name, Namespace: deployment.Namespace}, found)
-	if err != nil && errors.IsNotFound(err) {
+	if err := r.applyWithSpecHash(ctx, tenant, deployment, found); err != nil {
+		return err
+	}
+	if found.GetResourceVersion() == "" {
 		log.FromContext(ctx).Info("Creating service deployment", "Name", deployment.Name)
-		err = r.Create(ctx, deployment)
-		if err != nil {
-			return err
-		}
 		r.EventRecorder.Event(tenant, corev1.EventTypeNormal, "ServiceDeployed",
 			fmt.Sprintf("Service %s deployed", svc.Name))
-	} else if err != nil {
-		return err
-	} else {
-		// Update deployment if spec changed
-		if !deploymentEqual(found, deployment) {
-			found.Spec = deployment.Spec
-			err = r.Update(ctx, found)
-			if err != nil {
-				return err
-			}
-		}
 	}
 
-	// Create service
-	service := r.kubernetesService(tenant, svc)
-	if err := controllerutil.SetControllerReference(tenant, service, r.Scheme); err != nil {
+	// A staged canary runs its TargetVersion in a second Deployment alongside
+	// the stable one above rather than replacing it -- kubernetesService's
+	// selector only matches "app"/"tenant", not "version", so traffic splits
+	// across both Deployments' pods the moment the canary one exists. Once
+	// Canary is cleared (promoted or rolled back), tear that Deployment down.
+	canaryReady := true
+	if svc.Canary != nil {
+		canaryDeployment := r.canaryDeployment(tenant, svc, credentialsHash, resolved)
+		foundCanary := &appsv1.Deployment{}
+		if err := r.applyWithSpecHash(ctx, tenant, canaryDeployment, foundCanary); err != nil {
+			return err
+		}
+		canaryReady = *canaryDeployment.Spec.Replicas == 0 || foundCanary.Status.ReadyReplicas >= *canaryDeployment.Spec.Replicas
+	} else if err := r.deleteCanaryDeployment(ctx, tenant, svc); err != nil {
 		return err
 	}
-	if err := r.Create(ctx, service); err != nil && !errors.IsAlreadyExists(err) {
+
+	// Create/update service
+	service := r.kubernetesService(tenant, svc)
+	if err := r.applyWithSpecHash(ctx, tenant, service, &corev1.Service{}); err != nil {
 		return err
 	}
 
@@ -467,8 +1325,12 @@ func (r *TenantReconciler) reconcileService(ctx context.Context, tenant *tenantv
 		Replicas:    svc.Replicas,
 		LastUpdated: &metav1.Time{Time: time.Now()},
 	}
-	// Check if deployment is ready
-	if found.Status.ReadyReplicas == *found.Spec.Replicas {
+	if resolved != nil {
+		serviceStatus.DefinitionGeneration = resolved.DefinitionGeneration
+		serviceStatus.VersionGeneration = resolved.VersionGeneration
+	}
+	// Check if both the stable deployment and any staged canary are ready
+	if found.Status.ReadyReplicas == *found.Spec.Replicas && canaryReady {
 		serviceStatus.Ready = true
 	}
 	// Update or append service status
@@ -486,24 +1348,151 @@ func (r *TenantReconciler) reconcileService(ctx context.Context, tenant *tenantv
 	return nil
 }
 
+// reconcileIngress delegates to whichever ingress.Provider the tenant (or the
+// operator-wide default) selects, so the object model routing a tenant's
+// Domains (a plain Ingress, Traefik IngressRoute, ApisixRoute, Knative
+// Ingress, ...) is entirely up to that provider.
 func (r *TenantReconciler) reconcileIngress(ctx context.Context, tenant *tenantv1alpha1.Tenant) error {
 	if len(tenant.Spec.Domains) == 0 {
 		return nil
 	}
-	ingress := r.tenantIngress(tenant)
-	if err := controllerutil.SetControllerReference(tenant, ingress, r.Scheme); err != nil {
+	if err := r.validateClientCertificates(ctx, tenant); err != nil {
+		return err
+	}
+	if err := r.validateMiddlewareRefs(ctx, tenant); err != nil {
 		return err
 	}
-	if err := r.Create(ctx, ingress); err != nil && !errors.IsAlreadyExists(err) {
+	if err := validateTLSSpec(tenant.Spec.TLS); err != nil {
+		tenant.Status.TLS.Validated = false
+		r.EventRecorder.Event(tenant, corev1.EventTypeWarning, "InvalidTLSSpec", err.Error())
 		return err
 	}
-	// Update tenant URL
-	if len(tenant.Spec.Domains) > 0 {
-		tenant.Status.URL = fmt.Sprintf("https://%s", tenant.Spec.Domains[0])
+	tenant.Status.TLS.Validated = true
+	provider, err := ingress.ForTenant(tenant, r.DefaultIngressProvider)
+	if err != nil {
+		return fmt.Errorf("failed to resolve ingress provider: %w", err)
+	}
+	objs, err := provider.Build(tenant)
+	if err != nil {
+		return fmt.Errorf("failed to build %s ingress objects: %w", provider.Name(), err)
 	}
+	for _, obj := range objs {
+		if err := r.applyWithSpecHash(ctx, tenant, obj, newEmptyLike(obj)); err != nil {
+			return err
+		}
+	}
+	r.reflectTLSStatus(ctx, tenant)
+	tenant.Status.URL = fmt.Sprintf("https://%s", tenant.Spec.Domains[0])
 	return nil
 }
 
+// validateTLSSpec rejects a TLSSpec that names both a bring-your-own secret
+// and a cert-manager issuer, since it's ambiguous which one should win.
+func validateTLSSpec(tls *tenantv1alpha1.TLSSpec) error {
+	if tls == nil {
+		return nil
+	}
+	if tls.BringYourOwnSecret != "" && tls.IssuerRef != nil {
+		return fmt.Errorf("tls.bringYourOwnSecret and tls.issuerRef are mutually exclusive")
+	}
+	return nil
+}
+
+// validateClientCertificates fetches and sanity-checks the Secret
+// tenant.Spec.ClientCertificates references, emitting a Warning event and
+// returning an error rather than letting reconcileIngress render an Ingress
+// pointing at a missing or malformed mTLS secret.
+func (r *TenantReconciler) validateClientCertificates(ctx context.Context, tenant *tenantv1alpha1.Tenant) error {
+	cc := tenant.Spec.ClientCertificates
+	if cc == nil {
+		return nil
+	}
+	secret := &corev1.Secret{}
+	err := r.Get(ctx, types.NamespacedName{Name: cc.SecretName, Namespace: fmt.Sprintf("tenant-%s", tenant.Name)}, secret)
+	if err != nil {
+		r.EventRecorder.Event(tenant, corev1.EventTypeWarning, "ClientCertSecretMissing",
+			fmt.Sprintf("clientCertificates.secretName %q not found: %v", cc.SecretName, err))
+		return fmt.Errorf("client certificate secret %q not found: %w", cc.SecretName, err)
+	}
+	if len(secret.Data["ca.crt"]) == 0 {
+		r.EventRecorder.Event(tenant, corev1.EventTypeWarning, "ClientCertSecretMalformed",
+			fmt.Sprintf("clientCertificates.secretName %q has no ca.crt entry", cc.SecretName))
+		return fmt.Errorf("client certificate secret %q is missing a ca.crt entry", cc.SecretName)
+	}
+	return nil
+}
+
+// traefikMiddlewareGVK is Traefik's Middleware CRD, used only to validate a
+// Spec.Middlewares ExternalRef before reconcileIngress renders a route
+// pointing at it. Not vendored here for the same reason pkg/ingress reads
+// every third-party ingress controller CRD as unstructured.Unstructured.
+var traefikMiddlewareGVK = schema.GroupVersionKind{Group: "traefik.io", Version: "v1alpha1", Kind: "Middleware"}
+
+// validateMiddlewareRefs fetches and sanity-checks every ExternalRef in
+// tenant.Spec.Middlewares, emitting a Warning event and returning an error
+// rather than letting reconcileIngress render an IngressRoute Traefik will
+// silently drop the middleware from. A cross-namespace ExternalRef is
+// additionally rejected unless its namespace appears in
+// AllowedMiddlewareNamespaces.
+func (r *TenantReconciler) validateMiddlewareRefs(ctx context.Context, tenant *tenantv1alpha1.Tenant) error {
+	ns := fmt.Sprintf("tenant-%s", tenant.Name)
+	for _, m := range tenant.Spec.Middlewares {
+		if m.ExternalRef == nil {
+			continue
+		}
+		refNs := m.ExternalRef.Namespace
+		if refNs == "" {
+			refNs = ns
+		}
+		if refNs != ns && !containsString(r.AllowedMiddlewareNamespaces, refNs) {
+			r.EventRecorder.Event(tenant, corev1.EventTypeWarning, "MiddlewareNamespaceNotAllowed",
+				fmt.Sprintf("middlewares[%s].externalRef namespace %q is not in the allowed-middleware-namespaces list", m.Name, refNs))
+			return fmt.Errorf("middleware %q references disallowed namespace %q", m.Name, refNs)
+		}
+		mw := &unstructured.Unstructured{}
+		mw.SetGroupVersionKind(traefikMiddlewareGVK)
+		if err := r.Get(ctx, types.NamespacedName{Name: m.ExternalRef.Name, Namespace: refNs}, mw); err != nil {
+			r.EventRecorder.Event(tenant, corev1.EventTypeWarning, "MiddlewareNotFound",
+				fmt.Sprintf("middlewares[%s].externalRef %s/%s not found: %v", m.Name, refNs, m.ExternalRef.Name, err))
+			return fmt.Errorf("middleware %q references missing Middleware %s/%s: %w", m.Name, refNs, m.ExternalRef.Name, err)
+		}
+	}
+	return nil
+}
+
+// containsString reports whether list contains s.
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// mapSecretToTenant re-enqueues the Tenant that references secret as its
+// ClientCertificates.SecretName, so updating (or fixing) that Secret
+// triggers a fresh reconcile instead of waiting for the next periodic one.
+func (r *TenantReconciler) mapSecretToTenant(ctx context.Context, obj client.Object) []ctrl.Request {
+	secret, ok := obj.(*corev1.Secret)
+	if !ok {
+		return nil
+	}
+	const nsPrefix = "tenant-"
+	if !strings.HasPrefix(secret.Namespace, nsPrefix) {
+		return nil
+	}
+	tenantName := strings.TrimPrefix(secret.Namespace, nsPrefix)
+	tenant := &tenantv1alpha1.Tenant{}
+	if err := r.Get(ctx, types.NamespacedName{Name: tenantName}, tenant); err != nil {
+		return nil
+	}
+	if tenant.Spec.ClientCertificates == nil || tenant.Spec.ClientCertificates.SecretName != secret.Name {
+		return nil
+	}
+	return []ctrl.Request{{NamespacedName: types.NamespacedName{Name: tenantName}}}
+}
+
 func (r *TenantReconciler) handleDeletion(ctx context.Context, tenant *tenantv1alpha1.Tenant) (ctrl.Result, error) {
 	if controllerutil.ContainsFinalizer(tenant, tenantFinalizer) {
 		// Update status
@@ -518,6 +1507,7 @@ func (r *TenantReconciler) handleDeletion(ctx context.Context, tenant *tenantv1a
 		if err := r.Update(ctx, tenant); err != nil {
 			return ctrl.Result{}, err
 		}
+		r.Scheduler.DeregisterTenant(tenant.Name)
 	}
 	return ctrl.Result{}, nil
 }
@@ -531,13 +1521,147 @@ func (r *TenantReconciler) cleanupTenantResources(ctx context.Context, tenant *t
 	return nil
 }
 
+// walArchiverSidecar renders the WAL-G sidecar that continuously ships the
+// currently-open WAL segment to wal.Bucket/wal.Prefix, making the
+// pg_basebackup artifacts buildBackupJob takes in physical mode eligible for
+// point-in-time restore.
+func walArchiverSidecar(tenant *tenantv1alpha1.Tenant, wal *tenantv1alpha1.WALArchivingSpec) corev1.Container {
+	interval := wal.ArchiveInterval.Duration
+	if interval == 0 {
+		interval = time.Minute
+	}
+	return corev1.Container{
+		Name:  "wal-archiver",
+		Image: "wal-g/wal-g:latest",
+		Command: []string{
+			"wal-g", "wal-push-daemon",
+			"--pgdata", "/var/lib/postgresql/data",
+			"--interval", interval.String(),
+		},
+		Env: []corev1.EnvVar{
+			{Name: "WALG_S3_PREFIX", Value: fmt.Sprintf("s3://%s/%s/%s", wal.Bucket, wal.Prefix, tenant.Name)},
+			{Name: "WALG_RETENTION_DAYS", Value: fmt.Sprintf("%d", wal.RetentionDays)},
+			{
+				Name: "PGUSER",
+				ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: fmt.Sprintf("%s-db-credentials", tenant.Name)},
+						Key:                  "username",
+					},
+				},
+			},
+			{
+				Name: "PGPASSWORD",
+				ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: fmt.Sprintf("%s-db-credentials", tenant.Name)},
+						Key:                  "password",
+					},
+				},
+			},
+		},
+		VolumeMounts: []corev1.VolumeMount{
+			{Name: "postgres-storage", MountPath: "/var/lib/postgresql/data", ReadOnly: true},
+		},
+	}
+}
+
+// mssqlContainer renders the SQL Server container for tenants with
+// Spec.Database.Type == "mssql". SA_PASSWORD is sourced from the same
+// generated -db-credentials secret every other database type uses.
+func mssqlContainer(tenant *tenantv1alpha1.Tenant) corev1.Container {
+	return corev1.Container{
+		Name:  "mssql",
+		Image: fmt.Sprintf("mcr.microsoft.com/mssql/server:%s", tenant.Spec.Database.Version),
+		Ports: []corev1.ContainerPort{
+			{ContainerPort: 1433, Name: "mssql"},
+		},
+		Env: []corev1.EnvVar{
+			{Name: "ACCEPT_EULA", Value: "Y"},
+			{Name: "MSSQL_PID", Value: "Developer"},
+			{
+				Name: "SA_PASSWORD",
+				ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: fmt.Sprintf("%s-db-credentials", tenant.Name)},
+						Key:                  "password",
+					},
+				},
+			},
+		},
+		VolumeMounts: []corev1.VolumeMount{
+			{Name: "postgres-storage", MountPath: "/var/opt/mssql"},
+		},
+	}
+}
+
 // Helper functions for creating Kubernetes resources
-func (r *TenantReconciler) databaseStatefulSet(tenant *tenantv1alpha1.Tenant) *appsv1.StatefulSet {
+func (r *TenantReconciler) databaseStatefulSet(ctx context.Context, tenant *tenantv1alpha1.Tenant, credentialsHash string) *appsv1.StatefulSet {
+	ha := tenant.Spec.Database.HighAvailability
+	haEnabled := ha != nil && tenant.Spec.Database.Type == "postgres"
+
 	replicas := int32(1)
 	labels := map[string]string{
 		"app":    "postgres",
 		"tenant": tenant.Name,
 	}
+	var containers []corev1.Container
+	switch {
+	case haEnabled:
+		replicas = ha.Replicas
+		if replicas < 2 {
+			replicas = 2
+		}
+		containers = []corev1.Container{patroniContainer(tenant, ha)}
+	case tenant.Spec.Database.Type == "mssql":
+		containers = []corev1.Container{mssqlContainer(tenant)}
+	default:
+		containers = []corev1.Container{
+			{
+				Name:  "postgres",
+				Image: fmt.Sprintf("postgres:%s", tenant.Spec.Database.Version),
+				Ports: []corev1.ContainerPort{
+					{ContainerPort: 5432, Name: "postgres"},
+				},
+				Env: []corev1.EnvVar{
+					{
+						Name: "POSTGRES_USER",
+						ValueFrom: &corev1.EnvVarSource{
+							SecretKeyRef: &corev1.SecretKeySelector{
+								LocalObjectReference: corev1.LocalObjectReference{Name: fmt.Sprintf("%s-db-credentials", tenant.Name)},
+								Key:                  "username",
+							},
+						},
+					},
+					{
+						Name: "POSTGRES_PASSWORD",
+						ValueFrom: &corev1.EnvVarSource{
+							SecretKeyRef: &corev1.SecretKeySelector{
+								LocalObjectReference: corev1.LocalObjectReference{Name: fmt.Sprintf("%s-db-credentials", tenant.Name)},
+								Key:                  "password",
+							},
+						},
+					},
+					{
+						Name: "POSTGRES_DB",
+						ValueFrom: &corev1.EnvVarSource{
+							SecretKeyRef: &corev1.SecretKeySelector{
+								LocalObjectReference: corev1.LocalObjectReference{Name: fmt.Sprintf("%s-db-credentials", tenant.Name)},
+								Key:                  "database",
+							},
+						},
+					},
+				},
+				VolumeMounts: []corev1.VolumeMount{
+					{Name: "postgres-storage", MountPath: "/var/lib/postgresql/data"},
+				},
+			},
+		}
+	}
+	if backupCfg := tenant.Spec.Database.Backup; backupCfg.WALArchiving && backupCfg.Mode == "physical" && backupCfg.WAL != nil && tenant.Spec.Database.Type != "mysql" {
+		containers = append(containers, walArchiverSidecar(tenant, backupCfg.WAL))
+	}
+	containers = append(containers, mergedDatabaseSidecars(ctx, tenant)...)
 	return &appsv1.StatefulSet{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      fmt.Sprintf("%s-db", tenant.Name),
@@ -551,50 +1675,12 @@ func (r *TenantReconciler) databaseStatefulSet(tenant *tenantv1alpha1.Tenant) *a
 			ServiceName: fmt.Sprintf("%s-db-svc", tenant.Name),
 			Template: corev1.PodTemplateSpec{
 				ObjectMeta: metav1.ObjectMeta{
-					Labels: labels,
+					Labels:      labels,
+					Annotations: map[string]string{credentialsHashAnnotation: credentialsHash},
 				},
 				Spec: corev1.PodSpec{
-					Containers: []corev1.Container{
-						{
-							Name:  "postgres",
-							Image: fmt.Sprintf("postgres:%s", tenant.Spec.Database.Version),
-							Ports: []corev1.ContainerPort{
-								{ContainerPort: 5432, Name: "postgres"},
-							},
-							Env: []corev1.EnvVar{
-								{
-									Name: "POSTGRES_USER",
-									ValueFrom: &corev1.EnvVarSource{
-										SecretKeyRef: &corev1.SecretKeySelector{
-											LocalObjectReference: corev1.LocalObjectReference{Name: fmt.Sprintf("%s-db-credentials", tenant.Name)},
-											Key:                  "username",
-										},
-									},
-								},
-								{
-									Name: "POSTGRES_PASSWORD",
-									ValueFrom: &corev1.EnvVarSource{
-										SecretKeyRef: &corev1.SecretKeySelector{
-											LocalObjectReference: corev1.LocalObjectReference{Name: fmt.Sprintf("%s-db-credentials", tenant.Name)},
-											Key:                  "password",
-										},
-									},
-								},
-								{
-									Name: "POSTGRES_DB",
-									ValueFrom: &corev1.EnvVarSource{
-										SecretKeyRef: &corev1.SecretKeySelector{
-											LocalObjectReference: corev1.LocalObjectReference{Name: fmt.Sprintf("%s-db-credentials", tenant.Name)},
-											Key:                  "database",
-										},
-									},
-								},
-							},
-							VolumeMounts: []corev1.VolumeMount{
-								{Name: "postgres-storage", MountPath: "/var/lib/postgresql/data"},
-							},
-						},
-					},
+					Containers: containers,
+					Volumes:    tenant.Spec.Database.SidecarVolumes,
 				},
 			},
 			VolumeClaimTemplates: []corev1.PersistentVolumeClaim{
@@ -614,55 +1700,468 @@ func (r *TenantReconciler) databaseStatefulSet(tenant *tenantv1alpha1.Tenant) *a
 	}
 }
 
-func (r *TenantReconciler) serviceDeployment(tenant *tenantv1alpha1.Tenant, svc tenantv1alpha1.ServiceSpec) *appsv1.Deployment {
+// patroniContainer renders the Spilo (Postgres + Patroni) container used for
+// a tenant's database StatefulSet when HighAvailability is enabled. Patroni
+// itself manages leader election and replica streaming against the shared
+// etcd DCS; Kubernetes only needs to keep the pods running and label them
+// via patroniConfigMap/reflectHAStatus.
+func patroniContainer(tenant *tenantv1alpha1.Tenant, ha *tenantv1alpha1.HighAvailabilitySpec) corev1.Container {
+	synchronousMode := "false"
+	if ha.SynchronousReplication {
+		synchronousMode = "true"
+	}
+	return corev1.Container{
+		Name:  "postgres",
+		Image: fmt.Sprintf("%s:%s", spiloImage, tenant.Spec.Database.Version),
+		Ports: []corev1.ContainerPort{
+			{ContainerPort: 5432, Name: "postgres"},
+			{ContainerPort: 8008, Name: "patroni-api"},
+		},
+		Env: []corev1.EnvVar{
+			{Name: "SCOPE", Value: fmt.Sprintf("%s-db", tenant.Name)},
+			{Name: "PATRONI_KUBERNETES_NAMESPACE", Value: fmt.Sprintf("tenant-%s", tenant.Name)},
+			{Name: "PATRONI_KUBERNETES_LABELS", Value: fmt.Sprintf(`{app: postgres, tenant: %s}`, tenant.Name)},
+			{Name: "PATRONI_ETCD3_HOSTS", Value: patroniEtcdEndpoints()},
+			{Name: "PATRONI_SYNCHRONOUS_MODE", Value: synchronousMode},
+			{Name: "PATRONI_NAME", ValueFrom: &corev1.EnvVarSource{FieldRef: &corev1.ObjectFieldSelector{FieldPath: "metadata.name"}}},
+			{
+				Name: "PATRONI_SUPERUSER_USERNAME",
+				ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: fmt.Sprintf("%s-db-credentials", tenant.Name)},
+						Key:                  "username",
+					},
+				},
+			},
+			{
+				Name: "PATRONI_SUPERUSER_PASSWORD",
+				ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: fmt.Sprintf("%s-db-credentials", tenant.Name)},
+						Key:                  "password",
+					},
+				},
+			},
+			{
+				Name: "POSTGRES_DB",
+				ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: fmt.Sprintf("%s-db-credentials", tenant.Name)},
+						Key:                  "database",
+					},
+				},
+			},
+		},
+		VolumeMounts: []corev1.VolumeMount{
+			{Name: "postgres-storage", MountPath: "/home/postgres/pgdata"},
+		},
+	}
+}
+
+// patroniConfigMap renders the ConfigMap Patroni reads for cluster-wide
+// bootstrap settings (failover mode, minimum synchronous replicas) that apply the
+// same way to every pod in the StatefulSet, as opposed to the per-pod
+// PATRONI_* env vars set on patroniContainer.
+func (r *TenantReconciler) patroniConfigMap(tenant *tenantv1alpha1.Tenant) *corev1.ConfigMap {
+	ha := tenant.Spec.Database.HighAvailability
+	failoverMode := ha.FailoverMode
+	if failoverMode == "" {
+		failoverMode = "automatic"
+	}
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-patroni-config", tenant.Name),
+			Namespace: fmt.Sprintf("tenant-%s", tenant.Name),
+		},
+		Data: map[string]string{
+			"scope":             fmt.Sprintf("%s-db", tenant.Name),
+			"failover-mode":     failoverMode,
+			"min-sync-replicas": fmt.Sprintf("%d", ha.MinSyncReplicas),
+		},
+	}
+}
+
+// reconcilePatroniConfigMap ensures the Patroni ConfigMap exists. It is not
+// updated in place once created: changing failoverMode or minSyncReplicas
+// on a live HA cluster is an operator-driven Patroni
+// switchover, not something the reconciler should do automatically.
+func (r *TenantReconciler) reconcilePatroniConfigMap(ctx context.Context, tenant *tenantv1alpha1.Tenant) error {
+	cm := r.patroniConfigMap(tenant)
+	if err := controllerutil.SetControllerReference(tenant, cm, r.Scheme); err != nil {
+		return err
+	}
+	if err := r.Create(ctx, cm); err != nil && !errors.IsAlreadyExists(err) {
+		return err
+	}
+	return nil
+}
+
+// haServices renders the three Services a Patroni cluster needs: a headless
+// governing Service for the StatefulSet's own pod DNS, and two role-selector
+// Services so clients can target the current primary for writes or any
+// replica for read-only traffic without tracking Patroni's leader election
+// themselves.
+func (r *TenantReconciler) haServices(tenant *tenantv1alpha1.Tenant) []*corev1.Service {
+	ns := fmt.Sprintf("tenant-%s", tenant.Name)
+	baseLabels := map[string]string{"app": "postgres", "tenant": tenant.Name}
+	return []*corev1.Service{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("%s-db-svc", tenant.Name), Namespace: ns},
+			Spec: corev1.ServiceSpec{
+				ClusterIP: corev1.ClusterIPNone,
+				Selector:  baseLabels,
+				Ports:     []corev1.ServicePort{{Port: 5432, Name: "postgres"}},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("%s-db-master", tenant.Name), Namespace: ns},
+			Spec: corev1.ServiceSpec{
+				Selector: mergeLabels(baseLabels, map[string]string{"spilo-role": "master"}),
+				Ports:    []corev1.ServicePort{{Port: 5432, Name: "postgres"}},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("%s-db-replica", tenant.Name), Namespace: ns},
+			Spec: corev1.ServiceSpec{
+				Selector: mergeLabels(baseLabels, map[string]string{"spilo-role": "replica"}),
+				Ports:    []corev1.ServicePort{{Port: 5432, Name: "postgres"}},
+			},
+		},
+	}
+}
+
+func mergeLabels(base, extra map[string]string) map[string]string {
+	merged := make(map[string]string, len(base)+len(extra))
+	for k, v := range base {
+		merged[k] = v
+	}
+	for k, v := range extra {
+		merged[k] = v
+	}
+	return merged
+}
+
+// reconcileHAServices ensures the governing and role-selector Services
+// exist. Selectors alone drive which pods each Service routes to, so
+// there's nothing to reconcile beyond initial creation.
+func (r *TenantReconciler) reconcileHAServices(ctx context.Context, tenant *tenantv1alpha1.Tenant) error {
+	for _, svc := range r.haServices(tenant) {
+		if err := controllerutil.SetControllerReference(tenant, svc, r.Scheme); err != nil {
+			return err
+		}
+		if err := r.Create(ctx, svc); err != nil && !errors.IsAlreadyExists(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// reflectHAStatus lists the tenant's database pods and records which one
+// Patroni currently holds the leader lock for (labeled spilo-role=master by
+// Patroni's Kubernetes integration) and which are streaming replicas, so
+// `tenant get` can show the current topology. Best-effort: a failure here
+// shouldn't fail the rest of the reconcile.
+func (r *TenantReconciler) reflectHAStatus(ctx context.Context, tenant *tenantv1alpha1.Tenant) {
+	podList := &corev1.PodList{}
+	err := r.List(ctx, podList,
+		client.InNamespace(fmt.Sprintf("tenant-%s", tenant.Name)),
+		client.MatchingLabels{"app": "postgres", "tenant": tenant.Name},
+	)
+	if err != nil {
+		log.FromContext(ctx).Error(err, "failed to list database pods for HA status")
+		return
+	}
+	var replicaPods []string
+	tenant.Status.DatabaseStatus.PrimaryPod = ""
+	for _, pod := range podList.Items {
+		switch pod.Labels["spilo-role"] {
+		case "master":
+			tenant.Status.DatabaseStatus.PrimaryPod = pod.Name
+		case "replica":
+			replicaPods = append(replicaPods, pod.Name)
+		}
+	}
+	tenant.Status.DatabaseStatus.ReplicaPods = replicaPods
+}
+
+// certificateGVK is cert-manager's Certificate CRD. Not vendored here (this
+// operator otherwise has no dependency on cert-manager's generated client),
+// so it's read as unstructured.Unstructured the same way pkg/ingress reads
+// third-party ingress controller CRDs.
+var certificateGVK = schema.GroupVersionKind{Group: "cert-manager.io", Version: "v1", Kind: "Certificate"}
+
+// reflectTLSStatus mirrors the applied TLS configuration, and the expiry of
+// the cert-manager Certificate it produced (if any), onto tenant.Status.TLS.
+// Best-effort: a missing or not-yet-issued Certificate just leaves
+// CertificateExpiry unset rather than failing the reconcile.
+func (r *TenantReconciler) reflectTLSStatus(ctx context.Context, tenant *tenantv1alpha1.Tenant) {
+	tls := tenant.Spec.TLS
+	tenant.Status.TLS.SecretName = fmt.Sprintf("%s-tls", tenant.Name)
+	tenant.Status.TLS.IssuerRef = nil
+	if tls != nil {
+		tenant.Status.TLS.IssuerRef = tls.IssuerRef
+		if tls.BringYourOwnSecret != "" {
+			tenant.Status.TLS.SecretName = tls.BringYourOwnSecret
+			// cert-manager never issues a Certificate for a BYO secret.
+			return
+		}
+	}
+	cert := &unstructured.Unstructured{}
+	cert.SetGroupVersionKind(certificateGVK)
+	err := r.Get(ctx, types.NamespacedName{
+		Name:      tenant.Status.TLS.SecretName,
+		Namespace: fmt.Sprintf("tenant-%s", tenant.Name),
+	}, cert)
+	if err != nil {
+		log.FromContext(ctx).Error(err, "failed to read cert-manager Certificate for TLS status")
+		return
+	}
+	notAfter, found, err := unstructured.NestedString(cert.Object, "status", "notAfter")
+	if err != nil || !found {
+		return
+	}
+	expiry, err := time.Parse(time.RFC3339, notAfter)
+	if err != nil {
+		log.FromContext(ctx).Error(err, "failed to parse Certificate status.notAfter")
+		return
+	}
+	tenant.Status.TLS.CertificateExpiry = &metav1.Time{Time: expiry}
+}
+
+func (r *TenantReconciler) serviceDeployment(tenant *tenantv1alpha1.Tenant, svc tenantv1alpha1.ServiceSpec, credentialsHash string, resolved *resolvedComponent) *appsv1.Deployment {
+	name := fmt.Sprintf("%s-%s", tenant.Name, svc.Name)
+	return r.buildServiceDeployment(tenant, svc, name, svc.Version, stableReplicas(svc), credentialsHash, resolved)
+}
+
+// canaryDeployment builds the shadow Deployment a staged canary rollout runs
+// svc.Canary.TargetVersion on, sized to canaryReplicas(svc) and named
+// distinctly from the stable Deployment serviceDeployment renders, so both
+// can coexist: kubernetesService's Selector only matches "app"/"tenant", not
+// "version", so the existing Service splits traffic across both
+// Deployments' pods without any change to the Service itself.
+func (r *TenantReconciler) canaryDeployment(tenant *tenantv1alpha1.Tenant, svc tenantv1alpha1.ServiceSpec, credentialsHash string, resolved *resolvedComponent) *appsv1.Deployment {
+	name := fmt.Sprintf("%s-%s-canary", tenant.Name, svc.Name)
+	replicas := canaryReplicas(svc)
+	return r.buildServiceDeployment(tenant, svc, name, svc.Canary.TargetVersion, replicas, credentialsHash, resolved)
+}
+
+// canaryReplicas computes how many TargetVersion replicas a staged canary
+// runs for the current Canary.Weight, rounding up so a low weight still
+// stages at least one replica once promotion has actually begun (Weight is
+// only ever 0 in the gap between staging and the first promotion step).
+func canaryReplicas(svc tenantv1alpha1.ServiceSpec) int32 {
+	if svc.Canary == nil || svc.Canary.Weight <= 0 {
+		return 0
+	}
+	n := int32(math.Ceil(float64(svc.Replicas) * float64(svc.Canary.Weight) / 100))
+	if n < 1 {
+		n = 1
+	}
+	return n
+}
+
+// stableReplicas returns how many of svc.Replicas the stable Deployment
+// keeps while a canary is staged. CanarySpec.Weight is documented as the
+// percentage of replicas running TargetVersion, so the stable Deployment
+// sheds exactly the replicas canaryReplicas adds -- total capacity across
+// both Deployments stays at svc.Replicas for the whole rollout instead of
+// doubling it, and Weight reaching 100 means the stable Deployment is
+// actually down to zero just before finalize cuts over.
+func stableReplicas(svc tenantv1alpha1.ServiceSpec) int32 {
+	if svc.Canary == nil {
+		return svc.Replicas
+	}
+	n := svc.Replicas - canaryReplicas(svc)
+	if n < 0 {
+		n = 0
+	}
+	return n
+}
+
+// deleteCanaryDeployment removes the shadow Deployment canaryDeployment
+// would have created, once a staged canary rollout for svc finishes or is
+// rolled back -- both clear svc.Canary. Most services never run a canary,
+// so a NotFound here is the common case, not an error.
+func (r *TenantReconciler) deleteCanaryDeployment(ctx context.Context, tenant *tenantv1alpha1.Tenant, svc tenantv1alpha1.ServiceSpec) error {
+	canary := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-%s-canary", tenant.Name, svc.Name),
+			Namespace: fmt.Sprintf("tenant-%s", tenant.Name),
+		},
+	}
+	if err := r.Delete(ctx, canary); err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// buildServiceDeployment renders svc's Deployment running version at
+// replicas, named name -- serviceDeployment and canaryDeployment are the two
+// callers, rendering the stable and shadow canary Deployments respectively
+// off the same pod spec.
+func (r *TenantReconciler) buildServiceDeployment(tenant *tenantv1alpha1.Tenant, svc tenantv1alpha1.ServiceSpec, name, version string, replicas int32, credentialsHash string, resolved *resolvedComponent) *appsv1.Deployment {
 	labels := map[string]string{
 		"app":     svc.Name,
 		"tenant":  tenant.Name,
-		"version": svc.Version,
+		"version": version,
+	}
+	image := fmt.Sprintf("rezenkai/%s:%s", svc.Name, version)
+	ports := []corev1.ContainerPort{{ContainerPort: 8080, Name: "http"}}
+	env := svc.Env
+	var livenessProbe, readinessProbe *corev1.Probe
+	var volumes []corev1.Volume
+	var volumeMounts []corev1.VolumeMount
+	if resolved != nil {
+		// resolved.Image was resolved from svc.VersionRef, which describes
+		// svc.Version, not a canary's TargetVersion -- blindly using it here
+		// would run the stable image under the canary's name and label, so
+		// canaryDeployment's call would never actually exercise the new
+		// version. The rest of the pod shape (ports/env/probes/volumes) the
+		// component describes still applies to both, since a canary is
+		// meant to validate TargetVersion under the same shape as stable.
+		if version == svc.Version {
+			image = resolved.Image
+		}
+		if len(resolved.Ports) > 0 {
+			ports = resolved.Ports
+		}
+		env = append(append([]corev1.EnvVar{}, resolved.Env...), svc.Env...)
+		livenessProbe = resolved.LivenessProbe
+		readinessProbe = resolved.ReadinessProbe
+		volumes = resolved.Volumes
+		volumeMounts = resolved.VolumeMounts
 	}
+	containers := append([]corev1.Container{
+		{
+			Name:  svc.Name,
+			Image: image,
+			Ports: ports,
+			Env: append(env,
+				corev1.EnvVar{Name: "TENANT_ID", Value: tenant.Name},
+				corev1.EnvVar{
+					Name:  "DB_HOST",
+					Value: fmt.Sprintf("%s-db-svc.tenant-%s.svc.cluster.local", tenant.Name, tenant.Name),
+				},
+			),
+			LivenessProbe:  livenessProbe,
+			ReadinessProbe: readinessProbe,
+			VolumeMounts:   volumeMounts,
+			Resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse(tenant.Spec.Resources.CPU.Request),
+					corev1.ResourceMemory: resource.MustParse(tenant.Spec.Resources.Memory.Request),
+				},
+				Limits: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse(tenant.Spec.Resources.CPU.Limit),
+					corev1.ResourceMemory: resource.MustParse(tenant.Spec.Resources.Memory.Limit),
+				},
+			},
+		},
+	}, svc.Sidecars...)
 	return &appsv1.Deployment{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      fmt.Sprintf("%s-%s", tenant.Name, svc.Name),
+			Name:      name,
 			Namespace: fmt.Sprintf("tenant-%s", tenant.Name),
 		},
 		Spec: appsv1.DeploymentSpec{
-			Replicas: &svc.Replicas,
+			Replicas: &replicas,
 			Selector: &metav1.LabelSelector{
 				MatchLabels: labels,
 			},
 			Template: corev1.PodTemplateSpec{
-				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				ObjectMeta: metav1.ObjectMeta{
+					Labels:      labels,
+					Annotations: map[string]string{credentialsHashAnnotation: credentialsHash},
+				},
 				Spec: corev1.PodSpec{
-					Containers: []corev1.Container{
-						{
-							Name:  svc.Name,
-							Image: fmt.Sprintf("rezenkai/%s:%s", svc.Name, svc.Version),
-							Ports: []corev1.ContainerPort{{ContainerPort: 8080, Name: "http"}},
-							Env: append(svc.Env,
-								corev1.EnvVar{Name: "TENANT_ID", Value: tenant.Name},
-								corev1.EnvVar{
-									Name:  "DB_HOST",
-									Value: fmt.Sprintf("%s-db-svc.tenant-%s.svc.cluster.local", tenant.Name, tenant.Name),
-								},
-							),
-							Resources: corev1.ResourceRequirements{
-								Requests: corev1.ResourceList{
-									corev1.ResourceCPU:    resource.MustParse(tenant.Spec.Resources.CPU.Request),
-									corev1.ResourceMemory: resource.MustParse(tenant.Spec.Resources.Memory.Request),
-								},
-								Limits: corev1.ResourceList{
-									corev1.ResourceCPU:    resource.MustParse(tenant.Spec.Resources.CPU.Limit),
-									corev1.ResourceMemory: resource.MustParse(tenant.Spec.Resources.Memory.Limit),
-								},
-							},
-						},
-					},
+					Containers: containers,
+					Volumes:    append(svc.SidecarVolumes, volumes...),
 				},
 			},
 		},
 	}
 }
 
+// resolvedComponent is the effective container definition serviceDeployment
+// renders when a ServiceSpec sets ComponentRef/VersionRef: component shape
+// (env/ports/probes/volumes) from a TenantDefinition merged with the image
+// from a TenantVersion, plus the generations reconcileService stamps onto
+// ServiceStatus so a fleet-wide TenantVersion edit is observably rolled out.
+type resolvedComponent struct {
+	Image                string
+	Env                  []corev1.EnvVar
+	Ports                []corev1.ContainerPort
+	LivenessProbe        *corev1.Probe
+	ReadinessProbe       *corev1.Probe
+	Volumes              []corev1.Volume
+	VolumeMounts         []corev1.VolumeMount
+	DefinitionGeneration int64
+	VersionGeneration    int64
+}
+
+// resolveComponent looks up svc's ComponentRef/VersionRef and merges them
+// into a resolvedComponent, or returns nil if svc doesn't reference either
+// (the service renders entirely from its own fields, as before TenantDefinition/
+// TenantVersion existed). svc.Overrides.Env, if set, is appended last so it
+// can add to but not remove a TenantDefinition component's own Env.
+func (r *TenantReconciler) resolveComponent(ctx context.Context, svc tenantv1alpha1.ServiceSpec) (*resolvedComponent, error) {
+	if svc.ComponentRef == "" && svc.VersionRef == "" {
+		return nil, nil
+	}
+	if svc.ComponentRef == "" || svc.VersionRef == "" {
+		return nil, fmt.Errorf("service %q must set both componentRef and versionRef, or neither", svc.Name)
+	}
+
+	def := &tenantv1alpha1.TenantDefinition{}
+	if err := r.Get(ctx, types.NamespacedName{Name: svc.ComponentRef}, def); err != nil {
+		return nil, fmt.Errorf("looking up TenantDefinition %q: %w", svc.ComponentRef, err)
+	}
+	ver := &tenantv1alpha1.TenantVersion{}
+	if err := r.Get(ctx, types.NamespacedName{Name: svc.VersionRef}, ver); err != nil {
+		return nil, fmt.Errorf("looking up TenantVersion %q: %w", svc.VersionRef, err)
+	}
+	if ver.Spec.DefinitionRef != svc.ComponentRef {
+		return nil, fmt.Errorf("versionRef %q targets definitionRef %q, not componentRef %q", svc.VersionRef, ver.Spec.DefinitionRef, svc.ComponentRef)
+	}
+
+	var component *tenantv1alpha1.ComponentDefinition
+	for i := range def.Spec.Components {
+		if def.Spec.Components[i].Name == svc.Name {
+			component = &def.Spec.Components[i]
+			break
+		}
+	}
+	if component == nil {
+		return nil, fmt.Errorf("TenantDefinition %q has no component named %q", svc.ComponentRef, svc.Name)
+	}
+	var image string
+	for _, cv := range ver.Spec.Components {
+		if cv.Name == svc.Name {
+			image = cv.Image
+			break
+		}
+	}
+	if image == "" {
+		return nil, fmt.Errorf("TenantVersion %q has no image for component %q", svc.VersionRef, svc.Name)
+	}
+
+	resolved := &resolvedComponent{
+		Image:                image,
+		Env:                  component.Env,
+		Ports:                component.Ports,
+		LivenessProbe:        component.LivenessProbe,
+		ReadinessProbe:       component.ReadinessProbe,
+		Volumes:              component.Volumes,
+		VolumeMounts:         component.VolumeMounts,
+		DefinitionGeneration: def.Generation,
+		VersionGeneration:    ver.Generation,
+	}
+	if svc.Overrides != nil {
+		resolved.Env = append(append([]corev1.EnvVar{}, resolved.Env...), svc.Overrides.Env...)
+	}
+	return resolved, nil
+}
+
 func (r *TenantReconciler) kubernetesService(tenant *tenantv1alpha1.Tenant, svc tenantv1alpha1.ServiceSpec) *corev1.Service {
 	labels := map[string]string{
 		"app":    svc.Name,
@@ -689,60 +2188,6 @@ func (r *TenantReconciler) kubernetesService(tenant *tenantv1alpha1.Tenant, svc
 	}
 }
 
-func (r *TenantReconciler) tenantIngress(tenant *tenantv1alpha1.Tenant) *networkingv1.Ingress {
-	pathType := networkingv1.PathTypePrefix
-	var rules []networkingv1.IngressRule
-	for _, domain := range tenant.Spec.Domains {
-		rules = append(rules, networkingv1.IngressRule{
-			Host: domain,
-			IngressRuleValue: networkingv1.IngressRuleValue{
-				HTTP: &networkingv1.HTTPIngressRuleValue{
-					Paths: []networkingv1.HTTPIngressPath{
-						{
-							Path:     "/",
-							PathType: &pathType,
-							Backend: networkingv1.IngressBackend{
-								Service: &networkingv1.IngressServiceBackend{
-									Name: fmt.Sprintf("%s-gateway-svc", tenant.Name),
-									Port: networkingv1.ServiceBackendPort{Number: 80},
-								},
-							},
-						},
-					},
-				},
-			},
-		})
-	}
-	return &networkingv1.Ingress{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      fmt.Sprintf("%s-ingress", tenant.Name),
-			Namespace: fmt.Sprintf("tenant-%s", tenant.Name),
-			Annotations: map[string]string{
-				"kubernetes.io/ingress.class":               "nginx",
-				"cert-manager.io/cluster-issuer":            "letsencrypt-prod",
-				"nginx.ingress.kubernetes.io/ssl-redirect":  "true",
-				"nginx.ingress.kubernetes.io/proxy-body-size": "100m",
-			},
-		},
-		Spec: networkingv1.IngressSpec{
-			TLS: []networkingv1.IngressTLS{
-				{Hosts: tenant.Spec.Domains, SecretName: fmt.Sprintf("%s-tls", tenant.Name)},
-			},
-			Rules: rules,
-		},
-	}
-}
-
-func deploymentEqual(a, b *appsv1.Deployment) bool {
-	return a.Spec.Template.Spec.Containers[0].Image == b.Spec.Template.Spec.Containers[0].Image &&
-		*a.Spec.Replicas == *b.Spec.Replicas
-}
-
-func generatePassword() string {
-	// TODO: Use crypto/rand for production
-	return "SecurePassword123!"
-}
-
 func (r *TenantReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &appsv1.Deployment{}, ownerKey, func(rawObj client.Object) []string {
 		deployment := rawObj.(*appsv1.Deployment)
@@ -754,13 +2199,20 @@ func (r *TenantReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	}); err != nil {
 		return err
 	}
-	return ctrl.NewControllerManagedBy(mgr).
+	bldr := ctrl.NewControllerManagedBy(mgr).
 		For(&tenantv1alpha1.Tenant{}).
 		Owns(&appsv1.Deployment{}).
 		Owns(&appsv1.StatefulSet{}).
 		Owns(&corev1.Service{}).
-		Owns(&networkingv1.Ingress{}).
 		Owns(&batchv1.Job{}).
+		Watches(&corev1.Secret{}, handler.EnqueueRequestsFromMapFunc(r.mapSecretToTenant))
+	// Register watches for every ingress provider's object kinds up front,
+	// since a tenant's choice of provider (Spec.Ingress.Provider) isn't
+	// known until reconcile time.
+	for _, p := range ingress.All() {
+		bldr = p.Owns(bldr)
+	}
+	return bldr.
 		WithEventFilter(predicate.GenerationChangedPredicate{}).
 		Complete(r)
 }
\ No newline at end of file
@@ -0,0 +1,220 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	tenantv1alpha1 "github.com/rezenkai/multi-saas-crm/tenant-orchestrator/api/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// int32Ptr returns a pointer to v, for building test fixtures that need a
+// *int32 field (Replicas, ...) inline.
+func int32Ptr(v int32) *int32 { return &v }
+
+// newTestReconciler builds a TenantReconciler backed by a fake client seeded
+// with tenant and any existing objects, for exercising applyWithSpecHash
+// without a real API server.
+func newTestReconciler(t *testing.T, tenant *tenantv1alpha1.Tenant, existing ...client.Object) *TenantReconciler {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add client-go types to scheme: %v", err)
+	}
+	if err := tenantv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add tenant types to scheme: %v", err)
+	}
+	objs := append([]client.Object{tenant}, existing...)
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+	return &TenantReconciler{Client: fakeClient, Scheme: scheme}
+}
+
+func testTenant(name, namespace string) *tenantv1alpha1.Tenant {
+	return &tenantv1alpha1.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, UID: "test-uid"},
+	}
+}
+
+func TestApplyWithSpecHashCreatesWhenAbsent(t *testing.T) {
+	tenant := testTenant("acme", "tenant-acme")
+	r := newTestReconciler(t, tenant)
+
+	desired := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "tenant-acme"},
+		Spec:       appsv1.DeploymentSpec{Replicas: int32Ptr(2)},
+	}
+	found := &appsv1.Deployment{}
+	if err := r.applyWithSpecHash(context.Background(), tenant, desired, found); err != nil {
+		t.Fatalf("applyWithSpecHash: %v", err)
+	}
+
+	got := &appsv1.Deployment{}
+	if err := r.Get(context.Background(), client.ObjectKeyFromObject(desired), got); err != nil {
+		t.Fatalf("expected deployment to be created: %v", err)
+	}
+	if got.GetAnnotations()[specHashAnnotation] == "" {
+		t.Error("expected created object to carry a specHash annotation")
+	}
+}
+
+func TestApplyWithSpecHashNoopWhenUnchanged(t *testing.T) {
+	tenant := testTenant("acme", "tenant-acme")
+	desired := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "tenant-acme"},
+		Spec:       appsv1.DeploymentSpec{Replicas: int32Ptr(2)},
+	}
+	hash, err := specHash(desired)
+	if err != nil {
+		t.Fatalf("specHash: %v", err)
+	}
+	live := desired.DeepCopy()
+	live.ResourceVersion = "1"
+	live.Annotations = map[string]string{specHashAnnotation: hash}
+	r := newTestReconciler(t, tenant, live)
+
+	found := &appsv1.Deployment{}
+	if err := r.applyWithSpecHash(context.Background(), tenant, desired.DeepCopy(), found); err != nil {
+		t.Fatalf("applyWithSpecHash: %v", err)
+	}
+
+	got := &appsv1.Deployment{}
+	if err := r.Get(context.Background(), client.ObjectKeyFromObject(desired), got); err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if got.ResourceVersion != "1" {
+		t.Errorf("expected no update when specHash is unchanged, got ResourceVersion %q", got.ResourceVersion)
+	}
+}
+
+// TestApplyWithSpecHashStatefulSetPreservesImmutableFields is a regression
+// test for updateWithSpecHash: a drifted StatefulSet must only have its
+// mutable fields (Replicas/Template/...) updated, never Selector or
+// ServiceName, which the API server rejects changes to.
+func TestApplyWithSpecHashStatefulSetPreservesImmutableFields(t *testing.T) {
+	tenant := testTenant("acme", "tenant-acme")
+	selector := &metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}}
+	live := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "db",
+			Namespace:       "tenant-acme",
+			Annotations:     map[string]string{specHashAnnotation: "stale"},
+			ResourceVersion: "5",
+		},
+		Spec: appsv1.StatefulSetSpec{
+			Replicas:    int32Ptr(1),
+			Selector:    selector,
+			ServiceName: "db",
+		},
+	}
+	r := newTestReconciler(t, tenant, live)
+
+	desired := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "tenant-acme"},
+		Spec: appsv1.StatefulSetSpec{
+			Replicas:    int32Ptr(3),
+			Selector:    &metav1.LabelSelector{MatchLabels: map[string]string{"app": "different"}},
+			ServiceName: "different",
+		},
+	}
+	found := &appsv1.StatefulSet{}
+	if err := r.applyWithSpecHash(context.Background(), tenant, desired, found); err != nil {
+		t.Fatalf("applyWithSpecHash: %v", err)
+	}
+
+	got := &appsv1.StatefulSet{}
+	if err := r.Get(context.Background(), client.ObjectKeyFromObject(live), got); err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if *got.Spec.Replicas != 3 {
+		t.Errorf("expected Replicas to be updated to 3, got %d", *got.Spec.Replicas)
+	}
+	if got.Spec.ServiceName != "db" {
+		t.Errorf("expected immutable ServiceName to be preserved, got %q", got.Spec.ServiceName)
+	}
+	if got.Spec.Selector.MatchLabels["app"] != "db" {
+		t.Errorf("expected immutable Selector to be preserved, got %v", got.Spec.Selector.MatchLabels)
+	}
+}
+
+// TestApplyWithSpecHashServicePreservesClusterIP is a regression test for
+// updateWithSpecHash: a drifted Service must keep its server-assigned
+// ClusterIP rather than having it cleared by a full-object Update.
+func TestApplyWithSpecHashServicePreservesClusterIP(t *testing.T) {
+	tenant := testTenant("acme", "tenant-acme")
+	live := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "app",
+			Namespace:       "tenant-acme",
+			Annotations:     map[string]string{specHashAnnotation: "stale"},
+			ResourceVersion: "9",
+		},
+		Spec: corev1.ServiceSpec{
+			ClusterIP:  "10.0.0.5",
+			ClusterIPs: []string{"10.0.0.5"},
+			Ports:      []corev1.ServicePort{{Port: 80}},
+		},
+	}
+	r := newTestReconciler(t, tenant, live)
+
+	desired := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "tenant-acme"},
+		Spec:       corev1.ServiceSpec{Ports: []corev1.ServicePort{{Port: 8080}}},
+	}
+	found := &corev1.Service{}
+	if err := r.applyWithSpecHash(context.Background(), tenant, desired, found); err != nil {
+		t.Fatalf("applyWithSpecHash: %v", err)
+	}
+
+	got := &corev1.Service{}
+	if err := r.Get(context.Background(), client.ObjectKeyFromObject(live), got); err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if got.Spec.ClusterIP != "10.0.0.5" {
+		t.Errorf("expected ClusterIP to be preserved, got %q", got.Spec.ClusterIP)
+	}
+	if got.Spec.Ports[0].Port != 8080 {
+		t.Errorf("expected Ports to be updated to 8080, got %d", got.Spec.Ports[0].Port)
+	}
+}
+
+// TestApplyWithSpecHashIngressUpdatesOnDrift covers the generic,
+// non-type-switched path updateWithSpecHash falls through to for object
+// kinds that have no immutable-field special case, such as Ingress.
+func TestApplyWithSpecHashIngressUpdatesOnDrift(t *testing.T) {
+	tenant := testTenant("acme", "tenant-acme")
+	live := &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "app",
+			Namespace:       "tenant-acme",
+			Annotations:     map[string]string{specHashAnnotation: "stale"},
+			ResourceVersion: "2",
+		},
+		Spec: networkingv1.IngressSpec{},
+	}
+	r := newTestReconciler(t, tenant, live)
+
+	className := "nginx"
+	desired := &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "tenant-acme"},
+		Spec:       networkingv1.IngressSpec{IngressClassName: &className},
+	}
+	found := &networkingv1.Ingress{}
+	if err := r.applyWithSpecHash(context.Background(), tenant, desired, found); err != nil {
+		t.Fatalf("applyWithSpecHash: %v", err)
+	}
+
+	got := &networkingv1.Ingress{}
+	if err := r.Get(context.Background(), client.ObjectKeyFromObject(live), got); err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if got.Spec.IngressClassName == nil || *got.Spec.IngressClassName != "nginx" {
+		t.Errorf("expected IngressClassName to be updated to nginx, got %v", got.Spec.IngressClassName)
+	}
+}
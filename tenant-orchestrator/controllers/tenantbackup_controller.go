@@ -0,0 +1,397 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	tenantv1alpha1 "github.com/rezenkai/multi-saas-crm/tenant-orchestrator/api/v1alpha1"
+	"github.com/rezenkai/multi-saas-crm/tenant-orchestrator/pkg/backup"
+	"github.com/rezenkai/multi-saas-crm/tenant-orchestrator/pkg/blueprint"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const tenantBackupFinalizer = "tenant.rezenkai.com/backup-finalizer"
+
+// defaultMaxTenantBackupHistory is how many BackupRecords
+// Tenant.Status.DatabaseStatus.Backups keeps when MAX_TENANT_BACKUP_HISTORY
+// isn't set, mirroring defaultMaxTenantOperationHistory in
+// tenantoperation_controller.go.
+const defaultMaxTenantBackupHistory = 10
+
+// maxTenantBackupHistory returns the configured Backups length cap, falling
+// back to defaultMaxTenantBackupHistory when MAX_TENANT_BACKUP_HISTORY is
+// unset or not a positive integer.
+func maxTenantBackupHistory() int {
+	v := os.Getenv("MAX_TENANT_BACKUP_HISTORY")
+	if v == "" {
+		return defaultMaxTenantBackupHistory
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil || n <= 0 {
+		return defaultMaxTenantBackupHistory
+	}
+	return n
+}
+
+// TenantBackupReconciler reconciles a TenantBackup object
+type TenantBackupReconciler struct {
+	client.Client
+	Scheme        *runtime.Scheme
+	EventRecorder record.EventRecorder
+}
+
+// +kubebuilder:rbac:groups=tenant.rezenkai.com,resources=tenantbackups,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=tenant.rezenkai.com,resources=tenantbackups/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=tenant.rezenkai.com,resources=blueprints,verbs=get;list;watch
+// +kubebuilder:rbac:groups=tenant.rezenkai.com,resources=backuprepos,verbs=get;list;watch
+// +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;create;update;patch
+
+func (r *TenantBackupReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := log.FromContext(ctx).WithValues("tenantbackup", req.NamespacedName)
+
+	tb := &tenantv1alpha1.TenantBackup{}
+	if err := r.Get(ctx, req.NamespacedName, tb); err != nil {
+		if errors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	if !tb.DeletionTimestamp.IsZero() {
+		return r.finalize(ctx, tb)
+	}
+
+	if !controllerutil.ContainsFinalizer(tb, tenantBackupFinalizer) {
+		controllerutil.AddFinalizer(tb, tenantBackupFinalizer)
+		if err := r.Update(ctx, tb); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{Requeue: true}, nil
+	}
+
+	tenant := &tenantv1alpha1.Tenant{}
+	if err := r.Get(ctx, types.NamespacedName{Name: tb.Spec.TenantRef, Namespace: tb.Namespace}, tenant); err != nil {
+		tb.Status.Phase = tenantv1alpha1.TenantBackupPhaseFailed
+		tb.Status.Message = fmt.Sprintf("tenant %q not found: %v", tb.Spec.TenantRef, err)
+		if statusErr := r.Status().Update(ctx, tb); statusErr != nil {
+			return ctrl.Result{}, statusErr
+		}
+		return ctrl.Result{}, nil
+	}
+
+	resolved, err := r.resolvedSpec(ctx, tb)
+	if err != nil {
+		tb.Status.Phase = tenantv1alpha1.TenantBackupPhaseFailed
+		tb.Status.Message = fmt.Sprintf("failed to resolve backup repo: %v", err)
+		return ctrl.Result{}, r.Status().Update(ctx, tb)
+	}
+
+	job, err := r.buildBackupJob(ctx, tenant, tb, resolved)
+	if err != nil {
+		tb.Status.Phase = tenantv1alpha1.TenantBackupPhaseFailed
+		tb.Status.Message = fmt.Sprintf("failed to render backup Job: %v", err)
+		return ctrl.Result{}, r.Status().Update(ctx, tb)
+	}
+	job.Namespace = tb.Namespace
+	if err := controllerutil.SetControllerReference(tb, job, r.Scheme); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	found := &batchv1.Job{}
+	err = r.Get(ctx, types.NamespacedName{Name: job.Name, Namespace: job.Namespace}, found)
+	if errors.IsNotFound(err) {
+		log.Info("Creating backup Job", "name", job.Name)
+		if err := r.Create(ctx, job); err != nil {
+			return ctrl.Result{}, err
+		}
+		tb.Status.Phase = tenantv1alpha1.TenantBackupPhaseRunning
+		tb.Status.StartTime = &metav1.Time{Time: time.Now()}
+		r.EventRecorder.Event(tb, corev1.EventTypeNormal, "BackupStarted", fmt.Sprintf("Backup Job %s created", job.Name))
+		if err := r.Status().Update(ctx, tb); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{RequeueAfter: 10 * time.Second}, nil
+	} else if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return r.reflectJobStatus(ctx, tb, found, resolved)
+}
+
+// resolvedSpec returns tb.Spec with StorageBackend/Bucket/Prefix overlaid
+// from tb.Spec.BackupRepoRef when set, and that BackupRepo's credentials
+// mirrored into tb's namespace so the backup/restore Job's SecretKeyRef (which
+// can only resolve a Secret in its own namespace) can reach them. tb.Spec
+// itself is left untouched so BackupRepoRef stays the source of truth on the
+// object; only this in-memory copy, used for the current reconcile, is
+// overlaid.
+func (r *TenantBackupReconciler) resolvedSpec(ctx context.Context, tb *tenantv1alpha1.TenantBackup) (tenantv1alpha1.TenantBackupSpec, error) {
+	spec := tb.Spec
+	if spec.BackupRepoRef == "" {
+		return spec, nil
+	}
+	repo := &tenantv1alpha1.BackupRepo{}
+	if err := r.Get(ctx, types.NamespacedName{Name: spec.BackupRepoRef}, repo); err != nil {
+		return spec, fmt.Errorf("looking up backup repo %q: %w", spec.BackupRepoRef, err)
+	}
+	if err := r.mirrorBackupRepoCredentials(ctx, repo, tb.Namespace); err != nil {
+		return spec, fmt.Errorf("mirroring backup repo credentials: %w", err)
+	}
+	spec.StorageBackend = repo.Spec.Backend
+	spec.Bucket = repo.Spec.Bucket
+	spec.Prefix = repo.Spec.Prefix
+	return spec, nil
+}
+
+// credentialSecretName is the Secret name pkg/backup's upload/download
+// containers expect for backend, matching what BuildUploadContainer/
+// BuildDownloadContainer hardcode.
+func credentialSecretName(backend string) string {
+	switch backend {
+	case "azure":
+		return "azure-credentials"
+	case "minio":
+		return "minio-credentials"
+	default:
+		return "aws-credentials"
+	}
+}
+
+// mirrorBackupRepoCredentials copies repo.Spec.CredentialsSecretRef into
+// namespace under credentialSecretName(repo.Spec.Backend). gcs containers
+// read GOOGLE_APPLICATION_CREDENTIALS from a mounted file rather than a
+// Secret env var, so there's nothing to mirror for that backend.
+func (r *TenantBackupReconciler) mirrorBackupRepoCredentials(ctx context.Context, repo *tenantv1alpha1.BackupRepo, namespace string) error {
+	if repo.Spec.Backend == "gcs" {
+		return nil
+	}
+	src := &corev1.Secret{}
+	ref := repo.Spec.CredentialsSecretRef
+	if err := r.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, src); err != nil {
+		return fmt.Errorf("reading source credentials secret %s/%s: %w", ref.Namespace, ref.Name, err)
+	}
+	name := credentialSecretName(repo.Spec.Backend)
+	existing := &corev1.Secret{}
+	err := r.Get(ctx, types.NamespacedName{Name: name, Namespace: namespace}, existing)
+	if errors.IsNotFound(err) {
+		return r.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Data:       src.Data,
+		})
+	}
+	if err != nil {
+		return err
+	}
+	existing.Data = src.Data
+	return r.Update(ctx, existing)
+}
+
+// buildBackupJob renders the backup Job for tb against its resolved spec.
+// Tenants with a Spec.Database.BlueprintRef, or a database engine with no
+// hardcoded path (e.g. mssql), render through a Blueprint; postgres and
+// mysql otherwise keep using the free-function builders so
+// physical/WAL-archiving mode (see buildBackupJob in tenant_controller.go)
+// keeps working unchanged.
+func (r *TenantBackupReconciler) buildBackupJob(ctx context.Context, tenant *tenantv1alpha1.Tenant, tb *tenantv1alpha1.TenantBackup, resolved tenantv1alpha1.TenantBackupSpec) (*batchv1.Job, error) {
+	dbType := tenant.Spec.Database.Type
+	if tenant.Spec.Database.BlueprintRef == "" && (dbType == "postgres" || dbType == "mysql") {
+		return buildBackupJob(tenant, tb.Name, resolved), nil
+	}
+
+	bp, err := r.lookupBlueprint(ctx, tenant)
+	if err != nil {
+		return nil, err
+	}
+	rc := blueprint.RenderContext{
+		Tenant:     blueprint.TenantContext{Name: tenant.Name, Namespace: tenant.Namespace},
+		Database:   databaseContext(tenant),
+		ArtifactID: tb.Name,
+	}
+	labels := map[string]string{"app": "backup", "tenant": tenant.Name}
+	job, err := blueprint.Render(bp, tenantv1alpha1.BlueprintActionBackup, fmt.Sprintf("%s-backup-%s", tenant.Name, tb.Name), tb.Namespace, rc, labels)
+	if err != nil {
+		return nil, err
+	}
+	// Built-in blueprints (no BlueprintRef) capture their artifact to
+	// /backup on the shared backup-vol; hand it to the same uploader sidecar
+	// the postgres/mysql free-function Job builders use. A custom
+	// BlueprintRef is responsible for shipping its own artifact.
+	if tenant.Spec.Database.BlueprintRef == "" {
+		localPath := fmt.Sprintf("/backup/%s.%s", tb.Name, builtinArtifactExt(tenant.Spec.Database.Type))
+		objectKey := backup.ObjectKey(resolved.Prefix, tenant.Name, tb.Name, builtinArtifactExt(tenant.Spec.Database.Type))
+		uploader := backup.BuildUploadContainer(backup.Backend(resolved.StorageBackend), resolved.Bucket, objectKey, localPath, resolved.EncryptionKeyRef)
+		job.Spec.Template.Spec.Containers = append(job.Spec.Template.Spec.Containers, uploader)
+	}
+	return job, nil
+}
+
+// builtinArtifactExt is the file extension a built-in blueprint's backup
+// phase writes its artifact under; mirrors backup.ArtifactExt for the
+// non-postgres/mysql engines Blueprint renders for.
+func builtinArtifactExt(dbType string) string {
+	if dbType == "mssql" {
+		return "bak"
+	}
+	return "sql"
+}
+
+// lookupBlueprint resolves tenant's Blueprint: the one named by BlueprintRef
+// if set, otherwise the built-in matching its database engine.
+func (r *TenantBackupReconciler) lookupBlueprint(ctx context.Context, tenant *tenantv1alpha1.Tenant) (*tenantv1alpha1.Blueprint, error) {
+	if tenant.Spec.Database.BlueprintRef == "" {
+		return blueprint.Builtin(tenant.Spec.Database.Type, tenant.Name)
+	}
+	bp := &tenantv1alpha1.Blueprint{}
+	if err := r.Get(ctx, types.NamespacedName{Name: tenant.Spec.Database.BlueprintRef, Namespace: tenant.Namespace}, bp); err != nil {
+		return nil, fmt.Errorf("looking up blueprint %q: %w", tenant.Spec.Database.BlueprintRef, err)
+	}
+	return bp, nil
+}
+
+// databaseContext builds the blueprint.DatabaseContext a tenant's blueprint
+// phases render against.
+func databaseContext(tenant *tenantv1alpha1.Tenant) blueprint.DatabaseContext {
+	return blueprint.DatabaseContext{
+		Host:     fmt.Sprintf("%s-db-svc.tenant-%s.svc.cluster.local", tenant.Name, tenant.Name),
+		Type:     tenant.Spec.Database.Type,
+		Version:  tenant.Spec.Database.Version,
+		Name:     fmt.Sprintf("tenant_%s_db", tenant.Name),
+		Username: fmt.Sprintf("tenant_%s", tenant.Name),
+	}
+}
+
+func (r *TenantBackupReconciler) reflectJobStatus(ctx context.Context, tb *tenantv1alpha1.TenantBackup, job *batchv1.Job, resolved tenantv1alpha1.TenantBackupSpec) (ctrl.Result, error) {
+	switch {
+	case job.Status.Succeeded > 0:
+		if tb.Status.Phase == tenantv1alpha1.TenantBackupPhaseCompleted {
+			return ctrl.Result{}, nil
+		}
+		tb.Status.Phase = tenantv1alpha1.TenantBackupPhaseCompleted
+		tb.Status.CompletionTime = &metav1.Time{Time: time.Now()}
+		tb.Status.BackendLocation = fmt.Sprintf("%s/%s", resolved.Bucket, backup.ObjectKey(resolved.Prefix, tb.Spec.TenantRef, tb.Name, artifactExtFor(tb.Spec.Mode, tb.Spec.Compression)))
+		r.EventRecorder.Event(tb, corev1.EventTypeNormal, "BackupCompleted", "Backup Job completed successfully")
+		if tb.Spec.Mode == "physical" && tb.Spec.WALArchiving {
+			r.recordPITRWindow(ctx, tb)
+		}
+		r.recordBackupStats(ctx, tb)
+		return ctrl.Result{}, r.Status().Update(ctx, tb)
+	case job.Status.Failed > 0:
+		if tb.Status.Phase == tenantv1alpha1.TenantBackupPhaseFailed {
+			return ctrl.Result{}, nil
+		}
+		tb.Status.Phase = tenantv1alpha1.TenantBackupPhaseFailed
+		tb.Status.Message = "backup Job failed, see Job events for details"
+		r.EventRecorder.Event(tb, corev1.EventTypeWarning, "BackupFailed", tb.Status.Message)
+		return ctrl.Result{}, r.Status().Update(ctx, tb)
+	default:
+		return ctrl.Result{RequeueAfter: 10 * time.Second}, nil
+	}
+}
+
+// finalize removes the backup artifact from its storage backend before
+// letting the TenantBackup CR itself be deleted.
+func (r *TenantBackupReconciler) finalize(ctx context.Context, tb *tenantv1alpha1.TenantBackup) (ctrl.Result, error) {
+	if !controllerutil.ContainsFinalizer(tb, tenantBackupFinalizer) {
+		return ctrl.Result{}, nil
+	}
+	if tb.Status.Phase == tenantv1alpha1.TenantBackupPhaseCompleted {
+		resolved, err := r.resolvedSpec(ctx, tb)
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to resolve backup repo: %w", err)
+		}
+		store, err := backup.NewObjectStore(backup.Backend(resolved.StorageBackend))
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to initialize object store: %w", err)
+		}
+		key := backup.ObjectKey(resolved.Prefix, tb.Spec.TenantRef, tb.Name, artifactExtFor(tb.Spec.Mode, tb.Spec.Compression))
+		if err := store.Delete(ctx, resolved.Bucket, key); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to delete backup artifact: %w", err)
+		}
+		r.EventRecorder.Event(tb, corev1.EventTypeNormal, "BackupArtifactDeleted", fmt.Sprintf("Deleted %s/%s", resolved.Bucket, key))
+	}
+	controllerutil.RemoveFinalizer(tb, tenantBackupFinalizer)
+	return ctrl.Result{}, r.Update(ctx, tb)
+}
+
+// recordPITRWindow reflects a completed physical/WAL-archiving backup onto
+// its Tenant's DatabaseStatus, so `tenant get` can show the actual
+// recoverable point-in-time window rather than requiring users to infer it
+// from TenantBackup history. It's best-effort: a failure here shouldn't fail
+// the backup itself.
+func (r *TenantBackupReconciler) recordPITRWindow(ctx context.Context, tb *tenantv1alpha1.TenantBackup) {
+	log := log.FromContext(ctx).WithValues("tenantbackup", tb.Name)
+	tenant := &tenantv1alpha1.Tenant{}
+	if err := r.Get(ctx, types.NamespacedName{Name: tb.Spec.TenantRef, Namespace: tb.Namespace}, tenant); err != nil {
+		log.Error(err, "failed to look up tenant for PITR window update")
+		return
+	}
+	now := metav1.Time{Time: time.Now()}
+	retentionDays := int32(7)
+	if tenant.Spec.Database.Backup.WAL != nil && tenant.Spec.Database.Backup.WAL.RetentionDays > 0 {
+		retentionDays = tenant.Spec.Database.Backup.WAL.RetentionDays
+	}
+	windowStart := metav1.Time{Time: now.Add(-time.Duration(retentionDays) * 24 * time.Hour)}
+	tenant.Status.DatabaseStatus.PITRWindowStart = &windowStart
+	tenant.Status.DatabaseStatus.PITRWindowEnd = &now
+	tenant.Status.DatabaseStatus.LastWALArchiveTime = &now
+	if err := r.Status().Update(ctx, tenant); err != nil {
+		log.Error(err, "failed to update tenant PITR window status")
+	}
+}
+
+// recordBackupStats reflects a completed backup onto its Tenant's
+// DatabaseStatus.LastSuccessfulBackup/BackupCount so `tenant get` doesn't
+// need to list TenantBackups to answer "when did this last succeed". Like
+// recordPITRWindow, it's best-effort: a failure here shouldn't fail the
+// backup itself.
+func (r *TenantBackupReconciler) recordBackupStats(ctx context.Context, tb *tenantv1alpha1.TenantBackup) {
+	log := log.FromContext(ctx).WithValues("tenantbackup", tb.Name)
+	tenant := &tenantv1alpha1.Tenant{}
+	if err := r.Get(ctx, types.NamespacedName{Name: tb.Spec.TenantRef, Namespace: tb.Namespace}, tenant); err != nil {
+		log.Error(err, "failed to look up tenant for backup stats update")
+		return
+	}
+	now := metav1.Time{Time: time.Now()}
+	tenant.Status.DatabaseStatus.LastSuccessfulBackup = &now
+	tenant.Status.DatabaseStatus.BackupCount++
+
+	record := tenantv1alpha1.BackupRecord{
+		Name:           tb.Name,
+		Method:         tb.Spec.Mode,
+		StartTime:      tb.Status.StartTime,
+		CompletionTime: tb.Status.CompletionTime,
+		SizeBytes:      tb.Status.SizeBytes,
+		Path:           tb.Status.BackendLocation,
+		Checksum:       tb.Status.Checksum,
+	}
+	history := append([]tenantv1alpha1.BackupRecord{record}, tenant.Status.DatabaseStatus.Backups...)
+	if max := maxTenantBackupHistory(); len(history) > max {
+		history = history[:max]
+	}
+	tenant.Status.DatabaseStatus.Backups = history
+
+	if err := r.Status().Update(ctx, tenant); err != nil {
+		log.Error(err, "failed to update tenant backup stats status")
+	}
+}
+
+func (r *TenantBackupReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&tenantv1alpha1.TenantBackup{}).
+		Owns(&batchv1.Job{}).
+		Complete(r)
+}
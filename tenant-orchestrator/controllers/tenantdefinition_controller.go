@@ -0,0 +1,62 @@
+package controllers
+
+import (
+	"context"
+	"time"
+
+	tenantv1alpha1 "github.com/rezenkai/multi-saas-crm/tenant-orchestrator/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// TenantDefinitionReconciler validates a TenantDefinition's Components so a
+// misconfigured one (e.g. a duplicate component name) surfaces on the object
+// itself rather than only when a Tenant's resolveComponent call fails.
+type TenantDefinitionReconciler struct {
+	client.Client
+	Scheme        *runtime.Scheme
+	EventRecorder record.EventRecorder
+}
+
+// +kubebuilder:rbac:groups=tenant.rezenkai.com,resources=tenantdefinitions,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=tenant.rezenkai.com,resources=tenantdefinitions/status,verbs=get;update;patch
+
+func (r *TenantDefinitionReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	def := &tenantv1alpha1.TenantDefinition{}
+	if err := r.Get(ctx, req.NamespacedName, def); err != nil {
+		if errors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	seen := make(map[string]bool, len(def.Spec.Components))
+	for _, c := range def.Spec.Components {
+		if seen[c.Name] {
+			def.Status.Phase = "Failed"
+			def.Status.Message = "duplicate component name " + c.Name
+			r.EventRecorder.Event(def, "Warning", "DuplicateComponent", def.Status.Message)
+			if err := r.Status().Update(ctx, def); err != nil {
+				return ctrl.Result{}, err
+			}
+			return ctrl.Result{}, nil
+		}
+		seen[c.Name] = true
+	}
+
+	def.Status.Phase = "Ready"
+	def.Status.Message = ""
+	if err := r.Status().Update(ctx, def); err != nil {
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{RequeueAfter: 5 * time.Minute}, nil
+}
+
+func (r *TenantDefinitionReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&tenantv1alpha1.TenantDefinition{}).
+		Complete(r)
+}
@@ -0,0 +1,286 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	tenantv1alpha1 "github.com/rezenkai/multi-saas-crm/tenant-orchestrator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// defaultMaxTenantOperationHistory is how many TenantOperationRecords
+// Tenant.Status.OperationHistory keeps when MAX_TENANT_OPERATION_HISTORY
+// isn't set.
+const defaultMaxTenantOperationHistory = 10
+
+// tenantSuspendedAnnotation marks a Tenant as suspended by a TenantOperation
+// of type Suspend; tenant_controller.go's Reconcile skips spec reconciliation
+// while it's present, and a Resume operation removes it.
+const tenantSuspendedAnnotation = "tenant.rezenkai.com/suspended"
+
+// maxTenantOperationHistory returns the configured OperationHistory length
+// cap, falling back to defaultMaxTenantOperationHistory when
+// MAX_TENANT_OPERATION_HISTORY is unset or not a positive integer.
+func maxTenantOperationHistory() int {
+	v := os.Getenv("MAX_TENANT_OPERATION_HISTORY")
+	if v == "" {
+		return defaultMaxTenantOperationHistory
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil || n <= 0 {
+		return defaultMaxTenantOperationHistory
+	}
+	return n
+}
+
+// TenantOperationReconciler reconciles a TenantOperation object
+type TenantOperationReconciler struct {
+	client.Client
+	Scheme        *runtime.Scheme
+	EventRecorder record.EventRecorder
+}
+
+// +kubebuilder:rbac:groups=tenant.rezenkai.com,resources=tenantoperations,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=tenant.rezenkai.com,resources=tenantoperations/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=tenant.rezenkai.com,resources=tenants,verbs=get;list;watch;update;patch;delete
+// +kubebuilder:rbac:groups=tenant.rezenkai.com,resources=tenants/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=tenant.rezenkai.com,resources=tenantbackups,verbs=get;list;watch;create
+// +kubebuilder:rbac:groups=tenant.rezenkai.com,resources=tenantrestores,verbs=get;list;watch;create
+
+func (r *TenantOperationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := log.FromContext(ctx).WithValues("tenantoperation", req.NamespacedName)
+
+	op := &tenantv1alpha1.TenantOperation{}
+	if err := r.Get(ctx, req.NamespacedName, op); err != nil {
+		if errors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	if op.Status.Phase == tenantv1alpha1.TenantOperationPhaseCompleted ||
+		op.Status.Phase == tenantv1alpha1.TenantOperationPhaseFailed {
+		return ctrl.Result{}, nil
+	}
+
+	tenant := &tenantv1alpha1.Tenant{}
+	if err := r.Get(ctx, types.NamespacedName{Name: op.Spec.TenantRef, Namespace: op.Namespace}, tenant); err != nil {
+		return r.fail(ctx, op, fmt.Sprintf("tenant %q not found: %v", op.Spec.TenantRef, err))
+	}
+
+	if op.Status.Phase == "" {
+		op.Status.Phase = tenantv1alpha1.TenantOperationPhaseRunning
+		op.Status.StartTime = &metav1.Time{Time: time.Now()}
+		r.EventRecorder.Event(op, corev1.EventTypeNormal, "OperationStarted",
+			fmt.Sprintf("%s operation on tenant %q started", op.Spec.Type, op.Spec.TenantRef))
+		if err := r.Status().Update(ctx, op); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	var dispatchErr error
+	switch op.Spec.Type {
+	case tenantv1alpha1.TenantOperationProvision:
+		dispatchErr = r.applyProvision(ctx, tenant)
+	case tenantv1alpha1.TenantOperationUpgrade:
+		dispatchErr = r.applyUpgrade(ctx, tenant, op.Spec.Parameters)
+	case tenantv1alpha1.TenantOperationMigrate:
+		dispatchErr = r.applyMigrate(ctx, tenant, op.Spec.Parameters)
+	case tenantv1alpha1.TenantOperationBackup:
+		dispatchErr = r.applyBackup(ctx, op, tenant)
+	case tenantv1alpha1.TenantOperationRestore:
+		dispatchErr = r.applyRestore(ctx, op, tenant)
+	case tenantv1alpha1.TenantOperationSuspend:
+		dispatchErr = r.applySuspend(ctx, tenant, true)
+	case tenantv1alpha1.TenantOperationResume:
+		dispatchErr = r.applySuspend(ctx, tenant, false)
+	case tenantv1alpha1.TenantOperationDelete:
+		dispatchErr = r.Delete(ctx, tenant)
+	default:
+		dispatchErr = fmt.Errorf("unsupported operation type %q", op.Spec.Type)
+	}
+
+	if dispatchErr != nil {
+		if op.Status.RetryCount < op.Spec.RetryLimit {
+			op.Status.RetryCount++
+			log.Info("retrying failed operation", "attempt", op.Status.RetryCount, "error", dispatchErr)
+			r.recordHistory(ctx, tenant, op)
+			if err := r.Status().Update(ctx, op); err != nil {
+				return ctrl.Result{}, err
+			}
+			return ctrl.Result{RequeueAfter: 10 * time.Second}, nil
+		}
+		return r.fail(ctx, op, dispatchErr.Error())
+	}
+
+	op.Status.Phase = tenantv1alpha1.TenantOperationPhaseCompleted
+	op.Status.CompletionTime = &metav1.Time{Time: time.Now()}
+	op.Status.Message = ""
+	r.EventRecorder.Event(op, corev1.EventTypeNormal, "OperationCompleted",
+		fmt.Sprintf("%s operation on tenant %q completed", op.Spec.Type, op.Spec.TenantRef))
+	r.recordHistory(ctx, tenant, op)
+	return ctrl.Result{}, r.Status().Update(ctx, op)
+}
+
+// fail marks op Failed and records it onto tenant's OperationHistory (best
+// effort, since the tenant itself may be the thing that's missing).
+func (r *TenantOperationReconciler) fail(ctx context.Context, op *tenantv1alpha1.TenantOperation, message string) (ctrl.Result, error) {
+	op.Status.Phase = tenantv1alpha1.TenantOperationPhaseFailed
+	op.Status.CompletionTime = &metav1.Time{Time: time.Now()}
+	op.Status.Message = message
+	r.EventRecorder.Event(op, corev1.EventTypeWarning, "OperationFailed", message)
+	tenant := &tenantv1alpha1.Tenant{}
+	if err := r.Get(ctx, types.NamespacedName{Name: op.Spec.TenantRef, Namespace: op.Namespace}, tenant); err == nil {
+		r.recordHistory(ctx, tenant, op)
+	}
+	return ctrl.Result{}, r.Status().Update(ctx, op)
+}
+
+// recordHistory prepends op's current state onto tenant.Status.OperationHistory,
+// trimming it to maxTenantOperationHistory entries. Best-effort: a failure to
+// persist this shouldn't fail the operation itself.
+func (r *TenantOperationReconciler) recordHistory(ctx context.Context, tenant *tenantv1alpha1.Tenant, op *tenantv1alpha1.TenantOperation) {
+	log := log.FromContext(ctx).WithValues("tenantoperation", op.Name)
+	record := tenantv1alpha1.TenantOperationRecord{
+		Name:       op.Name,
+		Type:       string(op.Spec.Type),
+		Phase:      string(op.Status.Phase),
+		ObservedAt: metav1.Time{Time: time.Now()},
+	}
+	history := []tenantv1alpha1.TenantOperationRecord{record}
+	for _, existing := range tenant.Status.OperationHistory {
+		if existing.Name == op.Name {
+			continue
+		}
+		history = append(history, existing)
+	}
+	if max := maxTenantOperationHistory(); len(history) > max {
+		history = history[:max]
+	}
+	tenant.Status.OperationHistory = history
+	if err := r.Status().Update(ctx, tenant); err != nil {
+		log.Error(err, "failed to update tenant operation history")
+	}
+}
+
+// applyProvision is a no-op: Tenant creation itself is already driven by
+// TenantReconciler reconciling the Tenant object's own Spec, so a Provision
+// operation exists purely to give that first reconcile an auditable,
+// retryable record.
+func (r *TenantOperationReconciler) applyProvision(ctx context.Context, tenant *tenantv1alpha1.Tenant) error {
+	return nil
+}
+
+// applyUpgrade bumps one service's Version, the same change `tenant upgrade
+// --strategy=rolling` makes, recording it to UpgradeHistory so it plays
+// through the same rollback bookkeeping.
+func (r *TenantOperationReconciler) applyUpgrade(ctx context.Context, tenant *tenantv1alpha1.Tenant, params map[string]string) error {
+	service := params["service"]
+	version := params["version"]
+	if service == "" || version == "" {
+		return fmt.Errorf("upgrade requires parameters.service and parameters.version")
+	}
+	found := false
+	record := tenantv1alpha1.UpgradeRecord{
+		Timestamp:        metav1.Now(),
+		Strategy:         "rolling",
+		PreviousVersions: map[string]string{},
+		Services:         []string{service},
+	}
+	for i, svc := range tenant.Spec.Services {
+		if svc.Name == service {
+			record.PreviousVersions[service] = svc.Version
+			tenant.Spec.Services[i].Version = version
+			found = true
+		}
+	}
+	if !found {
+		return fmt.Errorf("service %q not found in tenant %q", service, tenant.Name)
+	}
+	if err := r.Update(ctx, tenant); err != nil {
+		return fmt.Errorf("failed to apply upgrade: %w", err)
+	}
+	tenant.Status.UpgradeHistory = append(tenant.Status.UpgradeHistory, record)
+	return r.Status().Update(ctx, tenant)
+}
+
+// applyMigrate bumps the tenant's database Version, e.g. for an in-place
+// engine version migration; more involved migrations (cross-engine, a new
+// PVC) are left to a dedicated request once there's a concrete one to model.
+func (r *TenantOperationReconciler) applyMigrate(ctx context.Context, tenant *tenantv1alpha1.Tenant, params map[string]string) error {
+	version := params["version"]
+	if version == "" {
+		return fmt.Errorf("migrate requires parameters.version")
+	}
+	tenant.Spec.Database.Version = version
+	return r.Update(ctx, tenant)
+}
+
+// applyBackup creates the TenantBackup TenantBackupReconciler drives,
+// reusing op's own name so repeated reconciles of the same operation are
+// idempotent.
+func (r *TenantOperationReconciler) applyBackup(ctx context.Context, op *tenantv1alpha1.TenantOperation, tenant *tenantv1alpha1.Tenant) error {
+	tb := &tenantv1alpha1.TenantBackup{
+		ObjectMeta: metav1.ObjectMeta{Name: op.Name, Namespace: op.Namespace},
+		Spec: tenantv1alpha1.TenantBackupSpec{
+			TenantRef:      tenant.Name,
+			StorageBackend: op.Spec.Parameters["storageBackend"],
+			Bucket:         op.Spec.Parameters["bucket"],
+			Prefix:         op.Spec.Parameters["prefix"],
+		},
+	}
+	if err := r.Create(ctx, tb); err != nil && !errors.IsAlreadyExists(err) {
+		return fmt.Errorf("failed to create backup %q: %w", tb.Name, err)
+	}
+	return nil
+}
+
+// applyRestore creates the TenantRestore TenantRestoreReconciler drives.
+func (r *TenantOperationReconciler) applyRestore(ctx context.Context, op *tenantv1alpha1.TenantOperation, tenant *tenantv1alpha1.Tenant) error {
+	sourceBackupRef := op.Spec.Parameters["sourceBackupRef"]
+	if sourceBackupRef == "" {
+		return fmt.Errorf("restore requires parameters.sourceBackupRef")
+	}
+	restore := &tenantv1alpha1.TenantRestore{
+		ObjectMeta: metav1.ObjectMeta{Name: op.Name, Namespace: op.Namespace},
+		Spec: tenantv1alpha1.TenantRestoreSpec{
+			SourceBackupRef: sourceBackupRef,
+			TenantRef:       tenant.Name,
+		},
+	}
+	if err := r.Create(ctx, restore); err != nil && !errors.IsAlreadyExists(err) {
+		return fmt.Errorf("failed to create restore %q: %w", restore.Name, err)
+	}
+	return nil
+}
+
+// applySuspend sets or clears tenantSuspendedAnnotation, which
+// tenant_controller.go's Reconcile checks to pause normal spec reconciliation.
+func (r *TenantOperationReconciler) applySuspend(ctx context.Context, tenant *tenantv1alpha1.Tenant, suspended bool) error {
+	if suspended {
+		if tenant.Annotations == nil {
+			tenant.Annotations = map[string]string{}
+		}
+		tenant.Annotations[tenantSuspendedAnnotation] = "true"
+	} else {
+		delete(tenant.Annotations, tenantSuspendedAnnotation)
+	}
+	return r.Update(ctx, tenant)
+}
+
+func (r *TenantOperationReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&tenantv1alpha1.TenantOperation{}).
+		Complete(r)
+}
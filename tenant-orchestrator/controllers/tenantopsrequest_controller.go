@@ -0,0 +1,473 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	tenantv1alpha1 "github.com/rezenkai/multi-saas-crm/tenant-orchestrator/api/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// opsTierEnvelope bounds how far a single TenantOpsRequest may move a
+// tenant's resources, keyed by Tenant.Spec.Tier. There's no existing
+// per-tier resource table in this tree to reuse (Tier today only drives
+// label selectors), so this is the first one; VerticalScaling,
+// HorizontalScaling, and VolumeExpansion are validated against it before
+// anything is mutated.
+type opsTierEnvelope struct {
+	maxCPU      resource.Quantity
+	maxMemory   resource.Quantity
+	maxReplicas int32
+	maxStorage  resource.Quantity
+}
+
+var opsTierEnvelopes = map[string]opsTierEnvelope{
+	"starter": {
+		maxCPU:      resource.MustParse("2"),
+		maxMemory:   resource.MustParse("4Gi"),
+		maxReplicas: 3,
+		maxStorage:  resource.MustParse("20Gi"),
+	},
+	"professional": {
+		maxCPU:      resource.MustParse("8"),
+		maxMemory:   resource.MustParse("16Gi"),
+		maxReplicas: 10,
+		maxStorage:  resource.MustParse("200Gi"),
+	},
+	"enterprise": {
+		maxCPU:      resource.MustParse("32"),
+		maxMemory:   resource.MustParse("128Gi"),
+		maxReplicas: 50,
+		maxStorage:  resource.MustParse("2Ti"),
+	},
+}
+
+// restartedAtAnnotation is the same pod-template annotation `kubectl rollout
+// restart` sets; kubelet doesn't care about its value, only that it changed,
+// which is enough to roll every pod even though nothing else in the spec did.
+const restartedAtAnnotation = "kubectl.kubernetes.io/restartedAt"
+
+// switchoverRequestedAnnotation and switchoverTargetAnnotation are set on a
+// tenant's HA database StatefulSet to signal a requested Patroni failover.
+// This operator doesn't speak the Patroni REST API directly, so acting on
+// these -- the same way an external Patroni sidecar or a cluster-level
+// Patroni webhook would -- is left to that piece of infrastructure; this
+// controller's job is to record the request against the right object.
+const (
+	switchoverRequestedAnnotation = "tenant.rezenkai.com/switchover-requested-at"
+	switchoverTargetAnnotation    = "tenant.rezenkai.com/switchover-target"
+)
+
+// TenantOpsRequestReconciler reconciles a TenantOpsRequest object
+type TenantOpsRequestReconciler struct {
+	client.Client
+	Scheme        *runtime.Scheme
+	EventRecorder record.EventRecorder
+}
+
+// +kubebuilder:rbac:groups=tenant.rezenkai.com,resources=tenantopsrequests,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=tenant.rezenkai.com,resources=tenantopsrequests/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=tenant.rezenkai.com,resources=tenants,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=tenant.rezenkai.com,resources=tenants/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=apps,resources=statefulsets,verbs=get;list;watch;update;patch
+
+func (r *TenantOpsRequestReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := log.FromContext(ctx).WithValues("tenantopsrequest", req.NamespacedName)
+
+	ops := &tenantv1alpha1.TenantOpsRequest{}
+	if err := r.Get(ctx, req.NamespacedName, ops); err != nil {
+		if errors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	switch ops.Status.Phase {
+	case tenantv1alpha1.OpsPhaseSucceed, tenantv1alpha1.OpsPhaseFailed, tenantv1alpha1.OpsPhaseCancelled:
+		return ctrl.Result{}, nil
+	}
+
+	if ops.Spec.Cancel && ops.Status.Phase != tenantv1alpha1.OpsPhaseRunning {
+		return r.cancel(ctx, ops)
+	}
+
+	tenant := &tenantv1alpha1.Tenant{}
+	if err := r.Get(ctx, types.NamespacedName{Name: ops.Spec.TenantRef, Namespace: ops.Namespace}, tenant); err != nil {
+		return r.fail(ctx, ops, fmt.Sprintf("tenant %q not found: %v", ops.Spec.TenantRef, err))
+	}
+
+	if ops.Status.Phase == "" {
+		ops.Status.Phase = tenantv1alpha1.OpsPhaseCreating
+		ops.Status.StartTime = &metav1.Time{Time: time.Now()}
+		r.EventRecorder.Event(ops, corev1.EventTypeNormal, "OpsRequestCreated",
+			fmt.Sprintf("%s request against tenant %q created", ops.Spec.Type, ops.Spec.TenantRef))
+		if err := r.Status().Update(ctx, ops); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	if err := r.validate(tenant, ops); err != nil {
+		meta.SetStatusCondition(&ops.Status.Conditions, metav1.Condition{
+			Type:    tenantv1alpha1.OpsConditionTypeValidated,
+			Status:  metav1.ConditionFalse,
+			Reason:  "ValidationFailed",
+			Message: err.Error(),
+		})
+		return r.fail(ctx, ops, err.Error())
+	}
+	meta.SetStatusCondition(&ops.Status.Conditions, metav1.Condition{
+		Type:    tenantv1alpha1.OpsConditionTypeValidated,
+		Status:  metav1.ConditionTrue,
+		Reason:  "EnvelopeOK",
+		Message: "request is within the tenant's tier envelope",
+	})
+
+	ops.Status.Phase = tenantv1alpha1.OpsPhaseRunning
+	meta.SetStatusCondition(&ops.Status.Conditions, metav1.Condition{
+		Type:    tenantv1alpha1.OpsConditionTypeProgressing,
+		Status:  metav1.ConditionTrue,
+		Reason:  "Applying",
+		Message: "applying requested mutation",
+	})
+	if err := r.Status().Update(ctx, ops); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if err := r.apply(ctx, tenant, ops); err != nil {
+		log.Error(err, "failed to apply ops request")
+		return r.fail(ctx, ops, err.Error())
+	}
+
+	ops.Status.Phase = tenantv1alpha1.OpsPhaseSucceed
+	ops.Status.CompletionTime = &metav1.Time{Time: time.Now()}
+	ops.Status.Progress = fmt.Sprintf("%d/%d", len(ops.Status.ComponentProgress), len(ops.Status.ComponentProgress))
+	meta.SetStatusCondition(&ops.Status.Conditions, metav1.Condition{
+		Type:    tenantv1alpha1.OpsConditionTypeProgressing,
+		Status:  metav1.ConditionFalse,
+		Reason:  "Applied",
+		Message: "mutation applied",
+	})
+	meta.SetStatusCondition(&ops.Status.Conditions, metav1.Condition{
+		Type:    tenantv1alpha1.OpsConditionTypeSucceed,
+		Status:  metav1.ConditionTrue,
+		Reason:  "Applied",
+		Message: "mutation applied",
+	})
+	r.EventRecorder.Event(ops, corev1.EventTypeNormal, "OpsRequestSucceeded",
+		fmt.Sprintf("%s request against tenant %q succeeded", ops.Spec.Type, ops.Spec.TenantRef))
+	return ctrl.Result{}, r.Status().Update(ctx, ops)
+}
+
+// validate checks the request's payload is present for its Type and, for the
+// types that move a resource envelope, that the target value doesn't exceed
+// tenant.Spec.Tier's opsTierEnvelope.
+func (r *TenantOpsRequestReconciler) validate(tenant *tenantv1alpha1.Tenant, ops *tenantv1alpha1.TenantOpsRequest) error {
+	envelope, ok := opsTierEnvelopes[tenant.Spec.Tier]
+	if !ok {
+		return fmt.Errorf("tenant %q has unrecognized tier %q", tenant.Name, tenant.Spec.Tier)
+	}
+
+	switch ops.Spec.Type {
+	case tenantv1alpha1.VerticalScalingOpsType:
+		v := ops.Spec.VerticalScaling
+		if v == nil {
+			return fmt.Errorf("verticalScaling requires spec.verticalScaling")
+		}
+		if v.CPU.Limit != "" && resource.MustParse(v.CPU.Limit).Cmp(envelope.maxCPU) > 0 {
+			return fmt.Errorf("cpu limit %q exceeds tier %q envelope of %s", v.CPU.Limit, tenant.Spec.Tier, envelope.maxCPU.String())
+		}
+		if v.Memory.Limit != "" && resource.MustParse(v.Memory.Limit).Cmp(envelope.maxMemory) > 0 {
+			return fmt.Errorf("memory limit %q exceeds tier %q envelope of %s", v.Memory.Limit, tenant.Spec.Tier, envelope.maxMemory.String())
+		}
+	case tenantv1alpha1.HorizontalScalingOpsType:
+		h := ops.Spec.HorizontalScaling
+		if h == nil {
+			return fmt.Errorf("horizontalScaling requires spec.horizontalScaling")
+		}
+		if h.Replicas > envelope.maxReplicas {
+			return fmt.Errorf("replicas %d exceeds tier %q envelope of %d", h.Replicas, tenant.Spec.Tier, envelope.maxReplicas)
+		}
+		if ops.Spec.ServiceName == "" {
+			return fmt.Errorf("horizontalScaling requires spec.serviceName")
+		}
+	case tenantv1alpha1.VolumeExpansionOpsType:
+		v := ops.Spec.VolumeExpansion
+		if v == nil || v.Storage == "" {
+			return fmt.Errorf("volumeExpansion requires spec.volumeExpansion.storage")
+		}
+		newSize := resource.MustParse(v.Storage)
+		if newSize.Cmp(envelope.maxStorage) > 0 {
+			return fmt.Errorf("storage %q exceeds tier %q envelope of %s", v.Storage, tenant.Spec.Tier, envelope.maxStorage.String())
+		}
+		if newSize.Cmp(resource.MustParse(tenant.Spec.Resources.Storage.Size)) <= 0 {
+			return fmt.Errorf("storage %q is not larger than the tenant's current size %q", v.Storage, tenant.Spec.Resources.Storage.Size)
+		}
+	case tenantv1alpha1.ReconfigureOpsType:
+		if ops.Spec.Reconfigure == nil || len(ops.Spec.Reconfigure.Config) == 0 {
+			return fmt.Errorf("reconfigure requires spec.reconfigure.config")
+		}
+		if ops.Spec.ServiceName == "" {
+			return fmt.Errorf("reconfigure requires spec.serviceName")
+		}
+	case tenantv1alpha1.VersionUpgradeOpsType:
+		if ops.Spec.VersionUpgrade == nil || ops.Spec.VersionUpgrade.Version == "" {
+			return fmt.Errorf("versionUpgrade requires spec.versionUpgrade.version")
+		}
+		if ops.Spec.ServiceName == "" {
+			return fmt.Errorf("versionUpgrade requires spec.serviceName")
+		}
+	case tenantv1alpha1.RestartOpsType:
+		if ops.Spec.ServiceName == "" {
+			return fmt.Errorf("restart requires spec.serviceName")
+		}
+	case tenantv1alpha1.SwitchoverOpsType:
+		if tenant.Spec.Database.HighAvailability == nil {
+			return fmt.Errorf("switchover requires tenant %q to have database.highAvailability enabled", tenant.Name)
+		}
+	case tenantv1alpha1.ExposeOpsType:
+		if ops.Spec.Expose == nil || len(ops.Spec.Expose.Domains) == 0 {
+			return fmt.Errorf("expose requires spec.expose.domains")
+		}
+	default:
+		return fmt.Errorf("unsupported ops request type %q", ops.Spec.Type)
+	}
+	return nil
+}
+
+// apply dispatches ops to the handler for its Type and records a
+// ComponentProgress entry for the outcome.
+func (r *TenantOpsRequestReconciler) apply(ctx context.Context, tenant *tenantv1alpha1.Tenant, ops *tenantv1alpha1.TenantOpsRequest) error {
+	var err error
+	switch ops.Spec.Type {
+	case tenantv1alpha1.VerticalScalingOpsType:
+		err = r.applyVerticalScaling(ctx, tenant, ops.Spec.VerticalScaling)
+	case tenantv1alpha1.HorizontalScalingOpsType:
+		err = r.applyHorizontalScaling(ctx, tenant, ops.Spec.ServiceName, ops.Spec.HorizontalScaling)
+	case tenantv1alpha1.VolumeExpansionOpsType:
+		err = r.applyVolumeExpansion(ctx, tenant, ops.Spec.VolumeExpansion)
+	case tenantv1alpha1.ReconfigureOpsType:
+		err = r.applyReconfigure(ctx, tenant, ops.Spec.ServiceName, ops.Spec.Reconfigure)
+	case tenantv1alpha1.VersionUpgradeOpsType:
+		err = r.applyVersionUpgrade(ctx, tenant, ops.Spec.ServiceName, ops.Spec.VersionUpgrade)
+	case tenantv1alpha1.RestartOpsType:
+		err = r.applyRestart(ctx, tenant, ops.Spec.ServiceName)
+	case tenantv1alpha1.SwitchoverOpsType:
+		err = r.applySwitchover(ctx, tenant, ops.Spec.Switchover)
+	case tenantv1alpha1.ExposeOpsType:
+		err = r.applyExpose(ctx, tenant, ops.Spec.Expose)
+	default:
+		err = fmt.Errorf("unsupported ops request type %q", ops.Spec.Type)
+	}
+
+	progress := tenantv1alpha1.OpsComponentProgress{ServiceName: ops.Spec.ServiceName}
+	if err != nil {
+		progress.Status = "Failed"
+		progress.Message = err.Error()
+	} else {
+		progress.Status = "Succeed"
+	}
+	ops.Status.ComponentProgress = append(ops.Status.ComponentProgress, progress)
+	return err
+}
+
+// applyVerticalScaling changes tenant.Spec.Resources directly: this tree's
+// serviceDeployment renders every service's Deployment from that single
+// tenant-wide Resources spec rather than a per-service one, so there's no
+// per-component resource field for ServiceName to select between -- the
+// field exists on the request purely to say which component's rollout
+// triggered the request, not to scope the change.
+func (r *TenantOpsRequestReconciler) applyVerticalScaling(ctx context.Context, tenant *tenantv1alpha1.Tenant, v *tenantv1alpha1.VerticalScalingOpsSpec) error {
+	if v.CPU.Request != "" {
+		tenant.Spec.Resources.CPU.Request = v.CPU.Request
+	}
+	if v.CPU.Limit != "" {
+		tenant.Spec.Resources.CPU.Limit = v.CPU.Limit
+	}
+	if v.Memory.Request != "" {
+		tenant.Spec.Resources.Memory.Request = v.Memory.Request
+	}
+	if v.Memory.Limit != "" {
+		tenant.Spec.Resources.Memory.Limit = v.Memory.Limit
+	}
+	return r.Update(ctx, tenant)
+}
+
+// applyHorizontalScaling sets serviceName's replica count; the next
+// TenantReconciler pass picks it up through the normal spec-hash diff.
+func (r *TenantOpsRequestReconciler) applyHorizontalScaling(ctx context.Context, tenant *tenantv1alpha1.Tenant, serviceName string, h *tenantv1alpha1.HorizontalScalingOpsSpec) error {
+	for i, svc := range tenant.Spec.Services {
+		if svc.Name == serviceName {
+			tenant.Spec.Services[i].Replicas = h.Replicas
+			return r.Update(ctx, tenant)
+		}
+	}
+	return fmt.Errorf("service %q not found in tenant %q", serviceName, tenant.Name)
+}
+
+// applyVolumeExpansion grows the tenant's shared database storage size.
+func (r *TenantOpsRequestReconciler) applyVolumeExpansion(ctx context.Context, tenant *tenantv1alpha1.Tenant, v *tenantv1alpha1.VolumeExpansionOpsSpec) error {
+	tenant.Spec.Resources.Storage.Size = v.Storage
+	return r.Update(ctx, tenant)
+}
+
+// applyReconfigure merges new Config entries into serviceName's existing Config.
+func (r *TenantOpsRequestReconciler) applyReconfigure(ctx context.Context, tenant *tenantv1alpha1.Tenant, serviceName string, rc *tenantv1alpha1.ReconfigureOpsSpec) error {
+	for i, svc := range tenant.Spec.Services {
+		if svc.Name != serviceName {
+			continue
+		}
+		if tenant.Spec.Services[i].Config == nil {
+			tenant.Spec.Services[i].Config = map[string]string{}
+		}
+		for k, v := range rc.Config {
+			tenant.Spec.Services[i].Config[k] = v
+		}
+		return r.Update(ctx, tenant)
+	}
+	return fmt.Errorf("service %q not found in tenant %q", serviceName, tenant.Name)
+}
+
+// applyVersionUpgrade bumps serviceName's Version, recording it to
+// UpgradeHistory the same way TenantOperationReconciler.applyUpgrade does,
+// so both paths into a version bump play through the same rollback record.
+func (r *TenantOpsRequestReconciler) applyVersionUpgrade(ctx context.Context, tenant *tenantv1alpha1.Tenant, serviceName string, vu *tenantv1alpha1.VersionUpgradeOpsSpec) error {
+	record := tenantv1alpha1.UpgradeRecord{
+		Timestamp:        metav1.Now(),
+		Strategy:         "rolling",
+		PreviousVersions: map[string]string{},
+		Services:         []string{serviceName},
+	}
+	found := false
+	for i, svc := range tenant.Spec.Services {
+		if svc.Name == serviceName {
+			record.PreviousVersions[serviceName] = svc.Version
+			tenant.Spec.Services[i].Version = vu.Version
+			found = true
+		}
+	}
+	if !found {
+		return fmt.Errorf("service %q not found in tenant %q", serviceName, tenant.Name)
+	}
+	if err := r.Update(ctx, tenant); err != nil {
+		return fmt.Errorf("failed to apply version upgrade: %w", err)
+	}
+	tenant.Status.UpgradeHistory = append(tenant.Status.UpgradeHistory, record)
+	return r.Status().Update(ctx, tenant)
+}
+
+// applyRestart rolls serviceName's Deployment the same way `kubectl rollout
+// restart` does, without touching TenantSpec at all.
+func (r *TenantOpsRequestReconciler) applyRestart(ctx context.Context, tenant *tenantv1alpha1.Tenant, serviceName string) error {
+	found := false
+	for _, svc := range tenant.Spec.Services {
+		if svc.Name == serviceName {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return fmt.Errorf("service %q not found in tenant %q", serviceName, tenant.Name)
+	}
+	deploy := &appsv1.Deployment{}
+	key := types.NamespacedName{Name: fmt.Sprintf("%s-%s", tenant.Name, serviceName), Namespace: fmt.Sprintf("tenant-%s", tenant.Name)}
+	if err := r.Get(ctx, key, deploy); err != nil {
+		return fmt.Errorf("failed to get deployment %q: %w", key.Name, err)
+	}
+	if deploy.Spec.Template.Annotations == nil {
+		deploy.Spec.Template.Annotations = map[string]string{}
+	}
+	deploy.Spec.Template.Annotations[restartedAtAnnotation] = time.Now().Format(time.RFC3339)
+	return r.Update(ctx, deploy)
+}
+
+// applySwitchover records a failover request on the tenant's HA database
+// StatefulSet for Patroni (or whatever watches switchoverRequestedAnnotation
+// in this cluster) to act on.
+func (r *TenantOpsRequestReconciler) applySwitchover(ctx context.Context, tenant *tenantv1alpha1.Tenant, sw *tenantv1alpha1.SwitchoverOpsSpec) error {
+	sts := &appsv1.StatefulSet{}
+	key := types.NamespacedName{Name: fmt.Sprintf("%s-db", tenant.Name), Namespace: fmt.Sprintf("tenant-%s", tenant.Name)}
+	if err := r.Get(ctx, key, sts); err != nil {
+		return fmt.Errorf("failed to get database statefulset %q: %w", key.Name, err)
+	}
+	if sts.Annotations == nil {
+		sts.Annotations = map[string]string{}
+	}
+	sts.Annotations[switchoverRequestedAnnotation] = time.Now().Format(time.RFC3339)
+	if sw.NewPrimary != "" {
+		sts.Annotations[switchoverTargetAnnotation] = sw.NewPrimary
+	} else {
+		delete(sts.Annotations, switchoverTargetAnnotation)
+	}
+	return r.Update(ctx, sts)
+}
+
+// applyExpose appends new Domains, skipping any already present.
+func (r *TenantOpsRequestReconciler) applyExpose(ctx context.Context, tenant *tenantv1alpha1.Tenant, e *tenantv1alpha1.ExposeOpsSpec) error {
+	existing := make(map[string]bool, len(tenant.Spec.Domains))
+	for _, d := range tenant.Spec.Domains {
+		existing[d] = true
+	}
+	for _, d := range e.Domains {
+		if !existing[d] {
+			tenant.Spec.Domains = append(tenant.Spec.Domains, d)
+			existing[d] = true
+		}
+	}
+	return r.Update(ctx, tenant)
+}
+
+// cancel marks ops Cancelled without applying anything; valid any time
+// before the request has started mutating (Phase is empty, Pending, or
+// Creating), matching KubeBlocks' "cancel before it runs" semantics.
+func (r *TenantOpsRequestReconciler) cancel(ctx context.Context, ops *tenantv1alpha1.TenantOpsRequest) (ctrl.Result, error) {
+	ops.Status.Phase = tenantv1alpha1.OpsPhaseCancelled
+	ops.Status.CompletionTime = &metav1.Time{Time: time.Now()}
+	meta.SetStatusCondition(&ops.Status.Conditions, metav1.Condition{
+		Type:    tenantv1alpha1.OpsConditionTypeCancelled,
+		Status:  metav1.ConditionTrue,
+		Reason:  "Cancelled",
+		Message: "cancelled before it started applying",
+	})
+	r.EventRecorder.Event(ops, corev1.EventTypeNormal, "OpsRequestCancelled",
+		fmt.Sprintf("%s request against tenant %q cancelled", ops.Spec.Type, ops.Spec.TenantRef))
+	return ctrl.Result{}, r.Status().Update(ctx, ops)
+}
+
+// fail marks ops Failed with message.
+func (r *TenantOpsRequestReconciler) fail(ctx context.Context, ops *tenantv1alpha1.TenantOpsRequest, message string) (ctrl.Result, error) {
+	ops.Status.Phase = tenantv1alpha1.OpsPhaseFailed
+	ops.Status.CompletionTime = &metav1.Time{Time: time.Now()}
+	meta.SetStatusCondition(&ops.Status.Conditions, metav1.Condition{
+		Type:    tenantv1alpha1.OpsConditionTypeProgressing,
+		Status:  metav1.ConditionFalse,
+		Reason:  "Failed",
+		Message: message,
+	})
+	meta.SetStatusCondition(&ops.Status.Conditions, metav1.Condition{
+		Type:    tenantv1alpha1.OpsConditionTypeFailed,
+		Status:  metav1.ConditionTrue,
+		Reason:  "Failed",
+		Message: message,
+	})
+	r.EventRecorder.Event(ops, corev1.EventTypeWarning, "OpsRequestFailed", message)
+	return ctrl.Result{}, r.Status().Update(ctx, ops)
+}
+
+func (r *TenantOpsRequestReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&tenantv1alpha1.TenantOpsRequest{}).
+		Complete(r)
+}
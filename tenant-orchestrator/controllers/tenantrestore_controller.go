@@ -0,0 +1,176 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	tenantv1alpha1 "github.com/rezenkai/multi-saas-crm/tenant-orchestrator/api/v1alpha1"
+	"github.com/rezenkai/multi-saas-crm/tenant-orchestrator/pkg/backup"
+	"github.com/rezenkai/multi-saas-crm/tenant-orchestrator/pkg/blueprint"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// TenantRestoreReconciler reconciles a TenantRestore object
+type TenantRestoreReconciler struct {
+	client.Client
+	Scheme        *runtime.Scheme
+	EventRecorder record.EventRecorder
+}
+
+// +kubebuilder:rbac:groups=tenant.rezenkai.com,resources=tenantrestores,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=tenant.rezenkai.com,resources=tenantrestores/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=tenant.rezenkai.com,resources=blueprints,verbs=get;list;watch
+// +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;update;patch;delete
+
+func (r *TenantRestoreReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := log.FromContext(ctx).WithValues("tenantrestore", req.NamespacedName)
+
+	restore := &tenantv1alpha1.TenantRestore{}
+	if err := r.Get(ctx, req.NamespacedName, restore); err != nil {
+		if errors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	if !restore.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	tenant := &tenantv1alpha1.Tenant{}
+	if err := r.Get(ctx, types.NamespacedName{Name: restore.Spec.TenantRef, Namespace: restore.Namespace}, tenant); err != nil {
+		restore.Status.Phase = tenantv1alpha1.TenantBackupPhaseFailed
+		restore.Status.Message = fmt.Sprintf("tenant %q not found: %v", restore.Spec.TenantRef, err)
+		return ctrl.Result{}, r.Status().Update(ctx, restore)
+	}
+
+	sourceBackup := &tenantv1alpha1.TenantBackup{}
+	if err := r.Get(ctx, types.NamespacedName{Name: restore.Spec.SourceBackupRef, Namespace: restore.Namespace}, sourceBackup); err != nil {
+		restore.Status.Phase = tenantv1alpha1.TenantBackupPhaseFailed
+		restore.Status.Message = fmt.Sprintf("source backup %q not found: %v", restore.Spec.SourceBackupRef, err)
+		return ctrl.Result{}, r.Status().Update(ctx, restore)
+	}
+
+	job, err := r.buildRestoreJob(ctx, tenant, sourceBackup, restore)
+	if err != nil {
+		restore.Status.Phase = tenantv1alpha1.TenantBackupPhaseFailed
+		restore.Status.Message = fmt.Sprintf("failed to render restore Job: %v", err)
+		return ctrl.Result{}, r.Status().Update(ctx, restore)
+	}
+	job.Name = fmt.Sprintf("%s-restore-%s", tenant.Name, restore.Name)
+	job.Namespace = restore.Namespace
+	if err := controllerutil.SetControllerReference(restore, job, r.Scheme); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	found := &batchv1.Job{}
+	err = r.Get(ctx, types.NamespacedName{Name: job.Name, Namespace: job.Namespace}, found)
+	if errors.IsNotFound(err) {
+		log.Info("Creating restore Job", "name", job.Name)
+		if err := r.Create(ctx, job); err != nil {
+			return ctrl.Result{}, err
+		}
+		restore.Status.Phase = tenantv1alpha1.TenantBackupPhaseRunning
+		restore.Status.StartTime = &metav1.Time{Time: time.Now()}
+		restore.Status.Progress = 0
+		r.EventRecorder.Event(restore, corev1.EventTypeNormal, "RestoreStarted", fmt.Sprintf("Restore Job %s created", job.Name))
+		if err := r.Status().Update(ctx, restore); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{RequeueAfter: 10 * time.Second}, nil
+	} else if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	switch {
+	case found.Status.Succeeded > 0:
+		if restore.Status.Phase == tenantv1alpha1.TenantBackupPhaseCompleted {
+			return ctrl.Result{}, nil
+		}
+		restore.Status.Phase = tenantv1alpha1.TenantBackupPhaseCompleted
+		restore.Status.Progress = 100
+		restore.Status.CompletionTime = &metav1.Time{Time: time.Now()}
+		r.EventRecorder.Event(restore, corev1.EventTypeNormal, "RestoreCompleted", "Restore Job completed successfully")
+		return ctrl.Result{}, r.Status().Update(ctx, restore)
+	case found.Status.Failed > 0:
+		if restore.Status.Phase == tenantv1alpha1.TenantBackupPhaseFailed {
+			return ctrl.Result{}, nil
+		}
+		restore.Status.Phase = tenantv1alpha1.TenantBackupPhaseFailed
+		restore.Status.Message = "restore Job failed, see Job events for details"
+		r.EventRecorder.Event(restore, corev1.EventTypeWarning, "RestoreFailed", restore.Status.Message)
+		return ctrl.Result{}, r.Status().Update(ctx, restore)
+	default:
+		return ctrl.Result{RequeueAfter: 10 * time.Second}, nil
+	}
+}
+
+// buildRestoreJob renders the restore Job for restore. Mirrors
+// TenantBackupReconciler.buildBackupJob's choice between the Blueprint and
+// free-function paths.
+func (r *TenantRestoreReconciler) buildRestoreJob(ctx context.Context, tenant *tenantv1alpha1.Tenant, sourceBackup *tenantv1alpha1.TenantBackup, restore *tenantv1alpha1.TenantRestore) (*batchv1.Job, error) {
+	dbType := tenant.Spec.Database.Type
+	if tenant.Spec.Database.BlueprintRef == "" && (dbType == "postgres" || dbType == "mysql") {
+		return buildRestoreJob(tenant, sourceBackup.Name, sourceBackup.Spec, restore.Spec.PointInTime), nil
+	}
+
+	bp, err := r.lookupBlueprint(ctx, tenant)
+	if err != nil {
+		return nil, err
+	}
+	rc := blueprint.RenderContext{
+		Tenant:     blueprint.TenantContext{Name: tenant.Name, Namespace: tenant.Namespace},
+		Database:   databaseContext(tenant),
+		ArtifactID: sourceBackup.Name,
+	}
+	if restore.Spec.PointInTime != nil {
+		rc.PointInTime = restore.Spec.PointInTime.Format(time.RFC3339)
+	}
+	labels := map[string]string{"app": "restore", "tenant": tenant.Name}
+	job, err := blueprint.Render(bp, tenantv1alpha1.BlueprintActionRestore, fmt.Sprintf("%s-restore-%s", tenant.Name, restore.Name), restore.Namespace, rc, labels)
+	if err != nil {
+		return nil, err
+	}
+	// Mirrors TenantBackupReconciler.buildBackupJob: a built-in blueprint's
+	// restore phase reads its artifact from /backup on the shared
+	// backup-vol, so fetch it there with the same downloader sidecar the
+	// postgres/mysql free-function Job builder uses, before any phase runs.
+	if tenant.Spec.Database.BlueprintRef == "" {
+		localPath := fmt.Sprintf("/backup/%s.%s", sourceBackup.Name, builtinArtifactExt(tenant.Spec.Database.Type))
+		objectKey := backup.ObjectKey(sourceBackup.Spec.Prefix, tenant.Name, sourceBackup.Name, builtinArtifactExt(tenant.Spec.Database.Type))
+		downloader := backup.BuildDownloadContainer(backup.Backend(sourceBackup.Spec.StorageBackend), sourceBackup.Spec.Bucket, objectKey, localPath)
+		job.Spec.Template.Spec.InitContainers = append([]corev1.Container{downloader}, job.Spec.Template.Spec.InitContainers...)
+	}
+	return job, nil
+}
+
+// lookupBlueprint resolves tenant's Blueprint: the one named by BlueprintRef
+// if set, otherwise the built-in matching its database engine.
+func (r *TenantRestoreReconciler) lookupBlueprint(ctx context.Context, tenant *tenantv1alpha1.Tenant) (*tenantv1alpha1.Blueprint, error) {
+	if tenant.Spec.Database.BlueprintRef == "" {
+		return blueprint.Builtin(tenant.Spec.Database.Type, tenant.Name)
+	}
+	bp := &tenantv1alpha1.Blueprint{}
+	if err := r.Get(ctx, types.NamespacedName{Name: tenant.Spec.Database.BlueprintRef, Namespace: tenant.Namespace}, bp); err != nil {
+		return nil, fmt.Errorf("looking up blueprint %q: %w", tenant.Spec.Database.BlueprintRef, err)
+	}
+	return bp, nil
+}
+
+func (r *TenantRestoreReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&tenantv1alpha1.TenantRestore{}).
+		Owns(&batchv1.Job{}).
+		Complete(r)
+}
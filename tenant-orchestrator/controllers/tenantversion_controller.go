@@ -0,0 +1,85 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	tenantv1alpha1 "github.com/rezenkai/multi-saas-crm/tenant-orchestrator/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// TenantVersionReconciler reflects whether a TenantVersion's DefinitionRef
+// actually resolves and declares every component the TenantVersion lists,
+// so a Tenant's resolveComponent call can trust a "Ready" TenantVersion
+// instead of re-deriving this check per reconcile.
+type TenantVersionReconciler struct {
+	client.Client
+	Scheme        *runtime.Scheme
+	EventRecorder record.EventRecorder
+}
+
+// +kubebuilder:rbac:groups=tenant.rezenkai.com,resources=tenantversions,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=tenant.rezenkai.com,resources=tenantversions/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=tenant.rezenkai.com,resources=tenantdefinitions,verbs=get;list;watch
+
+func (r *TenantVersionReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	ver := &tenantv1alpha1.TenantVersion{}
+	if err := r.Get(ctx, req.NamespacedName, ver); err != nil {
+		if errors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	def := &tenantv1alpha1.TenantDefinition{}
+	err := r.Get(ctx, types.NamespacedName{Name: ver.Spec.DefinitionRef}, def)
+	switch {
+	case errors.IsNotFound(err):
+		ver.Status.Phase = "Failed"
+		ver.Status.Message = fmt.Sprintf("definitionRef %q not found", ver.Spec.DefinitionRef)
+		r.EventRecorder.Event(ver, "Warning", "DefinitionMissing", ver.Status.Message)
+	case err != nil:
+		return ctrl.Result{}, err
+	default:
+		if missing := missingComponents(def, ver); missing != "" {
+			ver.Status.Phase = "Failed"
+			ver.Status.Message = fmt.Sprintf("definitionRef %q has no component named %q", ver.Spec.DefinitionRef, missing)
+			r.EventRecorder.Event(ver, "Warning", "ComponentMissing", ver.Status.Message)
+		} else {
+			ver.Status.Phase = "Ready"
+			ver.Status.Message = ""
+		}
+	}
+
+	if err := r.Status().Update(ctx, ver); err != nil {
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{RequeueAfter: 5 * time.Minute}, nil
+}
+
+// missingComponents returns the name of the first ComponentVersion in ver
+// that has no matching ComponentDefinition in def, or "" if all match.
+func missingComponents(def *tenantv1alpha1.TenantDefinition, ver *tenantv1alpha1.TenantVersion) string {
+	known := make(map[string]bool, len(def.Spec.Components))
+	for _, c := range def.Spec.Components {
+		known[c.Name] = true
+	}
+	for _, c := range ver.Spec.Components {
+		if !known[c.Name] {
+			return c.Name
+		}
+	}
+	return ""
+}
+
+func (r *TenantVersionReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&tenantv1alpha1.TenantVersion{}).
+		Complete(r)
+}
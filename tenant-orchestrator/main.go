@@ -3,15 +3,20 @@ package main
 import (
 	"flag"
 	"os"
+	"strings"
 	"time"
 
 	tenantv1alpha1 "github.com/rezenkai/multi-saas-crm/tenant-orchestrator/api/v1alpha1"
+	tenantv1beta1 "github.com/rezenkai/multi-saas-crm/tenant-orchestrator/api/v1beta1"
 	"github.com/rezenkai/multi-saas-crm/tenant-orchestrator/controllers"
 	"github.com/rezenkai/multi-saas-crm/tenant-orchestrator/pkg/discovery"
 	"github.com/rezenkai/multi-saas-crm/tenant-orchestrator/pkg/health"
+	"github.com/rezenkai/multi-saas-crm/tenant-orchestrator/pkg/ingress"
+	"github.com/rezenkai/multi-saas-crm/tenant-orchestrator/pkg/scheduler"
 	"go.uber.org/zap"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/kubernetes"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -28,6 +33,7 @@ var (
 func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 	utilruntime.Must(tenantv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(tenantv1beta1.AddToScheme(scheme))
 }
 
 func main() {
@@ -36,12 +42,63 @@ func main() {
 	var probeAddr string
 	var webhookPort int
 	var syncPeriod time.Duration
+	var defaultIngressProvider string
+	var allowedMiddlewareNamespaces string
+	var discoveryResyncPeriod time.Duration
+	var discoveryWorkerCount int
+	var discoveryWatchNamespaces string
+	var discoveryRegistryBackends string
+	var discoveryRegistryTTL time.Duration
+	var discoveryConsulAddress string
+	var discoveryEtcdEndpoints string
+	var discoveryMDNSDomain string
+	var discoveryHealthInterval time.Duration
+	var discoveryHealthConcurrency int
+	var discoveryHealthConsecutiveFailures int
+	var discoveryHealthWindow time.Duration
+	var discoveryHealthBaseEjectionTime time.Duration
+	var discoveryHealthMaxEjectionTime time.Duration
+	var discoveryWatchBindAddress string
 	flag.StringVar(&metricsAddr, "metrics-bind-address", ":8080", "The address the metric endpoint binds to.")
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
 	flag.BoolVar(&enableLeaderElection, "leader-elect", false,
 		"Enable leader election for controller manager. Enabling this will ensure there is only one active controller manager.")
 	flag.IntVar(&webhookPort, "webhook-port", 9443, "Webhook server port")
 	flag.DurationVar(&syncPeriod, "sync-period", 30*time.Second, "Sync period for controllers")
+	flag.StringVar(&defaultIngressProvider, "default-ingress-provider", ingress.DefaultProviderName,
+		"Ingress provider (nginx, traefik-crd, apisix, kourier) used for tenants that don't set Spec.Ingress.Provider")
+	flag.StringVar(&allowedMiddlewareNamespaces, "allowed-middleware-namespaces", "",
+		"Comma-separated namespaces, beyond a tenant's own, a Spec.Middlewares externalRef may point a Traefik Middleware at")
+	flag.DurationVar(&discoveryResyncPeriod, "discovery-resync-period", 10*time.Minute,
+		"How often the service discovery informer subsystem replays its full cached state")
+	flag.IntVar(&discoveryWorkerCount, "discovery-worker-count", 2,
+		"Number of workers draining the service discovery informer subsystem's workqueue")
+	flag.StringVar(&discoveryWatchNamespaces, "discovery-watch-namespaces", "",
+		"Comma-separated namespaces the service discovery informer subsystem watches; empty watches every namespace")
+	flag.StringVar(&discoveryRegistryBackends, "discovery-registry-backends", "",
+		"Comma-separated external registrars (consul, etcd, mdns) to mirror discovered endpoints into; empty keeps only the JSON ConfigMap")
+	flag.DurationVar(&discoveryRegistryTTL, "discovery-registry-ttl", 30*time.Second,
+		"Health-check/lease TTL passed to external registrar backends")
+	flag.StringVar(&discoveryConsulAddress, "discovery-consul-address", "",
+		"Consul agent address for the consul registrar backend; empty uses the Consul client's default")
+	flag.StringVar(&discoveryEtcdEndpoints, "discovery-etcd-endpoints", "",
+		"Comma-separated etcd endpoints for the etcd registrar backend")
+	flag.StringVar(&discoveryMDNSDomain, "discovery-mdns-domain", "local.",
+		"mDNS domain for the mdns registrar backend")
+	flag.DurationVar(&discoveryHealthInterval, "discovery-health-interval", 30*time.Second,
+		"How often the service discovery health checker probes every known endpoint")
+	flag.IntVar(&discoveryHealthConcurrency, "discovery-health-concurrency", 10,
+		"Number of endpoints the service discovery health checker probes concurrently")
+	flag.IntVar(&discoveryHealthConsecutiveFailures, "discovery-health-consecutive-failures", 3,
+		"Consecutive failed health checks within discovery-health-window before an endpoint is ejected")
+	flag.DurationVar(&discoveryHealthWindow, "discovery-health-window", 2*time.Minute,
+		"Sliding time window consecutive health check failures are counted over")
+	flag.DurationVar(&discoveryHealthBaseEjectionTime, "discovery-health-base-ejection-time", 30*time.Second,
+		"How long an endpoint is ejected for after its first outlier detection; doubles on each subsequent ejection")
+	flag.DurationVar(&discoveryHealthMaxEjectionTime, "discovery-health-max-ejection-time", 5*time.Minute,
+		"Upper bound on the exponential ejection backoff applied by the service discovery health checker")
+	flag.StringVar(&discoveryWatchBindAddress, "discovery-watch-bind-address", ":8090",
+		"Bind address for the service discovery watch server's /v1/discovery/watch endpoint")
 	opts := zap.Options{Development: true}
 	opts.BindFlags(flag.CommandLine)
 	flag.Parse()
@@ -64,24 +121,106 @@ func main() {
 	}
 
 	// Initialize service discovery
-	discoveryClient := discovery.NewClient(mgr.GetClient())
+	var discoveryNamespaces []string
+	if discoveryWatchNamespaces != "" {
+		discoveryNamespaces = strings.Split(discoveryWatchNamespaces, ",")
+	}
+	var registryBackends []string
+	if discoveryRegistryBackends != "" {
+		registryBackends = strings.Split(discoveryRegistryBackends, ",")
+	}
+	var etcdEndpoints []string
+	if discoveryEtcdEndpoints != "" {
+		etcdEndpoints = strings.Split(discoveryEtcdEndpoints, ",")
+	}
+	registrar, err := discovery.NewRegistrarFromConfig(discovery.RegistryConfig{
+		Backends:      registryBackends,
+		TTL:           discoveryRegistryTTL,
+		ConsulAddress: discoveryConsulAddress,
+		EtcdEndpoints: etcdEndpoints,
+		MDNSDomain:    discoveryMDNSDomain,
+	})
+	if err != nil {
+		setupLog.Error(err, "unable to build service discovery registrar")
+		os.Exit(1)
+	}
+	discoveryClient := discovery.NewClient(mgr.GetClient(),
+		discovery.WithResyncPeriod(discoveryResyncPeriod),
+		discovery.WithWorkerCount(discoveryWorkerCount),
+		discovery.WithWatchNamespaces(discoveryNamespaces...),
+		discovery.WithRegistrar(registrar),
+	)
+	discoveryClientset, err := kubernetes.NewForConfig(mgr.GetConfig())
+	if err != nil {
+		setupLog.Error(err, "unable to create clientset for service discovery informers")
+		os.Exit(1)
+	}
+	discoveryClient.EnableInformers(discoveryClientset)
+	if err := mgr.Add(discoveryClient); err != nil {
+		setupLog.Error(err, "unable to register service discovery informer subsystem")
+		os.Exit(1)
+	}
+
+	// Initialize the service discovery health checker, which probes every
+	// known endpoint on a fixed interval and ejects outliers after repeated
+	// consecutive failures.
+	discoveryHealthChecker := discovery.NewHealthChecker(discoveryClient, discovery.HealthCheckerOptions{
+		Interval:            discoveryHealthInterval,
+		Concurrency:         discoveryHealthConcurrency,
+		ConsecutiveFailures: discoveryHealthConsecutiveFailures,
+		Window:              discoveryHealthWindow,
+		BaseEjectionTime:    discoveryHealthBaseEjectionTime,
+		MaxEjectionTime:     discoveryHealthMaxEjectionTime,
+	})
+	if err := mgr.Add(discoveryHealthChecker); err != nil {
+		setupLog.Error(err, "unable to register service discovery health checker")
+		os.Exit(1)
+	}
+
+	// Initialize the service discovery watch server, letting sidecars and
+	// API gateways subscribe to tenant service changes over HTTP instead of
+	// polling the discovery ConfigMap.
+	discoveryWatchServer := &discovery.WatchServer{
+		Addr:      discoveryWatchBindAddress,
+		Discovery: discoveryClient,
+	}
+	if err := mgr.Add(discoveryWatchServer); err != nil {
+		setupLog.Error(err, "unable to register service discovery watch server")
+		os.Exit(1)
+	}
 
 	// Initialize health monitor
 	healthMonitor := health.NewMonitor(mgr.GetClient())
 
+	// Initialize the maintenance job scheduler
+	jobScheduler := scheduler.NewScheduler(mgr.GetClient())
+	if err := mgr.Add(jobScheduler); err != nil {
+		setupLog.Error(err, "unable to register scheduler")
+		os.Exit(1)
+	}
+
+	var middlewareNamespaces []string
+	if allowedMiddlewareNamespaces != "" {
+		middlewareNamespaces = strings.Split(allowedMiddlewareNamespaces, ",")
+	}
+
 	// Setup Tenant controller
 	if err = (&controllers.TenantReconciler{
-		Client:        mgr.GetClient(),
-		Scheme:        mgr.GetScheme(),
-		Discovery:     discoveryClient,
-		HealthMonitor: healthMonitor,
-		EventRecorder: mgr.GetEventRecorderFor("tenant-controller"),
+		Client:                      mgr.GetClient(),
+		Scheme:                      mgr.GetScheme(),
+		Discovery:                   discoveryClient,
+		HealthMonitor:               healthMonitor,
+		Scheduler:                   jobScheduler,
+		EventRecorder:               mgr.GetEventRecorderFor("tenant-controller"),
+		DefaultIngressProvider:      defaultIngressProvider,
+		AllowedMiddlewareNamespaces: middlewareNamespaces,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Tenant")
 		os.Exit(1)
 	}
 
-	// Setup ServiceWatcher controller
+	// Setup ServiceWatcher controller (fallback path alongside the informer
+	// subsystem enabled above)
 	if err = (&discovery.ServiceWatcher{
 		Discovery: discoveryClient,
 	}).SetupWithManager(mgr); err != nil {
@@ -89,6 +228,107 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Setup TenantBackup controller
+	if err = (&controllers.TenantBackupReconciler{
+		Client:        mgr.GetClient(),
+		Scheme:        mgr.GetScheme(),
+		EventRecorder: mgr.GetEventRecorderFor("tenantbackup-controller"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "TenantBackup")
+		os.Exit(1)
+	}
+
+	// Setup TenantRestore controller
+	if err = (&controllers.TenantRestoreReconciler{
+		Client:        mgr.GetClient(),
+		Scheme:        mgr.GetScheme(),
+		EventRecorder: mgr.GetEventRecorderFor("tenantrestore-controller"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "TenantRestore")
+		os.Exit(1)
+	}
+
+	// Setup TenantOperation controller
+	if err = (&controllers.TenantOperationReconciler{
+		Client:        mgr.GetClient(),
+		Scheme:        mgr.GetScheme(),
+		EventRecorder: mgr.GetEventRecorderFor("tenantoperation-controller"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "TenantOperation")
+		os.Exit(1)
+	}
+
+	// Setup TenantOpsRequest controller
+	if err = (&controllers.TenantOpsRequestReconciler{
+		Client:        mgr.GetClient(),
+		Scheme:        mgr.GetScheme(),
+		EventRecorder: mgr.GetEventRecorderFor("tenantopsrequest-controller"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "TenantOpsRequest")
+		os.Exit(1)
+	}
+
+	// Setup Datastore controller
+	if err = (&controllers.DatastoreReconciler{
+		Client:        mgr.GetClient(),
+		Scheme:        mgr.GetScheme(),
+		EventRecorder: mgr.GetEventRecorderFor("datastore-controller"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "Datastore")
+		os.Exit(1)
+	}
+
+	// Setup BackupRepo controller
+	if err = (&controllers.BackupRepoReconciler{
+		Client:        mgr.GetClient(),
+		Scheme:        mgr.GetScheme(),
+		EventRecorder: mgr.GetEventRecorderFor("backuprepo-controller"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "BackupRepo")
+		os.Exit(1)
+	}
+
+	// Setup BackupSchedule controller
+	if err = (&controllers.BackupScheduleReconciler{
+		Client:        mgr.GetClient(),
+		Scheme:        mgr.GetScheme(),
+		EventRecorder: mgr.GetEventRecorderFor("backupschedule-controller"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "BackupSchedule")
+		os.Exit(1)
+	}
+
+	// Setup TenantDefinition controller
+	if err = (&controllers.TenantDefinitionReconciler{
+		Client:        mgr.GetClient(),
+		Scheme:        mgr.GetScheme(),
+		EventRecorder: mgr.GetEventRecorderFor("tenantdefinition-controller"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "TenantDefinition")
+		os.Exit(1)
+	}
+
+	// Setup TenantVersion controller
+	if err = (&controllers.TenantVersionReconciler{
+		Client:        mgr.GetClient(),
+		Scheme:        mgr.GetScheme(),
+		EventRecorder: mgr.GetEventRecorderFor("tenantversion-controller"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "TenantVersion")
+		os.Exit(1)
+	}
+
+	// Setup ClusterRegistration controller
+	if err = (&controllers.ClusterRegistrationReconciler{
+		Client:        mgr.GetClient(),
+		Scheme:        mgr.GetScheme(),
+		Discovery:     discoveryClient,
+		EventRecorder: mgr.GetEventRecorderFor("clusterregistration-controller"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "ClusterRegistration")
+		os.Exit(1)
+	}
+
 	// Setup webhooks
 	if err = (&tenantv1alpha1.Tenant{}).SetupWebhookWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create webhook", "webhook", "Tenant")
@@ -0,0 +1,35 @@
+package backup
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/bloberror"
+)
+
+// azureStore deletes backup artifacts from Azure Blob Storage.
+type azureStore struct {
+	client *azblob.Client
+}
+
+func newAzureStore() (*azureStore, error) {
+	connStr := os.Getenv("AZURE_STORAGE_CONNECTION_STRING")
+	if connStr == "" {
+		return nil, fmt.Errorf("AZURE_STORAGE_CONNECTION_STRING is not set")
+	}
+	client, err := azblob.NewClientFromConnectionString(connStr, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Azure Blob client: %w", err)
+	}
+	return &azureStore{client: client}, nil
+}
+
+func (a *azureStore) Delete(ctx context.Context, bucket, key string) error {
+	_, err := a.client.DeleteBlob(ctx, bucket, key, nil)
+	if err != nil && !bloberror.HasCode(err, bloberror.BlobNotFound) {
+		return fmt.Errorf("failed to delete azure blob %s/%s: %w", bucket, key, err)
+	}
+	return nil
+}
@@ -0,0 +1,159 @@
+// Package backup provides the pluggable object-storage backends that
+// TenantBackup/TenantRestore artifacts are written to and deleted from, plus
+// the Job container specs used to stream dumps to and from those backends.
+package backup
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Backend identifies an object-storage provider a backup artifact lives in.
+type Backend string
+
+const (
+	BackendS3    Backend = "s3"
+	BackendGCS   Backend = "gcs"
+	BackendAzure Backend = "azure"
+	BackendMinIO Backend = "minio"
+)
+
+// ObjectStore deletes objects from a backup backend. Uploads and downloads of
+// the (potentially large) dump artifact happen inside the backup/restore Job
+// via the provider's own CLI, but deletion is driven directly from the
+// TenantBackupReconciler so a CR delete reliably removes the remote object.
+type ObjectStore interface {
+	// Delete removes the object at key from bucket. It must not return an
+	// error when the object is already absent.
+	Delete(ctx context.Context, bucket, key string) error
+}
+
+// NewObjectStore returns the ObjectStore implementation for backend.
+func NewObjectStore(backend Backend) (ObjectStore, error) {
+	switch backend {
+	case BackendS3, "":
+		return newS3Store()
+	case BackendGCS:
+		return newGCSStore()
+	case BackendAzure:
+		return newAzureStore()
+	case BackendMinIO:
+		return newMinIOStore()
+	default:
+		return nil, fmt.Errorf("unsupported backup backend %q", backend)
+	}
+}
+
+// ObjectKey returns the object key a backup/restore artifact for tenant is
+// stored under, honoring an optional prefix. ext is the artifact's file
+// extension without a leading dot; see ArtifactExt.
+func ObjectKey(prefix, tenant, name, ext string) string {
+	if prefix == "" {
+		return fmt.Sprintf("%s/%s.%s", tenant, name, ext)
+	}
+	return fmt.Sprintf("%s/%s/%s.%s", prefix, tenant, name, ext)
+}
+
+// ArtifactExt returns the file extension a backup artifact is stored with
+// for mode: a "physical" (pg_basebackup) artifact is a compressed tarball,
+// while "logical" (pg_dump/mysqldump, also the default for "") is plain SQL.
+func ArtifactExt(mode string) string {
+	if mode == "physical" {
+		return "tar.gz"
+	}
+	return "sql"
+}
+
+// uploaderImage pins the CLI image used to move an artifact to/from each
+// backend's bucket; BuildDownloadContainer uses it as well.
+func uploaderImage(backend Backend) string {
+	switch backend {
+	case BackendGCS:
+		return "google/cloud-sdk:slim"
+	case BackendAzure:
+		return "mcr.microsoft.com/azure-cli:latest"
+	case BackendMinIO:
+		return "minio/mc:latest"
+	default:
+		return "amazon/aws-cli:latest"
+	}
+}
+
+// BuildUploadContainer renders the container that copies localPath to the
+// given bucket/key on backend. When encryptionKeyRef is set and backend is
+// s3, the upload requests SSE-KMS with that key; the gcs, azure and minio
+// uploads do not use encryptionKeyRef.
+func BuildUploadContainer(backend Backend, bucket, key, localPath string, encryptionKeyRef *corev1.SecretKeySelector) corev1.Container {
+	c := corev1.Container{
+		Name:  "uploader",
+		Image: uploaderImage(backend),
+	}
+	switch backend {
+	case BackendGCS:
+		c.Command = []string{"gsutil", "cp", localPath, fmt.Sprintf("gs://%s/%s", bucket, key)}
+		c.Env = []corev1.EnvVar{
+			{Name: "GOOGLE_APPLICATION_CREDENTIALS", Value: "/var/secrets/gcs/key.json"},
+		}
+	case BackendAzure:
+		c.Command = []string{"az", "storage", "blob", "upload", "--container-name", bucket, "--name", key, "--file", localPath}
+		c.Env = []corev1.EnvVar{
+			{Name: "AZURE_STORAGE_CONNECTION_STRING", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "azure-credentials"}, Key: "connection-string"}}},
+		}
+	case BackendMinIO:
+		c.Command = []string{"mc", "cp", localPath, fmt.Sprintf("minio/%s/%s", bucket, key)}
+		c.Env = []corev1.EnvVar{
+			{Name: "MC_HOST_minio", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "minio-credentials"}, Key: "host-url"}}},
+		}
+	default:
+		args := []string{"s3", "cp", localPath, fmt.Sprintf("s3://%s/%s", bucket, key)}
+		if encryptionKeyRef != nil {
+			args = append(args, "--sse", "aws:kms", "--sse-kms-key-id", "$(SSE_KMS_KEY_ID)")
+		}
+		c.Command = append([]string{"aws"}, args...)
+		c.Env = []corev1.EnvVar{
+			{Name: "AWS_ACCESS_KEY_ID", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "aws-credentials"}, Key: "access-key-id"}}},
+			{Name: "AWS_SECRET_ACCESS_KEY", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "aws-credentials"}, Key: "secret-access-key"}}},
+		}
+		if encryptionKeyRef != nil {
+			c.Env = append(c.Env, corev1.EnvVar{Name: "SSE_KMS_KEY_ID", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: encryptionKeyRef}})
+		}
+	}
+	c.VolumeMounts = []corev1.VolumeMount{{Name: "backup-vol", MountPath: "/backup"}}
+	return c
+}
+
+// BuildDownloadContainer renders the container that copies bucket/key on
+// backend down to localPath.
+func BuildDownloadContainer(backend Backend, bucket, key, localPath string) corev1.Container {
+	c := corev1.Container{
+		Name:  "downloader",
+		Image: uploaderImage(backend),
+	}
+	switch backend {
+	case BackendGCS:
+		c.Command = []string{"gsutil", "cp", fmt.Sprintf("gs://%s/%s", bucket, key), localPath}
+		c.Env = []corev1.EnvVar{
+			{Name: "GOOGLE_APPLICATION_CREDENTIALS", Value: "/var/secrets/gcs/key.json"},
+		}
+	case BackendAzure:
+		c.Command = []string{"az", "storage", "blob", "download", "--container-name", bucket, "--name", key, "--file", localPath}
+		c.Env = []corev1.EnvVar{
+			{Name: "AZURE_STORAGE_CONNECTION_STRING", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "azure-credentials"}, Key: "connection-string"}}},
+		}
+	case BackendMinIO:
+		c.Command = []string{"mc", "cp", fmt.Sprintf("minio/%s/%s", bucket, key), localPath}
+		c.Env = []corev1.EnvVar{
+			{Name: "MC_HOST_minio", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "minio-credentials"}, Key: "host-url"}}},
+		}
+	default:
+		c.Command = []string{"aws", "s3", "cp", fmt.Sprintf("s3://%s/%s", bucket, key), localPath}
+		c.Env = []corev1.EnvVar{
+			{Name: "AWS_ACCESS_KEY_ID", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "aws-credentials"}, Key: "access-key-id"}}},
+			{Name: "AWS_SECRET_ACCESS_KEY", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "aws-credentials"}, Key: "secret-access-key"}}},
+		}
+	}
+	c.VolumeMounts = []corev1.VolumeMount{{Name: "backup-vol", MountPath: "/backup"}}
+	return c
+}
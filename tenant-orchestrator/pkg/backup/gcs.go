@@ -0,0 +1,30 @@
+package backup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cloud.google.com/go/storage"
+)
+
+// gcsStore deletes backup artifacts from Google Cloud Storage.
+type gcsStore struct {
+	client *storage.Client
+}
+
+func newGCSStore() (*gcsStore, error) {
+	client, err := storage.NewClient(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GCS client: %w", err)
+	}
+	return &gcsStore{client: client}, nil
+}
+
+func (g *gcsStore) Delete(ctx context.Context, bucket, key string) error {
+	err := g.client.Bucket(bucket).Object(key).Delete(ctx)
+	if err != nil && !errors.Is(err, storage.ErrObjectNotExist) {
+		return fmt.Errorf("failed to delete gcs object %s/%s: %w", bucket, key, err)
+	}
+	return nil
+}
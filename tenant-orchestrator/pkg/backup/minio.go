@@ -0,0 +1,42 @@
+package backup
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// minioStore deletes backup artifacts from a self-hosted MinIO cluster.
+type minioStore struct {
+	client *minio.Client
+}
+
+func newMinIOStore() (*minioStore, error) {
+	endpoint := os.Getenv("MINIO_ENDPOINT")
+	if endpoint == "" {
+		return nil, fmt.Errorf("MINIO_ENDPOINT is not set")
+	}
+	client, err := minio.New(endpoint, &minio.Options{
+		Creds:  credentials.NewEnvMinio(),
+		Secure: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create MinIO client: %w", err)
+	}
+	return &minioStore{client: client}, nil
+}
+
+func (m *minioStore) Delete(ctx context.Context, bucket, key string) error {
+	err := m.client.RemoveObject(ctx, bucket, key, minio.RemoveObjectOptions{})
+	if err != nil {
+		resp := minio.ToErrorResponse(err)
+		if resp.Code == "NoSuchKey" {
+			return nil
+		}
+		return fmt.Errorf("failed to delete minio object %s/%s: %w", bucket, key, err)
+	}
+	return nil
+}
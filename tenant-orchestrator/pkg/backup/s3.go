@@ -0,0 +1,37 @@
+package backup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3/types"
+)
+
+// s3Store deletes backup artifacts from S3-compatible object storage.
+type s3Store struct {
+	client *s3.Client
+}
+
+func newS3Store() (*s3Store, error) {
+	cfg, err := config.LoadDefaultConfig(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to load AWS config: %w", err)
+	}
+	return &s3Store{client: s3.NewFromConfig(cfg)}, nil
+}
+
+func (s *s3Store) Delete(ctx context.Context, bucket, key string) error {
+	_, err := s.client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	var notFound *awstypes.NoSuchKey
+	if err != nil && !errors.As(err, &notFound) {
+		return fmt.Errorf("failed to delete s3 object %s/%s: %w", bucket, key, err)
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+package blueprint
+
+import (
+	"fmt"
+
+	tenantv1alpha1 "github.com/rezenkai/multi-saas-crm/tenant-orchestrator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Builtin returns the out-of-the-box Blueprint for dbType, reproducing the
+// pg_dump/pg_restore and mysqldump/mysql behavior the controllers used to
+// hardcode, plus mssql support that previously didn't exist at all. Callers
+// fall back to this when Tenant.Spec.Database.BlueprintRef is unset.
+func Builtin(dbType, tenantName string) (*tenantv1alpha1.Blueprint, error) {
+	credentialsSecret := fmt.Sprintf("%s-db-credentials", tenantName)
+	passwordEnv := func(name string) corev1.EnvVar {
+		return corev1.EnvVar{
+			Name: name,
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: credentialsSecret},
+					Key:                  "password",
+				},
+			},
+		}
+	}
+
+	switch dbType {
+	case "postgres":
+		return &tenantv1alpha1.Blueprint{
+			Spec: tenantv1alpha1.BlueprintSpec{
+				Actions: map[tenantv1alpha1.BlueprintActionName]tenantv1alpha1.BlueprintAction{
+					tenantv1alpha1.BlueprintActionBackup: {Phases: []tenantv1alpha1.BlueprintPhase{
+						{
+							Name:    "pg-dump",
+							Image:   "postgres:{{ .Database.Version }}",
+							Command: []string{"pg_dump"},
+							Args: []string{
+								"-h", "{{ .Database.Host }}",
+								"-U", "{{ .Database.Username }}",
+								"-d", "{{ .Database.Name }}",
+								"--file", "/backup/{{ .ArtifactID }}.sql",
+							},
+							Env: []corev1.EnvVar{passwordEnv("PGPASSWORD")},
+						},
+					}},
+					tenantv1alpha1.BlueprintActionRestore: {Phases: []tenantv1alpha1.BlueprintPhase{
+						{
+							Name:    "pg-restore",
+							Image:   "postgres:{{ .Database.Version }}",
+							Command: []string{"pg_restore"},
+							Args: []string{
+								"-h", "{{ .Database.Host }}",
+								"-U", "{{ .Database.Username }}",
+								"-d", "{{ .Database.Name }}",
+								"/backup/{{ .ArtifactID }}.sql",
+							},
+							Env: []corev1.EnvVar{passwordEnv("PGPASSWORD")},
+						},
+					}},
+				},
+			},
+		}, nil
+	case "mysql":
+		return &tenantv1alpha1.Blueprint{
+			Spec: tenantv1alpha1.BlueprintSpec{
+				Actions: map[tenantv1alpha1.BlueprintActionName]tenantv1alpha1.BlueprintAction{
+					tenantv1alpha1.BlueprintActionBackup: {Phases: []tenantv1alpha1.BlueprintPhase{
+						{
+							Name:    "mysqldump",
+							Image:   "mysql:{{ .Database.Version }}",
+							Command: []string{"mysqldump"},
+							Args: []string{
+								"-h", "{{ .Database.Host }}",
+								"-u", "{{ .Database.Username }}",
+								"--databases", "{{ .Database.Name }}",
+								"--result-file", "/backup/{{ .ArtifactID }}.sql",
+							},
+							Env: []corev1.EnvVar{passwordEnv("MYSQL_PWD")},
+						},
+					}},
+					tenantv1alpha1.BlueprintActionRestore: {Phases: []tenantv1alpha1.BlueprintPhase{
+						{
+							Name:    "mysql-restore",
+							Image:   "mysql:{{ .Database.Version }}",
+							Command: []string{"mysql"},
+							Args: []string{
+								"-h", "{{ .Database.Host }}",
+								"-u", "{{ .Database.Username }}",
+								"{{ .Database.Name }}",
+							},
+							Env: []corev1.EnvVar{passwordEnv("MYSQL_PWD")},
+						},
+					}},
+				},
+			},
+		}, nil
+	case "mssql":
+		return &tenantv1alpha1.Blueprint{
+			Spec: tenantv1alpha1.BlueprintSpec{
+				Actions: map[tenantv1alpha1.BlueprintActionName]tenantv1alpha1.BlueprintAction{
+					tenantv1alpha1.BlueprintActionBackup: {Phases: []tenantv1alpha1.BlueprintPhase{
+						{
+							Name:    "sqlcmd-backup",
+							Image:   "mcr.microsoft.com/mssql-tools",
+							Command: []string{"/opt/mssql-tools/bin/sqlcmd"},
+							Args: []string{
+								"-S", "{{ .Database.Host }}",
+								"-U", "{{ .Database.Username }}",
+								"-Q", "BACKUP DATABASE [{{ .Database.Name }}] TO DISK = '/backup/{{ .ArtifactID }}.bak' WITH COMPRESSION, CHECKSUM",
+							},
+							Env: []corev1.EnvVar{passwordEnv("SQLCMDPASSWORD")},
+						},
+					}},
+					tenantv1alpha1.BlueprintActionRestore: {Phases: []tenantv1alpha1.BlueprintPhase{
+						{
+							Name:    "sqlcmd-restore",
+							Image:   "mcr.microsoft.com/mssql-tools",
+							Command: []string{"/opt/mssql-tools/bin/sqlcmd"},
+							Args: []string{
+								"-S", "{{ .Database.Host }}",
+								"-U", "{{ .Database.Username }}",
+								"-Q", "RESTORE DATABASE [{{ .Database.Name }}] FROM DISK = '/backup/{{ .ArtifactID }}.bak' WITH MOVE '{{ .Database.Name }}' TO '/var/opt/mssql/data/{{ .Database.Name }}.mdf', MOVE '{{ .Database.Name }}_log' TO '/var/opt/mssql/data/{{ .Database.Name }}_log.ldf'",
+							},
+							Env: []corev1.EnvVar{passwordEnv("SQLCMDPASSWORD")},
+						},
+					}},
+				},
+			},
+		}, nil
+	default:
+		return nil, fmt.Errorf("no built-in blueprint for database type %q; set Spec.Database.BlueprintRef", dbType)
+	}
+}
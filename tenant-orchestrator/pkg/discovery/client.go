@@ -4,7 +4,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net"
 	"net/http"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -21,15 +24,124 @@ import (
 
 // ServiceEndpoint represents a discovered service endpoint
 type ServiceEndpoint struct {
-	Service   string            `json:"service"`
-	Namespace string            `json:"namespace"`
-	Tenant    string            `json:"tenant"`
-	Address   string            `json:"address"`
-	Port      int32             `json:"port"`
-	Protocol  string            `json:"protocol"`
-	Metadata  map[string]string `json:"metadata"`
-	Health    HealthStatus      `json:"health"`
-	UpdatedAt time.Time         `json:"updatedAt"`
+	Service   string `json:"service"`
+	Namespace string `json:"namespace"`
+	Tenant    string `json:"tenant"`
+	// Address is the bare host (no brackets, even for IPv6); use
+	// joinHostPort to compose it with Port for a URL.
+	Address string `json:"address"`
+	Port    int32  `json:"port"`
+	// PortName is the named container port this endpoint was produced
+	// from (e.g. "http", "grpc"), set whenever the Service declares one.
+	PortName string `json:"portName,omitempty"`
+	// AppProtocol mirrors the port's appProtocol, e.g. "HTTP2" or
+	// "kubernetes.io/h2c", when the Service or EndpointSlice sets one.
+	AppProtocol string `json:"appProtocol,omitempty"`
+	Protocol    string `json:"protocol"`
+	// AddressFamily is "IPv4" or "IPv6", left empty if it can't be
+	// determined (e.g. an FQDN endpoint).
+	AddressFamily string            `json:"addressFamily,omitempty"`
+	Metadata      map[string]string `json:"metadata"`
+	// Version is the backing Pod's discovery.rezenkai.com/version
+	// annotation, if set, enabling canary routing on FindService.
+	Version string `json:"version,omitempty"`
+	// ServiceID is the backing Pod's discovery.rezenkai.com/id
+	// annotation, a Kratos-style stable identity for one service instance.
+	ServiceID string `json:"serviceId,omitempty"`
+	// Protocols are additional protocol endpoints the backing Pod
+	// advertises alongside Address/Port, declared via the
+	// discovery.rezenkai.com/protocols annotation.
+	Protocols []ProtocolEndpoint `json:"protocols,omitempty"`
+	Health    HealthStatus       `json:"health"`
+	UpdatedAt time.Time          `json:"updatedAt"`
+}
+
+// ProtocolEndpoint is one additional protocol a Pod advertises alongside
+// its primary Service port (e.g. a gRPC or WebSocket listener on a
+// different port), declared as one element of the JSON array in the
+// discovery.rezenkai.com/protocols pod annotation.
+type ProtocolEndpoint struct {
+	Name string `json:"name"`
+	Port int32  `json:"port"`
+	Path string `json:"path,omitempty"`
+}
+
+// Annotations on a Service that override how CheckServiceHealth probes its
+// endpoints, read into ServiceEndpoint.Metadata alongside the Service's own
+// labels.
+const (
+	annotationHealthPath   = "discovery.rezenkai.com/health-path"
+	annotationHealthScheme = "discovery.rezenkai.com/health-scheme"
+	annotationHealthPort   = "discovery.rezenkai.com/health-port"
+)
+
+var healthAnnotations = []string{annotationHealthPath, annotationHealthScheme, annotationHealthPort}
+
+// Kratos-style annotations on a Pod backing a Service, describing the
+// instance's stable identity, version (for canary routing), additional
+// protocol endpoints, and arbitrary metadata, all merged into the
+// corresponding ServiceEndpoint.
+const (
+	annotationServiceID = "discovery.rezenkai.com/id"
+	annotationVersion   = "discovery.rezenkai.com/version"
+	annotationProtocols = "discovery.rezenkai.com/protocols"
+	annotationMetadata  = "discovery.rezenkai.com/metadata"
+)
+
+// applyPodDiscoveryMetadata merges pod's discovery.rezenkai.com/* annotations
+// into endpoint: ServiceID, Version, Protocols, and arbitrary metadata JSON.
+// A nil pod, or a malformed protocols/metadata annotation, leaves the
+// corresponding field untouched rather than failing the whole endpoint.
+func applyPodDiscoveryMetadata(pod *corev1.Pod, endpoint *ServiceEndpoint) {
+	if pod == nil {
+		return
+	}
+	if id := pod.Annotations[annotationServiceID]; id != "" {
+		endpoint.ServiceID = id
+	}
+	if version := pod.Annotations[annotationVersion]; version != "" {
+		endpoint.Version = version
+	}
+	if raw := pod.Annotations[annotationProtocols]; raw != "" {
+		var protocols []ProtocolEndpoint
+		if err := json.Unmarshal([]byte(raw), &protocols); err == nil {
+			endpoint.Protocols = protocols
+		}
+	}
+	if raw := pod.Annotations[annotationMetadata]; raw != "" {
+		var metadata map[string]string
+		if err := json.Unmarshal([]byte(raw), &metadata); err == nil {
+			for k, v := range metadata {
+				endpoint.Metadata[k] = v
+			}
+		}
+	}
+}
+
+// addressFamily reports whether addr is an IPv4 or IPv6 literal, tolerating
+// a link-local zone suffix (e.g. "fe80::1%eth0"), or "" if addr doesn't
+// parse as an IP at all (an FQDN endpoint, for instance).
+func addressFamily(addr string) string {
+	if idx := strings.IndexByte(addr, '%'); idx >= 0 {
+		addr = addr[:idx]
+	}
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return ""
+	}
+	if ip.To4() != nil {
+		return "IPv4"
+	}
+	return "IPv6"
+}
+
+// joinHostPort composes address and port into a host:port pair, bracketing
+// IPv6 literals (including zone-qualified ones) the way net.JoinHostPort
+// always has — callers building a URL out of a ServiceEndpoint must go
+// through this instead of fmt.Sprintf("%s:%d", ...), which produces an
+// invalid URL for any IPv6 address.
+func joinHostPort(address string, port int32) string {
+	return net.JoinHostPort(address, strconv.Itoa(int(port)))
 }
 
 // HealthStatus represents the health of a service endpoint
@@ -43,6 +155,21 @@ type HealthStatus struct {
 type Client struct {
 	client client.Client
 	cache  *serviceCache
+
+	informerOpts InformerOptions
+	informers    *informerSubsystem
+
+	// Registrar, when set, receives every endpoint UpdateServiceEndpoints
+	// (or the informer subsystem) discovers, alongside the JSON
+	// ConfigMap this package has always written. Use
+	// NewRegistrarFromConfig to build one from a RegistryConfig, or
+	// WithRegistrar to set it directly.
+	Registrar Registrar
+
+	watchMu       sync.Mutex
+	watchers      map[int]*watcher
+	nextWatcherID int
+	revision      int64
 }
 
 // serviceCache provides thread-safe caching of service endpoints
@@ -50,17 +177,96 @@ type serviceCache struct {
 	mu        sync.RWMutex
 	endpoints map[string][]ServiceEndpoint
 	tenants   map[string]*tenantv1alpha1.Tenant
+	clusters  map[string]*memberCluster
 }
 
-// NewClient creates a new service discovery client
-func NewClient(c client.Client) *Client {
-	return &Client{
+// setServiceEndpoints replaces tenant's cached endpoints for a single
+// service, leaving every other service's endpoints untouched. This is the
+// incremental counterpart to UpdateServiceEndpoints's full per-tenant
+// rebuild, used by the informer subsystem so one changed Service doesn't
+// require re-listing the whole tenant.
+func (c *serviceCache) setServiceEndpoints(tenant, service string, eps []ServiceEndpoint) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	existing := c.endpoints[tenant]
+	kept := make([]ServiceEndpoint, 0, len(existing))
+	for _, ep := range existing {
+		if ep.Service != service {
+			kept = append(kept, ep)
+		}
+	}
+	c.endpoints[tenant] = append(kept, eps...)
+}
+
+// removeServiceEndpoints drops every cached endpoint for service, used when
+// the informer subsystem observes the Service itself was deleted.
+func (c *serviceCache) removeServiceEndpoints(tenant, service string) {
+	c.setServiceEndpoints(tenant, service, nil)
+}
+
+// endpointsForService returns tenant's currently cached endpoints for a
+// single service, used to diff against a freshly computed list before
+// pushing the difference to an external Registrar.
+func (c *serviceCache) endpointsForService(tenant, service string) []ServiceEndpoint {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	var result []ServiceEndpoint
+	for _, ep := range c.endpoints[tenant] {
+		if ep.Service == service {
+			result = append(result, ep)
+		}
+	}
+	return result
+}
+
+// ClientOption configures optional behavior of a Client, most of which only
+// takes effect once EnableInformers switches it onto the SharedInformer
+// subsystem.
+type ClientOption func(*Client)
+
+// WithResyncPeriod sets how often the informer subsystem replays its full
+// cached state through the event handlers, healing any watch event it
+// might have missed. Defaults to 10 minutes.
+func WithResyncPeriod(d time.Duration) ClientOption {
+	return func(c *Client) { c.informerOpts.ResyncPeriod = d }
+}
+
+// WithWorkerCount sets how many goroutines drain the informer subsystem's
+// workqueue concurrently. Defaults to 2.
+func WithWorkerCount(n int) ClientOption {
+	return func(c *Client) { c.informerOpts.WorkerCount = n }
+}
+
+// WithWatchNamespaces restricts the informer subsystem to the given
+// namespaces, bounding informer memory on clusters with many tenants.
+// Unset (the default) watches every namespace.
+func WithWatchNamespaces(namespaces ...string) ClientOption {
+	return func(c *Client) { c.informerOpts.WatchNamespaces = namespaces }
+}
+
+// WithRegistrar sets the external Registrar Client mirrors endpoints into
+// alongside the discovery ConfigMap. See NewRegistrarFromConfig to build
+// one from operator-supplied RegistryConfig flags.
+func WithRegistrar(r Registrar) ClientOption {
+	return func(c *Client) { c.Registrar = r }
+}
+
+// NewClient creates a new service discovery client. By default it only
+// backs the reconciler-driven ServiceWatcher fallback path; call
+// EnableInformers to switch on the SharedInformer subsystem.
+func NewClient(c client.Client, opts ...ClientOption) *Client {
+	d := &Client{
 		client: c,
 		cache: &serviceCache{
 			endpoints: make(map[string][]ServiceEndpoint),
 			tenants:   make(map[string]*tenantv1alpha1.Tenant),
+			clusters:  make(map[string]*memberCluster),
 		},
 	}
+	for _, opt := range opts {
+		opt(d)
+	}
+	return d
 }
 
 // UpdateServiceEndpoints updates the service registry for a tenant
@@ -85,14 +291,28 @@ func (d *Client) UpdateServiceEndpoints(ctx context.Context, tenant *tenantv1alp
 		}
 		for _, subset := range ep.Subsets {
 			for _, addr := range subset.Addresses {
+				// Resolved once per address (not per port, since
+				// TargetRef is the same pod for every port of one
+				// address) and applied to every port's endpoint below.
+				pod, err := d.podForTarget(ctx, addr.TargetRef)
+				if err != nil {
+					log.Error(err, "failed to look up backing pod for discovery metadata", "service", svc.Name)
+				}
 				for _, port := range subset.Ports {
+					appProtocol := ""
+					if port.AppProtocol != nil {
+						appProtocol = *port.AppProtocol
+					}
 					endpoint := ServiceEndpoint{
-						Service:   svc.Name,
-						Namespace: svc.Namespace,
-						Tenant:    tenant.Name,
-						Address:   addr.IP,
-						Port:      port.Port,
-						Protocol:  string(port.Protocol),
+						Service:       svc.Name,
+						Namespace:     svc.Namespace,
+						Tenant:        tenant.Name,
+						Address:       addr.IP,
+						Port:          port.Port,
+						PortName:      port.Name,
+						AppProtocol:   appProtocol,
+						Protocol:      string(port.Protocol),
+						AddressFamily: addressFamily(addr.IP),
 						Metadata: map[string]string{
 							"tier":         tenant.Spec.Tier,
 							"organization": tenant.Spec.OrganizationName,
@@ -106,25 +326,83 @@ func (d *Client) UpdateServiceEndpoints(ctx context.Context, tenant *tenantv1alp
 					for k, v := range svc.Labels {
 						endpoint.Metadata[k] = v
 					}
+					for _, key := range healthAnnotations {
+						if v, ok := svc.Annotations[key]; ok {
+							endpoint.Metadata[key] = v
+						}
+					}
+					applyPodDiscoveryMetadata(pod, &endpoint)
 					endpoints = append(endpoints, endpoint)
 				}
 			}
 		}
 	}
 	d.cache.mu.Lock()
+	previous := d.cache.endpoints[tenant.Name]
 	d.cache.endpoints[tenant.Name] = endpoints
 	d.cache.tenants[tenant.Name] = tenant.DeepCopy()
 	d.cache.mu.Unlock()
+	d.pushToRegistrar(ctx, previous, endpoints)
+	d.emitWatchEvents(previous, endpoints)
 	return d.updateDiscoveryConfigMap(ctx, tenant, endpoints)
 }
 
+// podForTarget resolves the Pod an Endpoints address's TargetRef points at,
+// preferring the informer subsystem's Pod lister when EnableInformers has
+// been called and falling back to a direct API read otherwise. Returns a
+// nil Pod, not an error, if ref doesn't reference a Pod at all (headless
+// Services can target other kinds) or the Pod can't be found.
+func (d *Client) podForTarget(ctx context.Context, ref *corev1.ObjectReference) (*corev1.Pod, error) {
+	if ref == nil || ref.Kind != "Pod" {
+		return nil, nil
+	}
+	if d.informers != nil {
+		return d.lookupPod(ref.Namespace, ref.Name)
+	}
+	pod := &corev1.Pod{}
+	if err := d.client.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, pod); err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return pod, nil
+}
+
+// QueryOption filters the results of GetServiceEndpoints and FindService.
+type QueryOption func(*queryOptions)
+
+type queryOptions struct {
+	healthyOnly bool
+}
+
+// HealthyOnly restricts results to endpoints HealthChecker hasn't ejected.
+// Endpoints that have never been checked are included, since "unknown" is
+// not the same as "ejected".
+func HealthyOnly() QueryOption {
+	return func(o *queryOptions) { o.healthyOnly = true }
+}
+
+func resolveQueryOptions(opts []QueryOption) queryOptions {
+	var q queryOptions
+	for _, opt := range opts {
+		opt(&q)
+	}
+	return q
+}
+
+func (q queryOptions) matches(ep ServiceEndpoint) bool {
+	return !q.healthyOnly || ep.Health.Status != "ejected"
+}
+
 // GetServiceEndpoints returns all endpoints for a service
-func (d *Client) GetServiceEndpoints(service, tenant string) []ServiceEndpoint {
+func (d *Client) GetServiceEndpoints(service, tenant string, opts ...QueryOption) []ServiceEndpoint {
+	q := resolveQueryOptions(opts)
 	d.cache.mu.RLock()
 	defer d.cache.mu.RUnlock()
 	var result []ServiceEndpoint
 	for _, ep := range d.cache.endpoints[tenant] {
-		if ep.Service == service {
+		if ep.Service == service && q.matches(ep) {
 			result = append(result, ep)
 		}
 	}
@@ -155,13 +433,14 @@ func (d *Client) GetAllEndpoints() map[string][]ServiceEndpoint {
 }
 
 // FindService locates a service endpoint by criteria
-func (d *Client) FindService(criteria map[string]string) []ServiceEndpoint {
+func (d *Client) FindService(criteria map[string]string, opts ...QueryOption) []ServiceEndpoint {
+	q := resolveQueryOptions(opts)
 	d.cache.mu.RLock()
 	defer d.cache.mu.RUnlock()
 	var result []ServiceEndpoint
 	for _, endpoints := range d.cache.endpoints {
 		for _, ep := range endpoints {
-			if matchesCriteria(ep, criteria) {
+			if matchesCriteria(ep, criteria) && q.matches(ep) {
 				result = append(result, ep)
 			}
 		}
@@ -172,9 +451,12 @@ func (d *Client) FindService(criteria map[string]string) []ServiceEndpoint {
 // RemoveTenant removes a tenant from service discovery
 func (d *Client) RemoveTenant(ctx context.Context, tenant *tenantv1alpha1.Tenant) error {
 	d.cache.mu.Lock()
+	previous := d.cache.endpoints[tenant.Name]
 	delete(d.cache.endpoints, tenant.Name)
 	delete(d.cache.tenants, tenant.Name)
 	d.cache.mu.Unlock()
+	d.pushToRegistrar(ctx, previous, nil)
+	d.emitWatchEvents(previous, nil)
 	// Delete discovery ConfigMap
 	cm := &corev1.ConfigMap{}
 	err := d.client.Get(ctx, types.NamespacedName{Name: fmt.Sprintf("%s-discovery", tenant.Name), Namespace: "tenant-system"}, cm)
@@ -229,7 +511,7 @@ func (d *Client) updateDiscoveryConfigMap(ctx context.Context, tenant *tenantv1a
 // CheckServiceHealth performs health check on a service endpoint
 func (d *Client) CheckServiceHealth(ctx context.Context, endpoint ServiceEndpoint) HealthStatus {
 	client := &http.Client{Timeout: 5 * time.Second}
-	url := fmt.Sprintf("http://%s:%d/health", endpoint.Address, endpoint.Port)
+	url := healthCheckURL(endpoint)
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
 		return HealthStatus{
@@ -261,6 +543,29 @@ func (d *Client) CheckServiceHealth(ctx context.Context, endpoint ServiceEndpoin
 	}
 }
 
+// healthCheckURL builds the URL CheckServiceHealth probes for endpoint,
+// honoring any discovery.rezenkai.com/health-{path,scheme,port} overrides
+// carried in its Metadata (copied there from the owning Service's
+// annotations) and always composing the host:port through joinHostPort so
+// an IPv6 address comes out bracketed instead of producing an invalid URL.
+func healthCheckURL(endpoint ServiceEndpoint) string {
+	scheme := "http"
+	if v := endpoint.Metadata[annotationHealthScheme]; v != "" {
+		scheme = v
+	}
+	path := "/health"
+	if v := endpoint.Metadata[annotationHealthPath]; v != "" {
+		path = v
+	}
+	port := endpoint.Port
+	if v := endpoint.Metadata[annotationHealthPort]; v != "" {
+		if p, err := strconv.Atoi(v); err == nil {
+			port = int32(p)
+		}
+	}
+	return fmt.Sprintf("%s://%s%s", scheme, joinHostPort(endpoint.Address, port), path)
+}
+
 // UpdateHealthStatus updates the health status of an endpoint
 func (d *Client) UpdateHealthStatus(tenant, service string, health HealthStatus) {
 	d.cache.mu.Lock()
@@ -273,11 +578,35 @@ func (d *Client) UpdateHealthStatus(tenant, service string, health HealthStatus)
 	}
 }
 
-// ServiceWatcher reconciles Kubernetes Service objects
+// UpdateEndpointHealth updates a single endpoint's cached Health, matched
+// by service name and address:port rather than UpdateHealthStatus's
+// service-wide match. HealthChecker uses this so ejecting one instance
+// under outlier detection doesn't overwrite the health of its siblings.
+func (d *Client) UpdateEndpointHealth(tenant string, ep ServiceEndpoint, health HealthStatus) {
+	d.cache.mu.Lock()
+	defer d.cache.mu.Unlock()
+	for i, cached := range d.cache.endpoints[tenant] {
+		if cached.Service == ep.Service && cached.Address == ep.Address && cached.Port == ep.Port {
+			d.cache.endpoints[tenant][i].Health = health
+			d.cache.endpoints[tenant][i].UpdatedAt = time.Now()
+		}
+	}
+}
+
+// ServiceWatcher reconciles Kubernetes Service objects through
+// controller-runtime. It is the fallback discovery path: once
+// Client.EnableInformers is wired up, the SharedInformer subsystem in
+// informer.go drives ServiceEndpoint updates incrementally and this
+// reconciler's full per-Service relist mostly just confirms what the
+// informers already applied. Clusters that never call EnableInformers still
+// get correct (if less scalable) discovery from this alone.
 type ServiceWatcher struct {
 	Discovery *Client
 }
 
+// +kubebuilder:rbac:groups=core,resources=endpoints,verbs=get;list;watch
+// +kubebuilder:rbac:groups=discovery.k8s.io,resources=endpointslices,verbs=get;list;watch
+
 // Reconcile implements the reconciliation loop for Service objects
 func (w *ServiceWatcher) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	log := log.FromContext(ctx).WithValues("service", req.NamespacedName)
@@ -376,6 +705,14 @@ func matchesCriteria(endpoint ServiceEndpoint, criteria map[string]string) bool
 			if endpoint.Namespace != value {
 				return false
 			}
+		case "version":
+			if endpoint.Version != value {
+				return false
+			}
+		case "protocol":
+			if !endpoint.hasProtocol(value) {
+				return false
+			}
 		default:
 			if endpoint.Metadata[key] != value {
 				return false
@@ -385,6 +722,22 @@ func matchesCriteria(endpoint ServiceEndpoint, criteria map[string]string) bool
 	return true
 }
 
+// hasProtocol reports whether name matches this endpoint's primary named
+// port (PortName) or one of its additional Protocols entries, letting
+// FindService's "protocol" criterion match either the Service's own port
+// name or a Pod-advertised protocol like "grpc" or "ws".
+func (ep ServiceEndpoint) hasProtocol(name string) bool {
+	if ep.PortName == name {
+		return true
+	}
+	for _, p := range ep.Protocols {
+		if p.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
 func isTenantNamespace(namespace string) bool {
 	return len(namespace) > len("tenant-") && namespace[:len("tenant-")] == "tenant-"
 }
@@ -396,58 +749,38 @@ func extractTenantName(namespace string) string {
 	return ""
 }
 
-// Registry provides a central registry for service discovery
-type Registry struct {
-	mu        sync.RWMutex
-	services  map[string]*ServiceInfo
-}
-
-// ServiceInfo contains detailed service information
-type ServiceInfo struct {
-	Name        string            `json:"name"`
-	Version     string            `json:"version"`
-	Endpoints   []ServiceEndpoint `json:"endpoints"`
-	Metadata    map[string]string `json:"metadata"`
-	LastUpdated time.Time         `json:"lastUpdated"`
+// instanceID identifies one registered endpoint instance across Registrar
+// backends (Consul service ID, etcd key suffix, mDNS instance name).
+func instanceID(ep ServiceEndpoint) string {
+	return fmt.Sprintf("%s/%s/%s", ep.Tenant, ep.Service, joinHostPort(ep.Address, ep.Port))
 }
 
-// NewRegistry creates a new service registry
-func NewRegistry() *Registry {
-	return &Registry{
-		services: make(map[string]*ServiceInfo),
+// pushToRegistrar mirrors a tenant or single service's freshly computed
+// endpoint list into d.Registrar, the go-micro-style pluggable backend
+// (Consul, etcd, mDNS, or a MultiRegistrar fanning out to several). It
+// registers every current endpoint, refreshing its health-check TTL from
+// CheckServiceHealth, and deregisters anything present in previous but
+// missing from current. A nil Registrar makes this a no-op, so Clients that
+// never set one behave exactly as before this existed.
+func (d *Client) pushToRegistrar(ctx context.Context, previous, current []ServiceEndpoint) {
+	if d.Registrar == nil {
+		return
 	}
-}
-
-// Register adds or updates a service in the registry
-func (r *Registry) Register(info *ServiceInfo) {
-	r.mu.Lock()
-	defer r.mu.Unlock()
-	info.LastUpdated = time.Now()
-	r.services[info.Name] = info
-}
-
-// Deregister removes a service from the registry
-func (r *Registry) Deregister(name string) {
-	r.mu.Lock()
-	defer r.mu.Unlock()
-	delete(r.services, name)
-}
-
-// Get retrieves service information
-func (r *Registry) Get(name string) (*ServiceInfo, bool) {
-	r.mu.RLock()
-	defer r.mu.RUnlock()
-	info, exists := r.services[name]
-	return info, exists
-}
-
-// List returns all registered services
-func (r *Registry) List() []*ServiceInfo {
-	r.mu.RLock()
-	defer r.mu.RUnlock()
-	var result []*ServiceInfo
-	for _, info := range r.services {
-		result = append(result, info)
+	logger := log.FromContext(ctx)
+	stillPresent := make(map[string]bool, len(current))
+	for _, ep := range current {
+		stillPresent[instanceID(ep)] = true
+		ep.Health = d.CheckServiceHealth(ctx, ep)
+		if err := d.Registrar.Register(ctx, ep); err != nil {
+			logger.Error(err, "failed to register endpoint with external registrar", "service", ep.Service, "address", ep.Address)
+		}
+	}
+	for _, ep := range previous {
+		if stillPresent[instanceID(ep)] {
+			continue
+		}
+		if err := d.Registrar.Deregister(ctx, ep); err != nil {
+			logger.Error(err, "failed to deregister stale endpoint from external registrar", "service", ep.Service, "address", ep.Address)
+		}
 	}
-	return result
 }
\ No newline at end of file
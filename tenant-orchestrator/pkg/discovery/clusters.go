@@ -0,0 +1,93 @@
+package discovery
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	tenantv1alpha1 "github.com/rezenkai/multi-saas-crm/tenant-orchestrator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// memberCluster is a reachable member cluster's client, built from the
+// kubeconfig its ClusterRegistration points at.
+type memberCluster struct {
+	client client.Client
+}
+
+// RegisterCluster builds a client.Client for the member cluster described by
+// reg, using the kubeconfig in the Secret reg.Spec.KubeconfigSecretRef
+// references, and makes it available to TenantReconciler for fan-out.
+func (d *Client) RegisterCluster(ctx context.Context, reg *tenantv1alpha1.ClusterRegistration) error {
+	secret := &corev1.Secret{}
+	if err := d.client.Get(ctx, types.NamespacedName{
+		Name:      reg.Spec.KubeconfigSecretRef.Name,
+		Namespace: "tenant-system",
+	}, secret); err != nil {
+		return fmt.Errorf("failed to read kubeconfig secret for cluster %s: %w", reg.Spec.ClusterName, err)
+	}
+	kubeconfig, ok := secret.Data[reg.Spec.KubeconfigSecretRef.Key]
+	if !ok {
+		return fmt.Errorf("kubeconfig secret %s has no key %q", secret.Name, reg.Spec.KubeconfigSecretRef.Key)
+	}
+
+	// clientcmd.BuildConfigFromFlags takes a kubeconfig path, so materialize
+	// the secret contents to a tempfile for the duration of the build.
+	f, err := os.CreateTemp("", fmt.Sprintf("kubeconfig-%s-*", reg.Spec.ClusterName))
+	if err != nil {
+		return fmt.Errorf("failed to create temp kubeconfig for cluster %s: %w", reg.Spec.ClusterName, err)
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.Write(kubeconfig); err != nil {
+		f.Close()
+		return fmt.Errorf("failed to write temp kubeconfig for cluster %s: %w", reg.Spec.ClusterName, err)
+	}
+	f.Close()
+
+	restConfig, err := clientcmd.BuildConfigFromFlags(reg.Spec.APIEndpoint, f.Name())
+	if err != nil {
+		return fmt.Errorf("failed to build config for cluster %s: %w", reg.Spec.ClusterName, err)
+	}
+
+	memberClient, err := client.New(restConfig, client.Options{Scheme: tenantv1alpha1.Scheme})
+	if err != nil {
+		return fmt.Errorf("failed to build client for cluster %s: %w", reg.Spec.ClusterName, err)
+	}
+
+	d.cache.mu.Lock()
+	d.cache.clusters[reg.Spec.ClusterName] = &memberCluster{client: memberClient}
+	d.cache.mu.Unlock()
+	return nil
+}
+
+// DeregisterCluster removes a previously registered member cluster client.
+func (d *Client) DeregisterCluster(clusterName string) {
+	d.cache.mu.Lock()
+	defer d.cache.mu.Unlock()
+	delete(d.cache.clusters, clusterName)
+}
+
+// ClusterClient returns the client.Client registered for clusterName, if any.
+func (d *Client) ClusterClient(clusterName string) (client.Client, bool) {
+	d.cache.mu.RLock()
+	defer d.cache.mu.RUnlock()
+	mc, ok := d.cache.clusters[clusterName]
+	if !ok {
+		return nil, false
+	}
+	return mc.client, true
+}
+
+// ClusterNames returns the names of all currently registered member clusters.
+func (d *Client) ClusterNames() []string {
+	d.cache.mu.RLock()
+	defer d.cache.mu.RUnlock()
+	names := make([]string, 0, len(d.cache.clusters))
+	for name := range d.cache.clusters {
+		names = append(names, name)
+	}
+	return names
+}
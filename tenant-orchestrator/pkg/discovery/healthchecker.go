@@ -0,0 +1,245 @@
+package discovery
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+var (
+	healthChecksTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "discovery_health_checks_total",
+			Help: "Total service discovery endpoint health checks, by result",
+		},
+		[]string{"result"},
+	)
+	endpointEjectionsTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "discovery_endpoint_ejections_total",
+			Help: "Total endpoints ejected from routing after consecutive failed health checks, by service",
+		},
+		[]string{"service"},
+	)
+	checkDuration = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Name: "discovery_check_duration_seconds",
+			Help: "Duration of individual service discovery endpoint health checks",
+		},
+	)
+)
+
+// HealthCheckerOptions configures a HealthChecker. Zero values are replaced
+// with sane defaults by withDefaults.
+type HealthCheckerOptions struct {
+	// Interval is how often all known endpoints are checked.
+	Interval time.Duration
+	// Concurrency bounds how many endpoints are checked at once.
+	Concurrency int
+	// ConsecutiveFailures is how many failed checks within Window eject an
+	// endpoint.
+	ConsecutiveFailures int
+	// Window is the sliding time window consecutive failures are counted
+	// over; failures older than Window are discarded.
+	Window time.Duration
+	// BaseEjectionTime is how long an endpoint is ejected for after its
+	// first outlier detection; the ejection time doubles on each
+	// subsequent ejection, up to MaxEjectionTime.
+	BaseEjectionTime time.Duration
+	// MaxEjectionTime caps the exponential ejection backoff.
+	MaxEjectionTime time.Duration
+}
+
+func (o HealthCheckerOptions) withDefaults() HealthCheckerOptions {
+	if o.Interval <= 0 {
+		o.Interval = 30 * time.Second
+	}
+	if o.Concurrency <= 0 {
+		o.Concurrency = 10
+	}
+	if o.ConsecutiveFailures <= 0 {
+		o.ConsecutiveFailures = 3
+	}
+	if o.Window <= 0 {
+		o.Window = 2 * time.Minute
+	}
+	if o.BaseEjectionTime <= 0 {
+		o.BaseEjectionTime = 30 * time.Second
+	}
+	if o.MaxEjectionTime <= 0 {
+		o.MaxEjectionTime = 5 * time.Minute
+	}
+	return o
+}
+
+// outlierState tracks an endpoint's recent failures and ejection history for
+// outlier detection, modeled on Envoy's consecutive-failure ejection panel.
+type outlierState struct {
+	failureTimes  []time.Time
+	ejectionCount int
+	ejectedUntil  time.Time
+}
+
+// HealthChecker periodically health-checks every endpoint known to a
+// discovery Client and ejects endpoints that fail ConsecutiveFailures checks
+// within Window, re-probing ejected endpoints like any other once their
+// ejection period elapses.
+type HealthChecker struct {
+	discovery *Client
+	opts      HealthCheckerOptions
+
+	mu      sync.Mutex
+	outlier map[string]*outlierState
+}
+
+// NewHealthChecker creates a HealthChecker that checks d's endpoints on the
+// schedule and with the outlier-detection parameters given by opts.
+func NewHealthChecker(d *Client, opts HealthCheckerOptions) *HealthChecker {
+	return &HealthChecker{
+		discovery: d,
+		opts:      opts.withDefaults(),
+		outlier:   make(map[string]*outlierState),
+	}
+}
+
+// Start implements manager.Runnable, ticking on Interval and health-checking
+// every known endpoint on each tick.
+func (h *HealthChecker) Start(ctx context.Context) error {
+	ticker := time.NewTicker(h.opts.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			h.checkAll(ctx)
+		}
+	}
+}
+
+// NeedLeaderElection reports false: HealthChecker only mutates its own
+// process-local cache and outlier state, unlike Client's informer subsystem
+// (which reconciles shared ConfigMap/registrar state) or Scheduler (which
+// must not double-fire jobs across replicas), so every replica can run one.
+func (h *HealthChecker) NeedLeaderElection() bool {
+	return false
+}
+
+// checkAll health-checks every endpoint known to the discovery Client,
+// bounded to opts.Concurrency concurrent checks at a time.
+func (h *HealthChecker) checkAll(ctx context.Context) {
+	sem := make(chan struct{}, h.opts.Concurrency)
+	var wg sync.WaitGroup
+	for tenant, endpoints := range h.discovery.GetAllEndpoints() {
+		for _, ep := range endpoints {
+			tenant, ep := tenant, ep
+			wg.Add(1)
+			sem <- struct{}{}
+			go func() {
+				defer wg.Done()
+				defer func() { <-sem }()
+				h.checkOne(ctx, tenant, ep)
+			}()
+		}
+	}
+	wg.Wait()
+}
+
+// checkOne health-checks a single endpoint, records metrics, and applies
+// outlier-ejection accounting.
+func (h *HealthChecker) checkOne(ctx context.Context, tenant string, ep ServiceEndpoint) {
+	start := time.Now()
+	health := h.discovery.CheckServiceHealth(ctx, ep)
+	checkDuration.Observe(time.Since(start).Seconds())
+
+	if health.Status != "healthy" {
+		healthChecksTotal.WithLabelValues("failure").Inc()
+		h.recordFailure(ep)
+	} else {
+		healthChecksTotal.WithLabelValues("success").Inc()
+		h.recordSuccess(ep)
+	}
+
+	if ejected, until := h.ejected(ep); ejected {
+		health.Status = "ejected"
+		health.Message = "ejected by outlier detection until " + until.Format(time.RFC3339)
+	}
+	h.discovery.UpdateEndpointHealth(tenant, ep, health)
+}
+
+// recordFailure appends a failure at now, discards failures outside Window,
+// and ejects the endpoint once ConsecutiveFailures have landed inside
+// Window. Each successive ejection doubles the ejection time, capped at
+// MaxEjectionTime.
+func (h *HealthChecker) recordFailure(ep ServiceEndpoint) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	now := time.Now()
+	key := instanceID(ep)
+	s, ok := h.outlier[key]
+	if !ok {
+		s = &outlierState{}
+		h.outlier[key] = s
+	}
+
+	s.failureTimes = append(s.failureTimes, now)
+	cutoff := now.Add(-h.opts.Window)
+	live := s.failureTimes[:0]
+	for _, t := range s.failureTimes {
+		if t.After(cutoff) {
+			live = append(live, t)
+		}
+	}
+	s.failureTimes = live
+
+	if len(s.failureTimes) < h.opts.ConsecutiveFailures || now.Before(s.ejectedUntil) {
+		return
+	}
+
+	s.ejectionCount++
+	ejectionTime := h.opts.BaseEjectionTime * time.Duration(1<<uint(min(s.ejectionCount-1, 10)))
+	if ejectionTime > h.opts.MaxEjectionTime {
+		ejectionTime = h.opts.MaxEjectionTime
+	}
+	s.ejectedUntil = now.Add(ejectionTime)
+	s.failureTimes = nil
+	endpointEjectionsTotal.WithLabelValues(ep.Service).Inc()
+
+	logger := log.FromContext(context.Background()).WithValues("service", ep.Service, "address", joinHostPort(ep.Address, ep.Port))
+	logger.Info("ejecting endpoint after consecutive health check failures", "ejectionTime", ejectionTime)
+}
+
+// recordSuccess clears accumulated failure history for ep. It does not
+// clear an in-progress ejection: an ejected endpoint stays ejected for its
+// full ejectedUntil window regardless of interim probe results, matching
+// Envoy's outlier detection semantics.
+func (h *HealthChecker) recordSuccess(ep ServiceEndpoint) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if s, ok := h.outlier[instanceID(ep)]; ok {
+		s.failureTimes = nil
+	}
+}
+
+// ejected reports whether ep is currently within an active ejection window.
+func (h *HealthChecker) ejected(ep ServiceEndpoint) (bool, time.Time) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	s, ok := h.outlier[instanceID(ep)]
+	if !ok {
+		return false, time.Time{}
+	}
+	return time.Now().Before(s.ejectedUntil), s.ejectedUntil
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,131 @@
+package discovery
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// defaultLongPollTimeout bounds how long a long-poll /v1/discovery/watch
+// request waits for a change before returning 204 with no body.
+const defaultLongPollTimeout = 30 * time.Second
+
+// WatchServer exposes a Client's Watch subscriptions over HTTP, for
+// sidecars and API gateways that want to subscribe to tenant service
+// changes without polling the discovery ConfigMap.
+type WatchServer struct {
+	// Addr is the bind address for the HTTP server, e.g. ":8090".
+	Addr string
+	// Discovery is the Client whose endpoint changes are streamed.
+	Discovery *Client
+
+	server *http.Server
+}
+
+// Start implements manager.Runnable, serving /v1/discovery/watch until ctx
+// is done.
+func (s *WatchServer) Start(ctx context.Context) error {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/discovery/watch", s.handleWatch)
+	s.server = &http.Server{Addr: s.Addr, Handler: mux}
+
+	errCh := make(chan error, 1)
+	go func() { errCh <- s.server.ListenAndServe() }()
+
+	select {
+	case <-ctx.Done():
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		return s.server.Shutdown(shutdownCtx)
+	case err := <-errCh:
+		if err == http.ErrServerClosed {
+			return nil
+		}
+		return err
+	}
+}
+
+// NeedLeaderElection reports false: like HealthChecker, WatchServer only
+// serves its own process-local cache, so every replica should run one
+// rather than leaving service discovery unreachable on non-leader pods.
+func (s *WatchServer) NeedLeaderElection() bool {
+	return false
+}
+
+// handleWatch serves an initial snapshot followed by incremental deltas for
+// ?tenant=&service=, via Server-Sent Events (for clients sending
+// "Accept: text/event-stream") or a single long-poll response otherwise.
+// A long-poll response carries one event only: the first snapshot event
+// when any cached endpoint matches, else the next change, waiting up to
+// ?timeoutSeconds= or defaultLongPollTimeout for it before returning 204.
+func (s *WatchServer) handleWatch(w http.ResponseWriter, r *http.Request) {
+	tenant := r.URL.Query().Get("tenant")
+	service := r.URL.Query().Get("service")
+
+	events, err := s.Discovery.Watch(r.Context(), tenant, service)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if strings.Contains(r.Header.Get("Accept"), "text/event-stream") {
+		s.serveSSE(w, r, events)
+		return
+	}
+	s.serveLongPoll(w, r, events)
+}
+
+func (s *WatchServer) serveSSE(w http.ResponseWriter, r *http.Request, events <-chan DiscoveryEvent) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming not supported", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	logger := log.FromContext(r.Context())
+	for ev := range events {
+		data, err := json.Marshal(ev)
+		if err != nil {
+			logger.Error(err, "failed to marshal discovery event")
+			continue
+		}
+		if _, err := w.Write([]byte("id: " + strconv.FormatInt(ev.Revision, 10) + "\ndata: " + string(data) + "\n\n")); err != nil {
+			return
+		}
+		flusher.Flush()
+	}
+}
+
+func (s *WatchServer) serveLongPoll(w http.ResponseWriter, r *http.Request, events <-chan DiscoveryEvent) {
+	timeout := defaultLongPollTimeout
+	if raw := r.URL.Query().Get("timeoutSeconds"); raw != "" {
+		if secs, err := strconv.Atoi(raw); err == nil && secs > 0 {
+			timeout = time.Duration(secs) * time.Second
+		}
+	}
+
+	select {
+	case ev, ok := <-events:
+		if !ok {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(ev); err != nil {
+			log.FromContext(r.Context()).Error(err, "failed to write long-poll discovery response")
+		}
+	case <-time.After(timeout):
+		w.WriteHeader(http.StatusNoContent)
+	case <-r.Context().Done():
+	}
+}
@@ -0,0 +1,468 @@
+package discovery
+
+import (
+	"context"
+	"time"
+
+	tenantv1alpha1 "github.com/rezenkai/multi-saas-crm/tenant-orchestrator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	discoverylisters "k8s.io/client-go/listers/discovery/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// InformerOptions configures Client's SharedInformer-based discovery
+// subsystem, the preferred path over the ServiceWatcher reconciler
+// fallback.
+type InformerOptions struct {
+	// ResyncPeriod is how often the informers replay their full cached
+	// state through the event handlers, healing any watch event a
+	// cache missed. Zero takes the default of 10 minutes.
+	ResyncPeriod time.Duration
+	// WorkerCount is how many goroutines drain the workqueue
+	// concurrently. Zero takes the default of 2.
+	WorkerCount int
+	// WatchNamespaces restricts the informers to these namespaces,
+	// bounding memory on clusters with many tenants. Empty watches
+	// every namespace.
+	WatchNamespaces []string
+}
+
+func (o InformerOptions) withDefaults() InformerOptions {
+	if o.ResyncPeriod <= 0 {
+		o.ResyncPeriod = 10 * time.Minute
+	}
+	if o.WorkerCount <= 0 {
+		o.WorkerCount = 2
+	}
+	return o
+}
+
+// informerSubsystem is Client's SharedInformer-based alternative to
+// ServiceWatcher: it watches Services, Endpoints, and EndpointSlices
+// directly through client-go informers instead of relying on
+// controller-runtime to re-list every service and Get its Endpoints per
+// reconcile, and applies changes through a workqueue with retry/backoff
+// instead of running handlers inline on the informer's goroutine.
+type informerSubsystem struct {
+	discovery *Client
+	opts      InformerOptions
+	queue     workqueue.RateLimitingInterface
+	factories []informers.SharedInformerFactory
+
+	serviceListers       []corelisters.ServiceLister
+	endpointSliceListers []discoverylisters.EndpointSliceLister
+	podListers           []corelisters.PodLister
+}
+
+// serviceKey is a namespace/name pair identifying the Service whose
+// ServiceEndpoints need recomputing. Both Endpoints and EndpointSlice
+// events resolve back to their owning Service before being enqueued, so the
+// workqueue only ever does one kind of work.
+type serviceKey struct {
+	namespace string
+	name      string
+}
+
+// EnableInformers switches d onto the SharedInformer subsystem, backed by
+// clientset. Call Start (typically via mgr.Add) once the manager is ready
+// to run it; until Start is called, d still behaves exactly as it did
+// before EnableInformers, so existing ServiceWatcher-only deployments are
+// unaffected.
+func (d *Client) EnableInformers(clientset kubernetes.Interface) {
+	opts := d.informerOpts.withDefaults()
+	d.informerOpts = opts
+	sub := &informerSubsystem{
+		discovery: d,
+		opts:      opts,
+		queue:     workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()),
+	}
+
+	namespaces := opts.WatchNamespaces
+	if len(namespaces) == 0 {
+		namespaces = []string{corev1.NamespaceAll}
+	}
+	for _, ns := range namespaces {
+		factory := informers.NewSharedInformerFactoryWithOptions(clientset, opts.ResyncPeriod, informers.WithNamespace(ns))
+		services := factory.Core().V1().Services()
+		endpoints := factory.Core().V1().Endpoints()
+		endpointSlices := factory.Discovery().V1().EndpointSlices()
+		// Pods are read back via podListers to resolve their
+		// discovery.rezenkai.com/* annotations (see lookupPod). A pod-only
+		// annotation edit (e.g. bumping the version annotation for a
+		// canary) doesn't touch Endpoints/EndpointSlice, so pods also get
+		// their own UpdateFunc re-enqueuing every Service they match.
+		pods := factory.Core().V1().Pods()
+
+		services.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+			AddFunc:    sub.enqueueService,
+			UpdateFunc: func(_, new interface{}) { sub.enqueueService(new) },
+			DeleteFunc: sub.enqueueService,
+		})
+		endpoints.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+			AddFunc:    sub.enqueueOwningService,
+			UpdateFunc: func(_, new interface{}) { sub.enqueueOwningService(new) },
+			DeleteFunc: sub.enqueueOwningService,
+		})
+		endpointSlices.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+			AddFunc:    sub.enqueueOwningService,
+			UpdateFunc: func(_, new interface{}) { sub.enqueueOwningService(new) },
+			DeleteFunc: sub.enqueueOwningService,
+		})
+		pods.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+			UpdateFunc: func(_, new interface{}) { sub.enqueueServicesForPod(new) },
+		})
+
+		sub.factories = append(sub.factories, factory)
+		sub.serviceListers = append(sub.serviceListers, services.Lister())
+		sub.endpointSliceListers = append(sub.endpointSliceListers, endpointSlices.Lister())
+		sub.podListers = append(sub.podListers, pods.Lister())
+	}
+
+	d.informers = sub
+}
+
+// enqueueService keys a Service add/update/delete event via
+// cache.MetaNamespaceKeyFunc, the same keying client-go's own controllers
+// use.
+func (s *informerSubsystem) enqueueService(obj interface{}) {
+	meta, ok := objectMeta(obj)
+	if !ok {
+		return
+	}
+	s.queue.Add(serviceKey{namespace: meta.GetNamespace(), name: meta.GetName()})
+}
+
+// enqueueOwningService resolves an Endpoints or EndpointSlice event back to
+// the Service it belongs to and enqueues that instead, so the sync loop
+// only ever has one code path: recompute one Service's endpoints.
+func (s *informerSubsystem) enqueueOwningService(obj interface{}) {
+	switch o := obj.(type) {
+	case *corev1.Endpoints:
+		s.queue.Add(serviceKey{namespace: o.Namespace, name: o.Name})
+	case *discoveryv1.EndpointSlice:
+		if svc := o.Labels[discoveryv1.LabelServiceName]; svc != "" {
+			s.queue.Add(serviceKey{namespace: o.Namespace, name: svc})
+		}
+	case cache.DeletedFinalStateUnknown:
+		s.enqueueOwningService(o.Obj)
+	}
+}
+
+// enqueueServicesForPod re-syncs every Service in the pod's namespace whose
+// label selector matches it, so an edit to a Pod's discovery.rezenkai.com/*
+// annotations (which Endpoints/EndpointSlice events never surface, since
+// those objects don't carry the Pod's annotations) still refreshes the
+// ServiceEndpoints built from it.
+func (s *informerSubsystem) enqueueServicesForPod(obj interface{}) {
+	pod, ok := obj.(*corev1.Pod)
+	if !ok {
+		return
+	}
+	if len(pod.Labels) == 0 {
+		return
+	}
+	podLabels := labels.Set(pod.Labels)
+	for _, lister := range s.serviceListers {
+		svcs, err := lister.Services(pod.Namespace).List(labels.Everything())
+		if err != nil {
+			continue
+		}
+		for _, svc := range svcs {
+			if len(svc.Spec.Selector) == 0 {
+				continue
+			}
+			if labels.SelectorFromSet(svc.Spec.Selector).Matches(podLabels) {
+				s.queue.Add(serviceKey{namespace: svc.Namespace, name: svc.Name})
+			}
+		}
+	}
+}
+
+func objectMeta(obj interface{}) (metaAccessor, bool) {
+	if tomb, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tomb.Obj
+	}
+	m, ok := obj.(metaAccessor)
+	return m, ok
+}
+
+// metaAccessor is the subset of metav1.Object the informer handlers need;
+// every type an informer hands back (corev1.Service, corev1.Endpoints,
+// discoveryv1.EndpointSlice) satisfies it.
+type metaAccessor interface {
+	GetNamespace() string
+	GetName() string
+}
+
+// Start runs the informer subsystem until ctx is cancelled, implementing
+// manager.Runnable so main.go can register it alongside the rest of the
+// controllers. It is a no-op if EnableInformers was never called.
+func (d *Client) Start(ctx context.Context) error {
+	if d.informers == nil {
+		return nil
+	}
+	sub := d.informers
+	logger := log.FromContext(ctx).WithName("discovery-informer")
+
+	stop := ctx.Done()
+	for _, factory := range sub.factories {
+		factory.Start(stop)
+	}
+	for _, factory := range sub.factories {
+		for t, synced := range factory.WaitForCacheSync(stop) {
+			if !synced {
+				logger.Info("informer cache failed to sync", "type", t)
+			}
+		}
+	}
+
+	for i := 0; i < sub.opts.WorkerCount; i++ {
+		go func() {
+			for sub.processNextItem(ctx, logger) {
+			}
+		}()
+	}
+
+	<-stop
+	sub.queue.ShutDown()
+	return nil
+}
+
+func (s *informerSubsystem) processNextItem(ctx context.Context, logger syncLogger) bool {
+	item, quit := s.queue.Get()
+	if quit {
+		return false
+	}
+	defer s.queue.Done(item)
+
+	key := item.(serviceKey)
+	if err := s.discovery.syncService(ctx, key.namespace, key.name); err != nil {
+		if s.queue.NumRequeues(item) < 5 {
+			s.queue.AddRateLimited(item)
+			return true
+		}
+		logger.Error(err, "giving up on service after repeated sync failures", "namespace", key.namespace, "service", key.name)
+	}
+	s.queue.Forget(item)
+	return true
+}
+
+// syncLogger is the narrow logging interface Start and processNextItem
+// share, satisfied by controller-runtime's logr.Logger without importing
+// that package just for the type name.
+type syncLogger interface {
+	Error(err error, msg string, keysAndValues ...interface{})
+	Info(msg string, keysAndValues ...interface{})
+}
+
+// syncService recomputes ServiceEndpoints for one Service from the
+// informer caches and merges the result into its tenant's cache entry, the
+// incremental counterpart to UpdateServiceEndpoints's full per-tenant
+// rebuild.
+func (d *Client) syncService(ctx context.Context, namespace, name string) error {
+	tenantName := extractTenantName(namespace)
+	if tenantName == "" {
+		return nil
+	}
+
+	svc, err := d.lookupService(namespace, name)
+	if err != nil {
+		return err
+	}
+	if svc == nil || isSystemService(name) {
+		previous := d.cache.endpointsForService(tenantName, name)
+		d.cache.removeServiceEndpoints(tenantName, name)
+		d.pushToRegistrar(ctx, previous, nil)
+		d.emitWatchEvents(previous, nil)
+		return d.refreshConfigMapForTenant(ctx, tenantName)
+	}
+
+	tenant := &tenantv1alpha1.Tenant{}
+	if err := d.client.Get(ctx, types.NamespacedName{Name: tenantName, Namespace: namespace}, tenant); err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	slices, err := d.lookupEndpointSlices(namespace, name)
+	if err != nil {
+		return err
+	}
+
+	eps := d.endpointsFromSlices(ctx, svc, slices, tenant)
+	previous := d.cache.endpointsForService(tenantName, name)
+	d.cache.setServiceEndpoints(tenantName, name, eps)
+	d.cache.mu.Lock()
+	d.cache.tenants[tenantName] = tenant.DeepCopy()
+	d.cache.mu.Unlock()
+	d.pushToRegistrar(ctx, previous, eps)
+	d.emitWatchEvents(previous, eps)
+	return d.refreshConfigMapForTenant(ctx, tenantName)
+}
+
+// lookupService returns the named Service via the informer caches, or nil
+// if none of them has it (including the ordinary not-found case).
+func (d *Client) lookupService(namespace, name string) (*corev1.Service, error) {
+	for _, lister := range d.informers.serviceListers {
+		svc, err := lister.Services(namespace).Get(name)
+		if err == nil {
+			return svc, nil
+		}
+		if !errors.IsNotFound(err) {
+			return nil, err
+		}
+	}
+	return nil, nil
+}
+
+// lookupPod returns the named Pod via the informer caches, or nil if none of
+// them has it (including the ordinary not-found case). Used to read a
+// backing Pod's discovery.rezenkai.com/* annotations.
+func (d *Client) lookupPod(namespace, name string) (*corev1.Pod, error) {
+	if d.informers == nil {
+		return nil, nil
+	}
+	for _, lister := range d.informers.podListers {
+		pod, err := lister.Pods(namespace).Get(name)
+		if err == nil {
+			return pod, nil
+		}
+		if !errors.IsNotFound(err) {
+			return nil, err
+		}
+	}
+	return nil, nil
+}
+
+// lookupEndpointSlices returns every EndpointSlice backing service across
+// all configured namespace-scoped informers. A Service commonly owns more
+// than one slice (Kubernetes caps each slice at 100 endpoints, and dual-stack
+// services get separate IPv4 and IPv6 slices), so callers must merge all of
+// them rather than assuming one slice per Service.
+func (d *Client) lookupEndpointSlices(namespace, service string) ([]*discoveryv1.EndpointSlice, error) {
+	selector := labels.SelectorFromSet(labels.Set{discoveryv1.LabelServiceName: service})
+	var all []*discoveryv1.EndpointSlice
+	for _, lister := range d.informers.endpointSliceListers {
+		slices, err := lister.EndpointSlices(namespace).List(selector)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, slices...)
+	}
+	return all, nil
+}
+
+// endpointsFromSlices flattens svc's EndpointSlices into ServiceEndpoints,
+// one per ready address/port combination, covering every slice and address
+// family (IPv4 and IPv6) a dual-stack Service publishes.
+func (d *Client) endpointsFromSlices(ctx context.Context, svc *corev1.Service, slices []*discoveryv1.EndpointSlice, tenant *tenantv1alpha1.Tenant) []ServiceEndpoint {
+	var eps []ServiceEndpoint
+	now := time.Now()
+	// podCache resolves each backing Pod once per call even though the
+	// same Pod backs one endpoint's address across every port the Service
+	// declares.
+	podCache := make(map[types.NamespacedName]*corev1.Pod)
+	resolvePod := func(ref *corev1.ObjectReference) *corev1.Pod {
+		if ref == nil || ref.Kind != "Pod" {
+			return nil
+		}
+		key := types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}
+		if pod, ok := podCache[key]; ok {
+			return pod
+		}
+		pod, err := d.lookupPod(ref.Namespace, ref.Name)
+		if err != nil {
+			log.FromContext(ctx).Error(err, "failed to look up backing pod for discovery metadata", "service", svc.Name)
+		}
+		podCache[key] = pod
+		return pod
+	}
+	for _, slice := range slices {
+		family := string(slice.AddressType)
+		for _, port := range slice.Ports {
+			if port.Port == nil {
+				continue
+			}
+			protocol := corev1.ProtocolTCP
+			if port.Protocol != nil {
+				protocol = *port.Protocol
+			}
+			portName := ""
+			if port.Name != nil {
+				portName = *port.Name
+			}
+			appProtocol := ""
+			if port.AppProtocol != nil {
+				appProtocol = *port.AppProtocol
+			}
+			for _, endpoint := range slice.Endpoints {
+				if endpoint.Conditions.Ready != nil && !*endpoint.Conditions.Ready {
+					continue
+				}
+				for _, addr := range endpoint.Addresses {
+					ep := ServiceEndpoint{
+						Service:       svc.Name,
+						Namespace:     svc.Namespace,
+						Tenant:        tenant.Name,
+						Address:       addr,
+						Port:          *port.Port,
+						PortName:      portName,
+						AppProtocol:   appProtocol,
+						Protocol:      string(protocol),
+						AddressFamily: family,
+						Metadata: map[string]string{
+							"tier":         tenant.Spec.Tier,
+							"organization": tenant.Spec.OrganizationName,
+						},
+						Health: HealthStatus{
+							Status:    "unknown",
+							LastCheck: now,
+						},
+						UpdatedAt: now,
+					}
+					for k, v := range svc.Labels {
+						ep.Metadata[k] = v
+					}
+					for _, key := range healthAnnotations {
+						if v, ok := svc.Annotations[key]; ok {
+							ep.Metadata[key] = v
+						}
+					}
+					applyPodDiscoveryMetadata(resolvePod(endpoint.TargetRef), &ep)
+					eps = append(eps, ep)
+				}
+			}
+		}
+	}
+	return eps
+}
+
+// refreshConfigMapForTenant re-publishes tenantName's discovery ConfigMap
+// from whatever is currently cached, so incremental per-service updates
+// stay visible to anything reading the ConfigMap directly.
+func (d *Client) refreshConfigMapForTenant(ctx context.Context, tenantName string) error {
+	d.cache.mu.RLock()
+	tenant := d.cache.tenants[tenantName]
+	endpoints := append([]ServiceEndpoint(nil), d.cache.endpoints[tenantName]...)
+	d.cache.mu.RUnlock()
+	if tenant == nil {
+		return nil
+	}
+	return d.updateDiscoveryConfigMap(ctx, tenant, endpoints)
+}
+
+// NeedLeaderElection ensures only the elected manager instance runs the
+// informer subsystem, matching the rest of the manager's Runnables.
+func (d *Client) NeedLeaderElection() bool {
+	return true
+}
@@ -0,0 +1,570 @@
+package discovery
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	consulapi "github.com/hashicorp/consul/api"
+	"github.com/hashicorp/mdns"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+// Registrar is the pluggable backend Client mirrors discovered endpoints
+// into, mirroring the go-micro registry plugin model: an in-memory
+// implementation for single-cluster use, and external drivers (Consul,
+// etcd, mDNS) so other clusters or a service mesh can consume the same
+// discovery data.
+type Registrar interface {
+	// Register upserts ep under its service name. Called again on every
+	// change, including just a health-status refresh.
+	Register(ctx context.Context, ep ServiceEndpoint) error
+	// Deregister removes ep. Implementations key it the same way
+	// Register did (see instanceID), so a caller that only has the
+	// endpoint's identifying fields can still remove it.
+	Deregister(ctx context.Context, ep ServiceEndpoint) error
+	// GetService returns every endpoint currently registered for name.
+	GetService(ctx context.Context, name string) ([]ServiceEndpoint, error)
+	// Watch streams endpoint-list snapshots for name, starting with the
+	// current one, until ctx is cancelled, at which point the channel is
+	// closed.
+	Watch(ctx context.Context, name string) (<-chan []ServiceEndpoint, error)
+}
+
+// RegistryConfig selects and configures the external Registrar backend, if
+// any, a controller manager should mirror discovered endpoints into. Wired
+// from main.go's flags and turned into a Registrar via
+// NewRegistrarFromConfig.
+type RegistryConfig struct {
+	// Backends lists which drivers to fan out to via MultiRegistrar. Each
+	// entry is one of "consul", "etcd", "mdns"; empty disables external
+	// mirroring (Client still writes the JSON ConfigMap either way).
+	Backends []string
+	// TTL is the health-check / lease TTL passed to whichever backends
+	// use one (Consul TTL checks, etcd lease keepalive).
+	TTL time.Duration
+
+	ConsulAddress string
+	EtcdEndpoints []string
+	MDNSDomain    string
+}
+
+// NewRegistrarFromConfig builds the Registrar cfg describes. A single
+// configured backend is returned directly; more than one is wrapped in a
+// MultiRegistrar. An empty Backends list returns (nil, nil), the same as
+// never setting a Registrar at all.
+func NewRegistrarFromConfig(cfg RegistryConfig) (Registrar, error) {
+	var registrars []Registrar
+	for _, backend := range cfg.Backends {
+		switch backend {
+		case "consul":
+			r, err := NewConsulRegistrar(cfg.ConsulAddress, cfg.TTL)
+			if err != nil {
+				return nil, fmt.Errorf("registry config: consul backend: %w", err)
+			}
+			registrars = append(registrars, r)
+		case "etcd":
+			r, err := NewEtcdRegistrar(cfg.EtcdEndpoints, cfg.TTL)
+			if err != nil {
+				return nil, fmt.Errorf("registry config: etcd backend: %w", err)
+			}
+			registrars = append(registrars, r)
+		case "mdns":
+			registrars = append(registrars, NewMDNSRegistrar(cfg.MDNSDomain, cfg.TTL))
+		default:
+			return nil, fmt.Errorf("registry config: unknown backend %q", backend)
+		}
+	}
+	switch len(registrars) {
+	case 0:
+		return nil, nil
+	case 1:
+		return registrars[0], nil
+	default:
+		return NewMultiRegistrar(registrars...), nil
+	}
+}
+
+// MemoryRegistrar is the in-memory Registrar implementation, equivalent to
+// what this package's old standalone Registry/ServiceInfo pair did, now
+// behind the shared interface so it composes with the external backends
+// (e.g. as the primary source a MultiRegistrar fans out from).
+type MemoryRegistrar struct {
+	mu        sync.RWMutex
+	instances map[string]map[string]ServiceEndpoint // service -> instanceID -> endpoint
+	watchers  map[string][]chan []ServiceEndpoint
+}
+
+// NewMemoryRegistrar creates an empty MemoryRegistrar.
+func NewMemoryRegistrar() *MemoryRegistrar {
+	return &MemoryRegistrar{
+		instances: make(map[string]map[string]ServiceEndpoint),
+		watchers:  make(map[string][]chan []ServiceEndpoint),
+	}
+}
+
+func (m *MemoryRegistrar) Register(ctx context.Context, ep ServiceEndpoint) error {
+	m.mu.Lock()
+	if m.instances[ep.Service] == nil {
+		m.instances[ep.Service] = make(map[string]ServiceEndpoint)
+	}
+	m.instances[ep.Service][instanceID(ep)] = ep
+	snapshot := m.snapshotLocked(ep.Service)
+	m.mu.Unlock()
+	m.notify(ep.Service, snapshot)
+	return nil
+}
+
+func (m *MemoryRegistrar) Deregister(ctx context.Context, ep ServiceEndpoint) error {
+	m.mu.Lock()
+	delete(m.instances[ep.Service], instanceID(ep))
+	snapshot := m.snapshotLocked(ep.Service)
+	m.mu.Unlock()
+	m.notify(ep.Service, snapshot)
+	return nil
+}
+
+func (m *MemoryRegistrar) GetService(ctx context.Context, name string) ([]ServiceEndpoint, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.snapshotLocked(name), nil
+}
+
+func (m *MemoryRegistrar) Watch(ctx context.Context, name string) (<-chan []ServiceEndpoint, error) {
+	ch := make(chan []ServiceEndpoint, 1)
+	m.mu.Lock()
+	m.watchers[name] = append(m.watchers[name], ch)
+	ch <- m.snapshotLocked(name)
+	m.mu.Unlock()
+	go func() {
+		<-ctx.Done()
+		m.removeWatcher(name, ch)
+		close(ch)
+	}()
+	return ch, nil
+}
+
+func (m *MemoryRegistrar) snapshotLocked(name string) []ServiceEndpoint {
+	instances := m.instances[name]
+	eps := make([]ServiceEndpoint, 0, len(instances))
+	for _, ep := range instances {
+		eps = append(eps, ep)
+	}
+	return eps
+}
+
+func (m *MemoryRegistrar) notify(name string, snapshot []ServiceEndpoint) {
+	m.mu.RLock()
+	watchers := append([]chan []ServiceEndpoint(nil), m.watchers[name]...)
+	m.mu.RUnlock()
+	for _, ch := range watchers {
+		select {
+		case ch <- snapshot:
+		default:
+			// Slow watcher; drop the update rather than block Register/Deregister.
+		}
+	}
+}
+
+func (m *MemoryRegistrar) removeWatcher(name string, target chan []ServiceEndpoint) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	watchers := m.watchers[name]
+	for i, ch := range watchers {
+		if ch == target {
+			m.watchers[name] = append(watchers[:i], watchers[i+1:]...)
+			return
+		}
+	}
+}
+
+// ConsulRegistrar mirrors endpoints into a Consul agent's service catalog,
+// using a TTL health check so CheckServiceHealth's results (refreshed
+// through Register) drive Consul's view of the endpoint's health.
+type ConsulRegistrar struct {
+	client *consulapi.Client
+	ttl    time.Duration
+}
+
+// NewConsulRegistrar connects to the Consul agent at address (empty uses
+// the client's default, typically http://127.0.0.1:8500).
+func NewConsulRegistrar(address string, ttl time.Duration) (*ConsulRegistrar, error) {
+	cfg := consulapi.DefaultConfig()
+	if address != "" {
+		cfg.Address = address
+	}
+	c, err := consulapi.NewClient(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create consul client: %w", err)
+	}
+	return &ConsulRegistrar{client: c, ttl: ttl}, nil
+}
+
+func (c *ConsulRegistrar) Register(ctx context.Context, ep ServiceEndpoint) error {
+	id := instanceID(ep)
+	reg := &consulapi.AgentServiceRegistration{
+		ID:      id,
+		Name:    ep.Service,
+		Address: ep.Address,
+		Port:    int(ep.Port),
+		Tags:    []string{"tenant:" + ep.Tenant},
+		Check: &consulapi.AgentServiceCheck{
+			TTL:                            c.ttl.String(),
+			DeregisterCriticalServiceAfter: (c.ttl * 10).String(),
+		},
+	}
+	if err := c.client.Agent().ServiceRegister(reg); err != nil {
+		return fmt.Errorf("consul register: %w", err)
+	}
+	checkID := "service:" + id
+	if ep.Health.Status == "healthy" {
+		return c.client.Agent().PassTTL(checkID, ep.Health.Message)
+	}
+	return c.client.Agent().WarnTTL(checkID, ep.Health.Message)
+}
+
+func (c *ConsulRegistrar) Deregister(ctx context.Context, ep ServiceEndpoint) error {
+	if err := c.client.Agent().ServiceDeregister(instanceID(ep)); err != nil {
+		return fmt.Errorf("consul deregister: %w", err)
+	}
+	return nil
+}
+
+func (c *ConsulRegistrar) GetService(ctx context.Context, name string) ([]ServiceEndpoint, error) {
+	entries, _, err := c.client.Health().Service(name, "", true, (&consulapi.QueryOptions{}).WithContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("consul get service: %w", err)
+	}
+	return consulEntriesToEndpoints(name, entries), nil
+}
+
+func (c *ConsulRegistrar) Watch(ctx context.Context, name string) (<-chan []ServiceEndpoint, error) {
+	ch := make(chan []ServiceEndpoint, 1)
+	go c.watchLoop(ctx, name, ch)
+	return ch, nil
+}
+
+// watchLoop long-polls Consul's blocking query API, the same mechanism
+// Consul's own client-side load balancers use to avoid tight-loop polling.
+func (c *ConsulRegistrar) watchLoop(ctx context.Context, name string, ch chan<- []ServiceEndpoint) {
+	defer close(ch)
+	var lastIndex uint64
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		default:
+		}
+		q := (&consulapi.QueryOptions{WaitIndex: lastIndex, WaitTime: 30 * time.Second}).WithContext(ctx)
+		entries, meta, err := c.client.Health().Service(name, "", true, q)
+		if err != nil {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(time.Second):
+			}
+			continue
+		}
+		lastIndex = meta.LastIndex
+		select {
+		case ch <- consulEntriesToEndpoints(name, entries):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func consulEntriesToEndpoints(name string, entries []*consulapi.ServiceEntry) []ServiceEndpoint {
+	eps := make([]ServiceEndpoint, 0, len(entries))
+	for _, e := range entries {
+		eps = append(eps, ServiceEndpoint{
+			Service:       name,
+			Address:       e.Service.Address,
+			Port:          int32(e.Service.Port),
+			AddressFamily: addressFamily(e.Service.Address),
+		})
+	}
+	return eps
+}
+
+// EtcdRegistrar mirrors endpoints as lease-backed keys under prefix,
+// keeping each lease alive for as long as the endpoint stays registered so
+// a crashed writer's endpoints expire on their own.
+type EtcdRegistrar struct {
+	client *clientv3.Client
+	ttl    time.Duration
+	prefix string
+
+	mu     sync.Mutex
+	leases map[string]clientv3.LeaseID
+}
+
+// NewEtcdRegistrar connects to the given etcd endpoints.
+func NewEtcdRegistrar(endpoints []string, ttl time.Duration) (*EtcdRegistrar, error) {
+	c, err := clientv3.New(clientv3.Config{
+		Endpoints:   endpoints,
+		DialTimeout: 5 * time.Second,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create etcd client: %w", err)
+	}
+	return &EtcdRegistrar{
+		client: c,
+		ttl:    ttl,
+		prefix: "/discovery/",
+		leases: make(map[string]clientv3.LeaseID),
+	}, nil
+}
+
+func (e *EtcdRegistrar) key(ep ServiceEndpoint) string {
+	return e.prefix + ep.Service + "/" + instanceID(ep)
+}
+
+func (e *EtcdRegistrar) Register(ctx context.Context, ep ServiceEndpoint) error {
+	lease, err := e.client.Grant(ctx, int64(e.ttl.Seconds()))
+	if err != nil {
+		return fmt.Errorf("etcd lease grant: %w", err)
+	}
+	data, err := json.Marshal(ep)
+	if err != nil {
+		return fmt.Errorf("etcd marshal endpoint: %w", err)
+	}
+	key := e.key(ep)
+	if _, err := e.client.Put(ctx, key, string(data), clientv3.WithLease(lease.ID)); err != nil {
+		return fmt.Errorf("etcd put: %w", err)
+	}
+	keepAlive, err := e.client.KeepAlive(ctx, lease.ID)
+	if err != nil {
+		return fmt.Errorf("etcd keepalive: %w", err)
+	}
+	go func() {
+		for range keepAlive {
+			// Drain keepalive responses; the channel closes on its own
+			// once ctx is cancelled or the lease can no longer be renewed.
+		}
+	}()
+	e.mu.Lock()
+	e.leases[key] = lease.ID
+	e.mu.Unlock()
+	return nil
+}
+
+func (e *EtcdRegistrar) Deregister(ctx context.Context, ep ServiceEndpoint) error {
+	key := e.key(ep)
+	e.mu.Lock()
+	leaseID, ok := e.leases[key]
+	delete(e.leases, key)
+	e.mu.Unlock()
+	if ok {
+		if _, err := e.client.Revoke(ctx, leaseID); err != nil {
+			return fmt.Errorf("etcd lease revoke: %w", err)
+		}
+	}
+	if _, err := e.client.Delete(ctx, key); err != nil {
+		return fmt.Errorf("etcd delete: %w", err)
+	}
+	return nil
+}
+
+func (e *EtcdRegistrar) GetService(ctx context.Context, name string) ([]ServiceEndpoint, error) {
+	resp, err := e.client.Get(ctx, e.prefix+name+"/", clientv3.WithPrefix())
+	if err != nil {
+		return nil, fmt.Errorf("etcd get: %w", err)
+	}
+	eps := make([]ServiceEndpoint, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		var ep ServiceEndpoint
+		if err := json.Unmarshal(kv.Value, &ep); err == nil {
+			eps = append(eps, ep)
+		}
+	}
+	return eps, nil
+}
+
+func (e *EtcdRegistrar) Watch(ctx context.Context, name string) (<-chan []ServiceEndpoint, error) {
+	ch := make(chan []ServiceEndpoint, 1)
+	initial, err := e.GetService(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	ch <- initial
+	go func() {
+		defer close(ch)
+		watchCh := e.client.Watch(ctx, e.prefix+name+"/", clientv3.WithPrefix())
+		for range watchCh {
+			eps, err := e.GetService(ctx, name)
+			if err != nil {
+				continue
+			}
+			select {
+			case ch <- eps:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch, nil
+}
+
+// MDNSRegistrar advertises endpoints as mDNS/DNS-SD services on the local
+// network segment, for discovery by consumers with no shared registry at
+// all (Kratos's and go-micro's mDNS plugins take the same approach).
+type MDNSRegistrar struct {
+	domain string
+	ttl    time.Duration
+
+	mu      sync.Mutex
+	servers map[string]*mdns.Server
+}
+
+// NewMDNSRegistrar creates an MDNSRegistrar advertising under domain (empty
+// defaults to "local."), polling GetService results on roughly ttl.
+func NewMDNSRegistrar(domain string, ttl time.Duration) *MDNSRegistrar {
+	if domain == "" {
+		domain = "local."
+	}
+	return &MDNSRegistrar{
+		domain:  domain,
+		ttl:     ttl,
+		servers: make(map[string]*mdns.Server),
+	}
+}
+
+func (r *MDNSRegistrar) Register(ctx context.Context, ep ServiceEndpoint) error {
+	id := instanceID(ep)
+	var ips []net.IP
+	if ip := net.ParseIP(ep.Address); ip != nil {
+		ips = []net.IP{ip}
+	}
+	svc, err := mdns.NewMDNSService(id, "_"+ep.Service+"._tcp", r.domain, "", int(ep.Port), ips, []string{"tenant=" + ep.Tenant})
+	if err != nil {
+		return fmt.Errorf("mdns service descriptor: %w", err)
+	}
+	server, err := mdns.NewServer(&mdns.Config{Zone: svc})
+	if err != nil {
+		return fmt.Errorf("mdns server: %w", err)
+	}
+	r.mu.Lock()
+	if old, ok := r.servers[id]; ok {
+		old.Shutdown()
+	}
+	r.servers[id] = server
+	r.mu.Unlock()
+	return nil
+}
+
+func (r *MDNSRegistrar) Deregister(ctx context.Context, ep ServiceEndpoint) error {
+	id := instanceID(ep)
+	r.mu.Lock()
+	server, ok := r.servers[id]
+	delete(r.servers, id)
+	r.mu.Unlock()
+	if !ok {
+		return nil
+	}
+	return server.Shutdown()
+}
+
+func (r *MDNSRegistrar) GetService(ctx context.Context, name string) ([]ServiceEndpoint, error) {
+	entriesCh := make(chan *mdns.ServiceEntry, 16)
+	go func() {
+		mdns.Lookup("_"+name+"._tcp", entriesCh)
+		close(entriesCh)
+	}()
+	var eps []ServiceEndpoint
+	for entry := range entriesCh {
+		addr := entry.AddrV4.String()
+		if entry.AddrV4 == nil && entry.AddrV6 != nil {
+			addr = entry.AddrV6.String()
+		}
+		eps = append(eps, ServiceEndpoint{
+			Service:       name,
+			Address:       addr,
+			Port:          int32(entry.Port),
+			AddressFamily: addressFamily(addr),
+		})
+	}
+	return eps, nil
+}
+
+func (r *MDNSRegistrar) Watch(ctx context.Context, name string) (<-chan []ServiceEndpoint, error) {
+	ch := make(chan []ServiceEndpoint, 1)
+	go func() {
+		defer close(ch)
+		ticker := time.NewTicker(r.ttl)
+		defer ticker.Stop()
+		for {
+			if eps, err := r.GetService(ctx, name); err == nil {
+				select {
+				case ch <- eps:
+				case <-ctx.Done():
+					return
+				}
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	return ch, nil
+}
+
+// MultiRegistrar fans Register/Deregister out to every wrapped Registrar —
+// for example mirroring a tenant's services into Consul for a service mesh
+// while a MemoryRegistrar keeps serving in-process lookups — and answers
+// GetService/Watch from the first one, since they're expected to converge
+// on the same membership.
+type MultiRegistrar struct {
+	registrars []Registrar
+}
+
+// NewMultiRegistrar fans out across the given registrars, in order.
+func NewMultiRegistrar(registrars ...Registrar) *MultiRegistrar {
+	return &MultiRegistrar{registrars: registrars}
+}
+
+func (m *MultiRegistrar) Register(ctx context.Context, ep ServiceEndpoint) error {
+	return m.fanOut(func(r Registrar) error { return r.Register(ctx, ep) })
+}
+
+func (m *MultiRegistrar) Deregister(ctx context.Context, ep ServiceEndpoint) error {
+	return m.fanOut(func(r Registrar) error { return r.Deregister(ctx, ep) })
+}
+
+func (m *MultiRegistrar) fanOut(call func(Registrar) error) error {
+	var failures int
+	var firstErr error
+	for _, r := range m.registrars {
+		if err := call(r); err != nil {
+			failures++
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+	if failures > 0 {
+		return fmt.Errorf("%d of %d registrar backends failed; first error: %w", failures, len(m.registrars), firstErr)
+	}
+	return nil
+}
+
+func (m *MultiRegistrar) GetService(ctx context.Context, name string) ([]ServiceEndpoint, error) {
+	if len(m.registrars) == 0 {
+		return nil, nil
+	}
+	return m.registrars[0].GetService(ctx, name)
+}
+
+func (m *MultiRegistrar) Watch(ctx context.Context, name string) (<-chan []ServiceEndpoint, error) {
+	if len(m.registrars) == 0 {
+		ch := make(chan []ServiceEndpoint)
+		close(ch)
+		return ch, nil
+	}
+	return m.registrars[0].Watch(ctx, name)
+}
@@ -0,0 +1,164 @@
+package discovery
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+// Event types for DiscoveryEvent, named to match the Kubernetes watch API's
+// Added/Modified/Deleted vocabulary.
+const (
+	EventAdded    = "ADDED"
+	EventModified = "MODIFIED"
+	EventDeleted  = "DELETED"
+)
+
+// DiscoveryEvent describes one change to a ServiceEndpoint. Revision is a
+// monotonic counter over every event this Client has ever emitted, so a
+// client that disconnects can tell a caller "resume after N" (though this
+// package does not itself buffer history past what's already in flight).
+type DiscoveryEvent struct {
+	Type     string          `json:"type"`
+	Tenant   string          `json:"tenant"`
+	Service  string          `json:"service"`
+	Endpoint ServiceEndpoint `json:"endpoint"`
+	Revision int64           `json:"revision"`
+}
+
+// watcher is one subscriber registered via Watch, optionally filtered to a
+// single tenant and/or service; an empty filter field matches anything.
+type watcher struct {
+	ch      chan DiscoveryEvent
+	tenant  string
+	service string
+}
+
+func (w *watcher) matches(tenant, service string) bool {
+	return (w.tenant == "" || w.tenant == tenant) && (w.service == "" || w.service == service)
+}
+
+// watcherQueueSize bounds how many undelivered events a slow watcher can
+// accumulate before emitWatchEvents starts dropping its events, mirroring
+// MemoryRegistrar's non-blocking notify.
+const watcherQueueSize = 32
+
+// Watch subscribes to ServiceEndpoint changes, optionally filtered to a
+// single tenant and/or service (either may be left empty to match every
+// value). The returned channel is sent an EventAdded for every endpoint
+// currently cached that matches the filter, followed by incremental
+// EventAdded/EventModified/EventDeleted events as they happen. The channel
+// is closed once ctx is done; callers must keep draining it until then to
+// avoid blocking future events for other watchers.
+func (d *Client) Watch(ctx context.Context, tenant, service string) (<-chan DiscoveryEvent, error) {
+	w := &watcher{
+		tenant:  tenant,
+		service: service,
+	}
+
+	// The snapshot is taken and queued under watchMu, with room for all of
+	// it plus watcherQueueSize, so queuing it never blocks and no change
+	// broadcast after the snapshot is missed.
+	d.watchMu.Lock()
+	snapshot := d.snapshotFor(tenant, service)
+	w.ch = make(chan DiscoveryEvent, len(snapshot)+watcherQueueSize)
+	for _, ep := range snapshot {
+		w.ch <- DiscoveryEvent{
+			Type:     EventAdded,
+			Tenant:   ep.Tenant,
+			Service:  ep.Service,
+			Endpoint: ep,
+			Revision: atomic.LoadInt64(&d.revision),
+		}
+	}
+	if d.watchers == nil {
+		d.watchers = make(map[int]*watcher)
+	}
+	id := d.nextWatcherID
+	d.nextWatcherID++
+	d.watchers[id] = w
+	d.watchMu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		d.watchMu.Lock()
+		delete(d.watchers, id)
+		d.watchMu.Unlock()
+		close(w.ch)
+	}()
+
+	return w.ch, nil
+}
+
+// snapshotFor returns every cached endpoint matching tenant/service, either
+// of which may be left empty to match every value.
+func (d *Client) snapshotFor(tenant, service string) []ServiceEndpoint {
+	var result []ServiceEndpoint
+	for t, endpoints := range d.GetAllEndpoints() {
+		if tenant != "" && t != tenant {
+			continue
+		}
+		for _, ep := range endpoints {
+			if service != "" && ep.Service != service {
+				continue
+			}
+			result = append(result, ep)
+		}
+	}
+	return result
+}
+
+// emitWatchEvents diffs previous against current, the same freshly computed
+// before/after pair pushToRegistrar receives, and fans out ADDED/MODIFIED/
+// DELETED events to every registered Watch subscriber whose filter matches.
+// A nil/empty set of watchers makes this a cheap no-op.
+func (d *Client) emitWatchEvents(previous, current []ServiceEndpoint) {
+	d.watchMu.Lock()
+	defer d.watchMu.Unlock()
+	if len(d.watchers) == 0 {
+		return
+	}
+
+	previousByID := make(map[string]ServiceEndpoint, len(previous))
+	for _, ep := range previous {
+		previousByID[instanceID(ep)] = ep
+	}
+	currentByID := make(map[string]bool, len(current))
+
+	for _, ep := range current {
+		id := instanceID(ep)
+		currentByID[id] = true
+		eventType := EventAdded
+		if _, existed := previousByID[id]; existed {
+			eventType = EventModified
+		}
+		d.broadcastLocked(eventType, ep)
+	}
+	for id, ep := range previousByID {
+		if !currentByID[id] {
+			d.broadcastLocked(EventDeleted, ep)
+		}
+	}
+}
+
+// broadcastLocked sends ev to every matching watcher, dropping it for any
+// watcher whose queue is full rather than blocking the caller. d.watchMu
+// must be held.
+func (d *Client) broadcastLocked(eventType string, ep ServiceEndpoint) {
+	rev := atomic.AddInt64(&d.revision, 1)
+	ev := DiscoveryEvent{
+		Type:     eventType,
+		Tenant:   ep.Tenant,
+		Service:  ep.Service,
+		Endpoint: ep,
+		Revision: rev,
+	}
+	for _, w := range d.watchers {
+		if !w.matches(ep.Tenant, ep.Service) {
+			continue
+		}
+		select {
+		case w.ch <- ev:
+		default:
+		}
+	}
+}
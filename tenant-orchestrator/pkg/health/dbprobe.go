@@ -0,0 +1,135 @@
+package health
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const (
+	// defaultProbeTimeout bounds how long a single liveness/replication query
+	// may take before the probe is considered failed.
+	defaultProbeTimeout = 5 * time.Second
+	// probeBackoffBase and probeBackoffMax bound how quickly repeated probe
+	// failures back off, so a wedged database doesn't get hammered with new
+	// connection attempts on every CheckTenantHealth call.
+	probeBackoffBase = 30 * time.Second
+	probeBackoffMax  = 10 * time.Minute
+)
+
+var (
+	dbQueryLatency = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name: "tenant_db_query_latency_seconds",
+			Help: "Latency of the tenant database liveness query",
+		},
+		[]string{"tenant"},
+	)
+	dbReplicationLagBytes = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "tenant_db_replication_lag_bytes",
+			Help: "Replication replay lag behind the primary, in bytes (0 if not a replica)",
+		},
+		[]string{"tenant"},
+	)
+	dbConnectionsUsed = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "tenant_db_connections_used",
+			Help: "Active connections on the tenant database at probe time",
+		},
+		[]string{"tenant"},
+	)
+	dbUp = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "tenant_db_up",
+			Help: "Whether the tenant database answered its liveness probe (1) or not (0)",
+		},
+		[]string{"tenant"},
+	)
+)
+
+// dbStatus is the outcome of a single checkDatabaseHealth call. unknown is
+// distinct from an unhealthy probe: it means the probe could not be
+// attempted at all, most often because the tenant's DB secret isn't
+// materialized yet.
+type dbStatus string
+
+const (
+	dbStatusHealthy   dbStatus = "healthy"
+	dbStatusUnhealthy dbStatus = "unhealthy"
+	dbStatusUnknown   dbStatus = "unknown"
+)
+
+// probeBackoff tracks consecutive probe failures per tenant so a tenant
+// whose database is down doesn't get a fresh connection attempt on every
+// health check.
+type probeBackoff struct {
+	mu    sync.Mutex
+	state map[string]*backoffState
+}
+
+type backoffState struct {
+	consecutiveFailures int
+	nextAttempt         time.Time
+}
+
+func newProbeBackoff() *probeBackoff {
+	return &probeBackoff{state: make(map[string]*backoffState)}
+}
+
+// blocked reports whether tenant is still within its backoff window and, if
+// so, how long remains.
+func (b *probeBackoff) blocked(tenant string, now time.Time) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	s, ok := b.state[tenant]
+	return ok && now.Before(s.nextAttempt)
+}
+
+func (b *probeBackoff) recordFailure(tenant string, now time.Time) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	s, ok := b.state[tenant]
+	if !ok {
+		s = &backoffState{}
+		b.state[tenant] = s
+	}
+	s.consecutiveFailures++
+	delay := probeBackoffBase * time.Duration(1<<uint(min(s.consecutiveFailures-1, 6)))
+	if delay > probeBackoffMax {
+		delay = probeBackoffMax
+	}
+	s.nextAttempt = now.Add(delay)
+}
+
+func (b *probeBackoff) recordSuccess(tenant string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.state, tenant)
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// dbPort returns the default port for a Tenant.Spec.Database.Type value.
+func dbPort(engine string) int {
+	switch engine {
+	case "mysql":
+		return 3306
+	case "mssql":
+		return 1433
+	default:
+		return 5432
+	}
+}
+
+func dbCredentialsSecretName(tenantName string) string {
+	return fmt.Sprintf("%s-db-credentials", tenantName)
+}
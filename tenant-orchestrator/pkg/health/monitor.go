@@ -3,12 +3,18 @@ package health
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	tenantv1alpha1 "github.com/rezenkai/multi-saas-crm/tenant-orchestrator/api/v1alpha1"
 	"github.com/rezenkai/multi-saas-crm/tenant-orchestrator/pkg/discovery"
+	"github.com/rezenkai/multi-saas-crm/tenant-orchestrator/pkg/health/probes"
 	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -22,19 +28,31 @@ var (
 		},
 		[]string{"tenant", "service"},
 	)
+	clusterHealth = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "tenant_cluster_health_status",
+			Help: "Reachability of a tenant's placed member clusters (1 = reachable, 0 = unreachable)",
+		},
+		[]string{"tenant", "cluster"},
+	)
 )
 
 // Monitor manages tenant health checks
 type Monitor struct {
-	client   client.Client
+	client    client.Client
 	discovery *discovery.Client
+
+	probeTimeout time.Duration
+	backoff      *probeBackoff
 }
 
 // NewMonitor creates a new health monitor
 func NewMonitor(c client.Client, d *discovery.Client) *Monitor {
 	return &Monitor{
-		client:   c,
-		discovery: d,
+		client:       c,
+		discovery:    d,
+		probeTimeout: defaultProbeTimeout,
+		backoff:      newProbeBackoff(),
 	}
 }
 
@@ -56,34 +74,182 @@ func (m *Monitor) CheckTenantHealth(ctx context.Context, tenant *tenantv1alpha1.
 	}
 
 	// Check database health
-	dbHealthy, err := m.checkDatabaseHealth(ctx, tenant)
-	if err != nil {
-		log.Error(err, "Failed to check database health")
+	status, err := m.checkDatabaseHealth(ctx, tenant)
+	switch status {
+	case dbStatusHealthy:
+		tenantHealth.WithLabelValues(tenant.Name, "database").Set(1)
+	case dbStatusUnhealthy:
+		tenantHealth.WithLabelValues(tenant.Name, "database").Set(0)
 		overallHealthy = false
+		log.Error(err, "Database health check failed")
+	case dbStatusUnknown:
+		// Neither healthy nor unhealthy: don't flip overallHealthy or the
+		// gauge, since we couldn't actually reach the database.
+		log.Info("Database health unknown", "reason", err)
+	}
+
+	// Roll up per-member-cluster health for multi-cluster tenants
+	if tenant.Spec.Placement != nil {
+		for _, cs := range tenant.Status.ClusterStatuses {
+			healthy := m.checkClusterHealth(ctx, cs.Name)
+			clusterHealth.WithLabelValues(tenant.Name, cs.Name).Set(boolToFloat64(healthy))
+			if !healthy {
+				overallHealthy = false
+				log.Info("Member cluster unhealthy", "cluster", cs.Name)
+			}
+		}
 	}
-	tenantHealth.WithLabelValues(tenant.Name, "database").Set(boolToFloat64(dbHealthy))
 
 	return overallHealthy, nil
 }
 
-// checkDatabaseHealth performs a health check on the tenant's database
-func (m *Monitor) checkDatabaseHealth(ctx context.Context, tenant *tenantv1alpha1.Tenant) (bool, error) {
-	log := log.FromContext(ctx).WithValues("tenant", tenant.Name)
+// checkClusterHealth verifies a member cluster is reachable by listing its
+// tenant-system namespace through the registered per-cluster client.
+func (m *Monitor) checkClusterHealth(ctx context.Context, clusterName string) bool {
+	memberClient, ok := m.discovery.ClusterClient(clusterName)
+	if !ok {
+		return false
+	}
+	probeCtx, cancel := context.WithTimeout(ctx, m.probeTimeout)
+	defer cancel()
+	ns := &corev1.Namespace{}
+	return memberClient.Get(probeCtx, types.NamespacedName{Name: "tenant-system"}, ns) == nil
+}
+
+// checkDatabaseHealth verifies the database StatefulSet has ready replicas,
+// then opens a short-lived connection to probe liveness, replica role, and
+// replication lag. Failures are surfaced onto tenant.Status.Conditions so
+// `kubectl describe tenant` shows the root cause.
+func (m *Monitor) checkDatabaseHealth(ctx context.Context, tenant *tenantv1alpha1.Tenant) (dbStatus, error) {
 	statefulSet := &appsv1.StatefulSet{}
 	err := m.client.Get(ctx, types.NamespacedName{
 		Name:      fmt.Sprintf("%s-db", tenant.Name),
 		Namespace: fmt.Sprintf("tenant-%s", tenant.Name),
 	}, statefulSet)
 	if err != nil {
-		return false, fmt.Errorf("failed to get database StatefulSet: %w", err)
+		return m.failDatabase(tenant, fmt.Errorf("failed to get database StatefulSet: %w", err))
 	}
 	if statefulSet.Status.ReadyReplicas == 0 {
-		return false, fmt.Errorf("database StatefulSet has no ready replicas")
+		return m.failDatabase(tenant, fmt.Errorf("database StatefulSet has no ready replicas"))
+	}
+
+	if m.backoff.blocked(tenant.Name, time.Now()) {
+		return dbStatusUnknown, fmt.Errorf("skipping probe: backing off after repeated failures")
+	}
+
+	creds, err := m.fetchCredentials(ctx, tenant)
+	if errors.IsNotFound(err) {
+		return dbStatusUnknown, fmt.Errorf("database credentials not yet materialized")
+	}
+	if err != nil {
+		return m.failDatabase(tenant, fmt.Errorf("failed to read database credentials: %w", err))
+	}
+
+	probe, err := probes.NewProbe(tenant.Spec.Database.Type)
+	if err != nil {
+		return m.failDatabase(tenant, err)
+	}
+
+	probeCtx, cancel := context.WithTimeout(ctx, m.probeTimeout)
+	defer cancel()
+	result, err := probe.Check(probeCtx, creds)
+	if err != nil {
+		return m.failDatabase(tenant, fmt.Errorf("database probe failed: %w", err))
 	}
 
-	// Optional: Add a database connectivity check (e.g., using a simple query)
-	// This requires database credentials and a client (e.g., lib/pq for PostgreSQL)
-	return true, nil
+	dbUp.WithLabelValues(tenant.Name).Set(boolToFloat64(result.Up))
+	dbQueryLatency.WithLabelValues(tenant.Name).Observe(result.Latency.Seconds())
+	dbReplicationLagBytes.WithLabelValues(tenant.Name).Set(float64(result.ReplicationLagBytes))
+	dbConnectionsUsed.WithLabelValues(tenant.Name).Set(float64(result.ConnectionsUsed))
+
+	if !result.Up {
+		return m.failDatabase(tenant, fmt.Errorf("database did not respond to liveness probe"))
+	}
+
+	if ha := tenant.Spec.Database.HighAvailability; ha != nil {
+		inSync, err := m.countSyncReplicas(ctx, tenant)
+		if err != nil {
+			return m.failDatabase(tenant, fmt.Errorf("failed to count in-sync replicas: %w", err))
+		}
+		if inSync < ha.MinSyncReplicas {
+			return m.failDatabase(tenant, fmt.Errorf("only %d of %d required replicas are in sync", inSync, ha.MinSyncReplicas))
+		}
+	}
+
+	m.backoff.recordSuccess(tenant.Name)
+	meta.SetStatusCondition(&tenant.Status.Conditions, metav1.Condition{
+		Type:    "DatabaseHealthy",
+		Status:  metav1.ConditionTrue,
+		Reason:  "ProbeSucceeded",
+		Message: "Database liveness probe succeeded",
+	})
+	return dbStatusHealthy, nil
+}
+
+// countSyncReplicas counts the tenant's database pods Patroni currently
+// reports (via its spilo-role pod label) as streaming replicas and marks
+// Ready, i.e. caught up enough to serve read traffic or take over on
+// failover.
+func (m *Monitor) countSyncReplicas(ctx context.Context, tenant *tenantv1alpha1.Tenant) (int32, error) {
+	podList := &corev1.PodList{}
+	err := m.client.List(ctx, podList,
+		client.InNamespace(fmt.Sprintf("tenant-%s", tenant.Name)),
+		client.MatchingLabels{"app": "postgres", "tenant": tenant.Name, "spilo-role": "replica"},
+	)
+	if err != nil {
+		return 0, err
+	}
+	var ready int32
+	for _, pod := range podList.Items {
+		for _, cond := range pod.Status.Conditions {
+			if cond.Type == corev1.PodReady && cond.Status == corev1.ConditionTrue {
+				ready++
+				break
+			}
+		}
+	}
+	return ready, nil
+}
+
+// failDatabase records a probe failure for backoff purposes, sets dbUp to 0,
+// and surfaces the root cause onto tenant.Status.Conditions.
+func (m *Monitor) failDatabase(tenant *tenantv1alpha1.Tenant, cause error) (dbStatus, error) {
+	m.backoff.recordFailure(tenant.Name, time.Now())
+	dbUp.WithLabelValues(tenant.Name).Set(0)
+	meta.SetStatusCondition(&tenant.Status.Conditions, metav1.Condition{
+		Type:    "DatabaseHealthy",
+		Status:  metav1.ConditionFalse,
+		Reason:  "ProbeFailed",
+		Message: cause.Error(),
+	})
+	return dbStatusUnhealthy, cause
+}
+
+// fetchCredentials reads the tenant's database secret, created by
+// TenantReconciler.reconcileDatabase.
+func (m *Monitor) fetchCredentials(ctx context.Context, tenant *tenantv1alpha1.Tenant) (probes.Credentials, error) {
+	secret := &corev1.Secret{}
+	err := m.client.Get(ctx, types.NamespacedName{
+		Name:      dbCredentialsSecretName(tenant.Name),
+		Namespace: fmt.Sprintf("tenant-%s", tenant.Name),
+	}, secret)
+	if err != nil {
+		return probes.Credentials{}, err
+	}
+	svcName := fmt.Sprintf("%s-db-svc", tenant.Name)
+	if tenant.Spec.Database.HighAvailability != nil {
+		// In HA mode the headless governing service has no single backing
+		// endpoint; probe the role-selector service that always routes to
+		// whichever pod Patroni currently holds the leader lock for.
+		svcName = fmt.Sprintf("%s-db-master", tenant.Name)
+	}
+	return probes.Credentials{
+		Host:     fmt.Sprintf("%s.tenant-%s.svc.cluster.local", svcName, tenant.Name),
+		Port:     dbPort(tenant.Spec.Database.Type),
+		User:     string(secret.Data["username"]),
+		Password: string(secret.Data["password"]),
+		Database: string(secret.Data["database"]),
+	}, nil
 }
 
 // boolToFloat64 converts a boolean to a Prometheus-compatible float64
@@ -92,4 +258,4 @@ func boolToFloat64(b bool) float64 {
 		return 1.0
 	}
 	return 0.0
-}
\ No newline at end of file
+}
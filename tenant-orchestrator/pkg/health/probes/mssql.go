@@ -0,0 +1,30 @@
+package probes
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"time"
+)
+
+// mssqlProbe checks liveness by shelling out to sqlcmd rather than opening a
+// direct connection like postgresProbe/mysqlProbe, since this repo vendors
+// no Go driver for SQL Server. Replica role and lag aren't reported; SQL
+// Server's HA story (Always On availability groups) doesn't map onto the
+// streaming-replica model the other probes check.
+type mssqlProbe struct{}
+
+func (p *mssqlProbe) Check(ctx context.Context, creds Credentials) (Result, error) {
+	start := time.Now()
+	cmd := exec.CommandContext(ctx, "/opt/mssql-tools/bin/sqlcmd",
+		"-S", fmt.Sprintf("%s,%d", creds.Host, creds.Port),
+		"-U", creds.User,
+		"-d", creds.Database,
+		"-Q", "SELECT 1",
+	)
+	cmd.Env = append(cmd.Env, fmt.Sprintf("SQLCMDPASSWORD=%s", creds.Password))
+	if err := cmd.Run(); err != nil {
+		return Result{}, fmt.Errorf("sqlcmd liveness query: %w", err)
+	}
+	return Result{Up: true, Latency: time.Since(start)}, nil
+}
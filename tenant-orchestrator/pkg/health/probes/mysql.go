@@ -0,0 +1,101 @@
+package probes
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	_ "github.com/go-sql-driver/mysql"
+)
+
+type mysqlProbe struct{}
+
+func (p *mysqlProbe) Check(ctx context.Context, creds Credentials) (Result, error) {
+	dsn := fmt.Sprintf(
+		"%s:%s@tcp(%s:%d)/%s?timeout=5s&tls=preferred",
+		creds.User, creds.Password, creds.Host, creds.Port, creds.Database,
+	)
+	db, err := sql.Open("mysql", dsn)
+	if err != nil {
+		return Result{}, fmt.Errorf("open mysql connection: %w", err)
+	}
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+
+	var result Result
+	start := time.Now()
+	if _, err := db.ExecContext(ctx, "SELECT 1"); err != nil {
+		return Result{}, fmt.Errorf("liveness query: %w", err)
+	}
+	result.Up = true
+	result.Latency = time.Since(start)
+
+	slaveStatus, err := queryRowMap(ctx, db, "SHOW SLAVE STATUS")
+	if err != nil {
+		return result, fmt.Errorf("replica status query: %w", err)
+	}
+	if slaveStatus != nil {
+		result.IsReplica = true
+		if behind, ok := slaveStatus["Seconds_Behind_Master"]; ok && behind != "" {
+			masterPos, _ := parseInt64(slaveStatus["Read_Master_Log_Pos"])
+			relayPos, _ := parseInt64(slaveStatus["Exec_Master_Log_Pos"])
+			if masterPos > relayPos {
+				result.ReplicationLagBytes = masterPos - relayPos
+			}
+		}
+	}
+
+	status, err := queryRowMap(ctx, db, "SHOW STATUS LIKE 'Threads_connected'")
+	if err != nil {
+		return result, fmt.Errorf("connection count query: %w", err)
+	}
+	if status != nil {
+		if n, ok := parseInt64(status["Value"]); ok {
+			result.ConnectionsUsed = int(n)
+		}
+	}
+
+	return result, nil
+}
+
+// queryRowMap runs query and returns its first row as a column-name->value
+// map, or nil if the query returned no rows (e.g. SHOW SLAVE STATUS on a
+// primary). MySQL's SHOW statements don't support scanning into typed
+// destinations directly since their column sets vary by server version.
+func queryRowMap(ctx context.Context, db *sql.DB, query string) (map[string]string, error) {
+	rows, err := db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		return nil, rows.Err()
+	}
+	cols, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	raw := make([]sql.NullString, len(cols))
+	dest := make([]interface{}, len(cols))
+	for i := range raw {
+		dest[i] = &raw[i]
+	}
+	if err := rows.Scan(dest...); err != nil {
+		return nil, err
+	}
+	result := make(map[string]string, len(cols))
+	for i, col := range cols {
+		result[col] = raw[i].String
+	}
+	return result, nil
+}
+
+func parseInt64(s string) (int64, bool) {
+	var n int64
+	if _, err := fmt.Sscanf(s, "%d", &n); err != nil {
+		return 0, false
+	}
+	return n, true
+}
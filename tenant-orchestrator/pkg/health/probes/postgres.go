@@ -0,0 +1,63 @@
+package probes
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	_ "github.com/lib/pq"
+)
+
+type postgresProbe struct{}
+
+func (p *postgresProbe) Check(ctx context.Context, creds Credentials) (Result, error) {
+	dsn := fmt.Sprintf(
+		"host=%s port=%d user=%s password=%s dbname=%s sslmode=require connect_timeout=5",
+		creds.Host, creds.Port, creds.User, creds.Password, creds.Database,
+	)
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		return Result{}, fmt.Errorf("open postgres connection: %w", err)
+	}
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+
+	var result Result
+	start := time.Now()
+	if _, err := db.ExecContext(ctx, "SELECT 1"); err != nil {
+		return Result{}, fmt.Errorf("liveness query: %w", err)
+	}
+	result.Up = true
+	result.Latency = time.Since(start)
+
+	if err := db.QueryRowContext(ctx, "SELECT pg_is_in_recovery()").Scan(&result.IsReplica); err != nil {
+		return result, fmt.Errorf("replica role query: %w", err)
+	}
+
+	if result.IsReplica {
+		var lag sql.NullInt64
+		err := db.QueryRowContext(ctx, `
+			SELECT pg_wal_lsn_diff(pg_last_wal_receive_lsn(), pg_last_wal_replay_lsn())::bigint
+		`).Scan(&lag)
+		if err != nil {
+			return result, fmt.Errorf("replication lag query: %w", err)
+		}
+		if lag.Valid {
+			result.ReplicationLagBytes = lag.Int64
+		}
+	}
+
+	var connections sql.NullInt64
+	err = db.QueryRowContext(ctx, `
+		SELECT count(*) FROM pg_stat_activity WHERE datname = current_database()
+	`).Scan(&connections)
+	if err != nil {
+		return result, fmt.Errorf("connection count query: %w", err)
+	}
+	if connections.Valid {
+		result.ConnectionsUsed = int(connections.Int64)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,55 @@
+// Package probes implements per-database-engine health checks: liveness,
+// replica role, and replication lag, each backed by a short-lived SQL
+// connection using the tenant's own database credentials.
+package probes
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Credentials are the connection parameters read from a tenant's DB secret.
+type Credentials struct {
+	Host     string
+	Port     int
+	User     string
+	Password string
+	Database string
+}
+
+// Result is the outcome of a single probe run.
+type Result struct {
+	// Up reports whether the liveness query (SELECT 1) succeeded.
+	Up bool
+	// Latency is how long the liveness query took.
+	Latency time.Duration
+	// IsReplica reports whether the instance is currently a read replica.
+	IsReplica bool
+	// ReplicationLagBytes is the replay lag behind the primary, in bytes.
+	// Zero when the instance is not a replica or lag could not be determined.
+	ReplicationLagBytes int64
+	// ConnectionsUsed is the number of active connections at probe time.
+	ConnectionsUsed int
+}
+
+// Probe checks the liveness, replica role, and replication lag of a single
+// database instance.
+type Probe interface {
+	Check(ctx context.Context, creds Credentials) (Result, error)
+}
+
+// NewProbe returns the Probe implementation for engine ("postgres", "mysql",
+// or "mssql", matching Tenant.Spec.Database.Type).
+func NewProbe(engine string) (Probe, error) {
+	switch engine {
+	case "postgres", "":
+		return &postgresProbe{}, nil
+	case "mysql":
+		return &mysqlProbe{}, nil
+	case "mssql":
+		return &mssqlProbe{}, nil
+	default:
+		return nil, fmt.Errorf("unsupported database engine %q", engine)
+	}
+}
@@ -0,0 +1,79 @@
+package ingress
+
+import (
+	"fmt"
+
+	tenantv1alpha1 "github.com/rezenkai/multi-saas-crm/tenant-orchestrator/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// apisixProvider targets the Apache APISIX ingress controller's
+// apisix.apache.org/v2 CRDs, built as unstructured.Unstructured for the same
+// reason traefikProvider is: this repo doesn't vendor a third-party ingress
+// controller's generated client.
+type apisixProvider struct{}
+
+var (
+	apisixGroupVersion = schema.GroupVersion{Group: "apisix.apache.org", Version: "v2"}
+	apisixRouteGVK     = apisixGroupVersion.WithKind("ApisixRoute")
+	apisixTLSGVK       = apisixGroupVersion.WithKind("ApisixTls")
+)
+
+func (p *apisixProvider) Name() string { return "apisix" }
+
+func (p *apisixProvider) Build(tenant *tenantv1alpha1.Tenant) ([]client.Object, error) {
+	if len(tenant.Spec.Domains) == 0 {
+		return nil, nil
+	}
+	ns := fmt.Sprintf("tenant-%s", tenant.Name)
+
+	tls := newUnstructured(apisixTLSGVK, fmt.Sprintf("%s-tls", tenant.Name), ns)
+	if err := unstructured.SetNestedStringSlice(tls.Object, tenant.Spec.Domains, "spec", "hosts"); err != nil {
+		return nil, fmt.Errorf("failed to build ApisixTls hosts: %w", err)
+	}
+	if err := unstructured.SetNestedField(tls.Object, fmt.Sprintf("%s-tls", tenant.Name), "spec", "secret", "name"); err != nil {
+		return nil, fmt.Errorf("failed to build ApisixTls secret ref: %w", err)
+	}
+	if err := unstructured.SetNestedField(tls.Object, ns, "spec", "secret", "namespace"); err != nil {
+		return nil, fmt.Errorf("failed to build ApisixTls secret ref: %w", err)
+	}
+
+	route := newUnstructured(apisixRouteGVK, fmt.Sprintf("%s-route", tenant.Name), ns)
+	httpRules := []interface{}{
+		map[string]interface{}{
+			"name": "default",
+			"match": map[string]interface{}{
+				"hosts": toInterfaceSlice(tenant.Spec.Domains),
+				"paths": []interface{}{"/*"},
+			},
+			"backends": []interface{}{
+				map[string]interface{}{
+					"serviceName": fmt.Sprintf("%s-gateway-svc", tenant.Name),
+					"servicePort": int64(80),
+				},
+			},
+		},
+	}
+	if err := unstructured.SetNestedSlice(route.Object, httpRules, "spec", "http"); err != nil {
+		return nil, fmt.Errorf("failed to build ApisixRoute http rules: %w", err)
+	}
+
+	return []client.Object{tls, route}, nil
+}
+
+func (p *apisixProvider) Owns(bldr *builder.Builder) *builder.Builder {
+	return bldr.
+		Owns(newGVKOnly(apisixRouteGVK)).
+		Owns(newGVKOnly(apisixTLSGVK))
+}
+
+func toInterfaceSlice(ss []string) []interface{} {
+	out := make([]interface{}, len(ss))
+	for i, s := range ss {
+		out[i] = s
+	}
+	return out
+}
@@ -0,0 +1,70 @@
+package ingress
+
+import (
+	"fmt"
+
+	tenantv1alpha1 "github.com/rezenkai/multi-saas-crm/tenant-orchestrator/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// kourierProvider targets Kourier, Knative Serving's Envoy-based ingress
+// gateway. Kourier has no CRD of its own: it reconciles Knative's
+// networking.internal.knative.dev/v1alpha1 Ingress resource and programs its
+// Envoy data plane from that, so that's the object this provider renders
+// rather than an Envoy config directly.
+type kourierProvider struct{}
+
+var knativeIngressGVK = schema.GroupVersion{Group: "networking.internal.knative.dev", Version: "v1alpha1"}.WithKind("Ingress")
+
+func (p *kourierProvider) Name() string { return "kourier" }
+
+func (p *kourierProvider) Build(tenant *tenantv1alpha1.Tenant) ([]client.Object, error) {
+	if len(tenant.Spec.Domains) == 0 {
+		return nil, nil
+	}
+	ns := fmt.Sprintf("tenant-%s", tenant.Name)
+	ing := newUnstructured(knativeIngressGVK, fmt.Sprintf("%s-ingress", tenant.Name), ns)
+	ing.SetAnnotations(map[string]string{"networking.knative.dev/ingress-class": "kourier.ingress.networking.knative.dev"})
+
+	rules := []interface{}{
+		map[string]interface{}{
+			"hosts":      toInterfaceSlice(tenant.Spec.Domains),
+			"visibility": "ExternalIP",
+			"http": map[string]interface{}{
+				"paths": []interface{}{
+					map[string]interface{}{
+						"splits": []interface{}{
+							map[string]interface{}{
+								"serviceName":      fmt.Sprintf("%s-gateway-svc", tenant.Name),
+								"serviceNamespace": ns,
+								"servicePort":      int64(80),
+								"percent":          int64(100),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	if err := unstructured.SetNestedSlice(ing.Object, rules, "spec", "rules"); err != nil {
+		return nil, fmt.Errorf("failed to build Knative Ingress rules: %w", err)
+	}
+	tls := []interface{}{
+		map[string]interface{}{
+			"hosts":      toInterfaceSlice(tenant.Spec.Domains),
+			"secretName": fmt.Sprintf("%s-tls", tenant.Name),
+		},
+	}
+	if err := unstructured.SetNestedSlice(ing.Object, tls, "spec", "tls"); err != nil {
+		return nil, fmt.Errorf("failed to build Knative Ingress tls: %w", err)
+	}
+
+	return []client.Object{ing}, nil
+}
+
+func (p *kourierProvider) Owns(bldr *builder.Builder) *builder.Builder {
+	return bldr.Owns(newGVKOnly(knativeIngressGVK))
+}
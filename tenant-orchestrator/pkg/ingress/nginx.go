@@ -0,0 +1,154 @@
+package ingress
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	tenantv1alpha1 "github.com/rezenkai/multi-saas-crm/tenant-orchestrator/api/v1alpha1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// tlsProtocols, in ascending order, mirrors the protocol names OpenSSL/NGINX
+// accept in an `ssl_protocols` directive.
+var tlsProtocols = []string{"TLSv1", "TLSv1.1", "TLSv1.2", "TLSv1.3"}
+
+// sslProtocolsFrom renders the `ssl_protocols` value that accepts min and
+// every newer protocol. An unrecognized min is passed through verbatim.
+func sslProtocolsFrom(min string) string {
+	for i, v := range tlsProtocols {
+		if v == min {
+			return strings.Join(tlsProtocols[i:], " ")
+		}
+	}
+	return min
+}
+
+// nginxProvider is the original, and still default, ingress-nginx +
+// cert-manager behavior: a single networking.k8s.io/v1 Ingress per tenant.
+type nginxProvider struct{}
+
+func (p *nginxProvider) Name() string { return "nginx" }
+
+func (p *nginxProvider) Build(tenant *tenantv1alpha1.Tenant) ([]client.Object, error) {
+	if len(tenant.Spec.Domains) == 0 {
+		return nil, nil
+	}
+	pathType := networkingv1.PathTypePrefix
+	var rules []networkingv1.IngressRule
+	for _, domain := range tenant.Spec.Domains {
+		rules = append(rules, networkingv1.IngressRule{
+			Host: domain,
+			IngressRuleValue: networkingv1.IngressRuleValue{
+				HTTP: &networkingv1.HTTPIngressRuleValue{
+					Paths: []networkingv1.HTTPIngressPath{
+						{
+							Path:     "/",
+							PathType: &pathType,
+							Backend: networkingv1.IngressBackend{
+								Service: &networkingv1.IngressServiceBackend{
+									Name: fmt.Sprintf("%s-gateway-svc", tenant.Name),
+									Port: networkingv1.ServiceBackendPort{Number: 80},
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+	}
+	ns := fmt.Sprintf("tenant-%s", tenant.Name)
+	annotations := map[string]string{
+		"kubernetes.io/ingress.class":                 "nginx",
+		"nginx.ingress.kubernetes.io/ssl-redirect":    "true",
+		"nginx.ingress.kubernetes.io/proxy-body-size": "100m",
+	}
+	tlsSecretName := fmt.Sprintf("%s-tls", tenant.Name)
+	tls := tenant.Spec.TLS
+	switch {
+	case tls == nil || (tls.BringYourOwnSecret == "" && tls.IssuerRef == nil):
+		// Preserve the operator's previous hard-coded default so existing
+		// tenants that haven't set Spec.TLS keep working unchanged.
+		annotations["cert-manager.io/cluster-issuer"] = "letsencrypt-prod"
+	case tls.BringYourOwnSecret != "":
+		tlsSecretName = tls.BringYourOwnSecret
+	case tls.IssuerRef != nil:
+		issuerAnnotation := "cert-manager.io/cluster-issuer"
+		if tls.IssuerRef.Kind == "Issuer" {
+			issuerAnnotation = "cert-manager.io/issuer"
+		}
+		annotations[issuerAnnotation] = tls.IssuerRef.Name
+	}
+	if tls != nil {
+		if tls.SSLRedirect != nil {
+			annotations["nginx.ingress.kubernetes.io/ssl-redirect"] = strconv.FormatBool(*tls.SSLRedirect)
+		}
+		if tls.ForceSSLRedirect {
+			annotations["nginx.ingress.kubernetes.io/force-ssl-redirect"] = "true"
+		}
+		if tls.HSTS != nil {
+			annotations["nginx.ingress.kubernetes.io/hsts"] = "true"
+			if tls.HSTS.MaxAgeSeconds > 0 {
+				annotations["nginx.ingress.kubernetes.io/hsts-max-age"] = strconv.Itoa(int(tls.HSTS.MaxAgeSeconds))
+			}
+			if tls.HSTS.IncludeSubDomains {
+				annotations["nginx.ingress.kubernetes.io/hsts-include-subdomains"] = "true"
+			}
+		}
+		if tls.FromToWWWRedirect != nil && tls.FromToWWWRedirect.PreferredHost == "www" {
+			// ingress-nginx has a built-in annotation for exactly this
+			// direction: redirect the bare domain to its www counterpart.
+			annotations["nginx.ingress.kubernetes.io/from-to-www-redirect"] = "true"
+		}
+		// ssl_protocols/ssl_ciphers and apex-preferred www redirection have
+		// no dedicated ingress-nginx annotation, so they're rendered as raw
+		// server-block directives via server-snippet.
+		var snippet []string
+		if tls.MinTLSVersion != "" {
+			snippet = append(snippet, fmt.Sprintf("ssl_protocols %s;", sslProtocolsFrom(tls.MinTLSVersion)))
+		}
+		if tls.Ciphers != "" {
+			snippet = append(snippet, fmt.Sprintf("ssl_ciphers %s;", tls.Ciphers))
+		}
+		if tls.FromToWWWRedirect != nil && tls.FromToWWWRedirect.PreferredHost == "apex" && len(tenant.Spec.Domains) > 0 {
+			apex := tenant.Spec.Domains[0]
+			snippet = append(snippet, fmt.Sprintf(
+				"if ($host = 'www.%s') { return 308 https://%s$request_uri; }", apex, apex))
+		}
+		if len(snippet) > 0 {
+			annotations["nginx.ingress.kubernetes.io/server-snippet"] = strings.Join(snippet, "\n")
+		}
+	}
+	if cc := tenant.Spec.ClientCertificates; cc != nil {
+		verify := cc.VerifyClient
+		if verify == "" {
+			verify = "on"
+		}
+		secretRef := fmt.Sprintf("%s/%s", ns, cc.SecretName)
+		annotations["nginx.ingress.kubernetes.io/auth-tls-secret"] = secretRef
+		annotations["nginx.ingress.kubernetes.io/auth-tls-verify-client"] = verify
+		annotations["nginx.ingress.kubernetes.io/proxy-ssl-secret"] = secretRef
+		annotations["nginx.ingress.kubernetes.io/proxy-ssl-verify"] = "on"
+	}
+	ing := &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        fmt.Sprintf("%s-ingress", tenant.Name),
+			Namespace:   ns,
+			Annotations: annotations,
+		},
+		Spec: networkingv1.IngressSpec{
+			TLS: []networkingv1.IngressTLS{
+				{Hosts: tenant.Spec.Domains, SecretName: tlsSecretName},
+			},
+			Rules: rules,
+		},
+	}
+	return []client.Object{ing}, nil
+}
+
+func (p *nginxProvider) Owns(bldr *builder.Builder) *builder.Builder {
+	return bldr.Owns(&networkingv1.Ingress{})
+}
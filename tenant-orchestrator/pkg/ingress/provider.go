@@ -0,0 +1,81 @@
+// Package ingress abstracts the objects TenantReconciler creates to route
+// external traffic to a tenant, so the operator isn't hardcoded to
+// ingress-nginx. A Provider owns everything about one ingress controller's
+// object model; TenantReconciler only ever calls Provider.Build and
+// Provider.Owns.
+package ingress
+
+import (
+	"fmt"
+
+	tenantv1alpha1 "github.com/rezenkai/multi-saas-crm/tenant-orchestrator/api/v1alpha1"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DefaultProviderName is used when a Tenant leaves Spec.Ingress unset and the
+// operator wasn't started with a different --default-ingress-provider.
+const DefaultProviderName = "nginx"
+
+// Provider converts a Tenant's Domains and TLS settings into the
+// routing objects idiomatic to one ingress controller.
+type Provider interface {
+	// Name identifies the provider, matching Tenant.Spec.Ingress.Provider.
+	Name() string
+	// Build renders the routing objects for tenant. Every returned object
+	// must already carry Namespace and a deterministic Name so repeated
+	// calls are idempotent; TenantReconciler sets the controller reference
+	// and creates/updates each one.
+	Build(tenant *tenantv1alpha1.Tenant) ([]client.Object, error)
+	// Owns registers watches for the object kinds this provider manages,
+	// so SetupWithManager doesn't need a case per provider.
+	Owns(bldr *builder.Builder) *builder.Builder
+}
+
+// New returns the Provider for name, falling back to DefaultProviderName
+// (nginx) when name is empty.
+func New(name string) (Provider, error) {
+	if name == "" {
+		name = DefaultProviderName
+	}
+	switch name {
+	case "nginx":
+		return &nginxProvider{}, nil
+	case "traefik-crd":
+		return &traefikProvider{}, nil
+	case "apisix":
+		return &apisixProvider{}, nil
+	case "kourier":
+		return &kourierProvider{}, nil
+	default:
+		return nil, fmt.Errorf("unsupported ingress provider %q", name)
+	}
+}
+
+// resolve picks tenant's own Spec.Ingress.Provider, falling back to
+// defaultName (the operator-wide flag) when the tenant didn't set one.
+func resolve(tenant *tenantv1alpha1.Tenant, defaultName string) string {
+	if tenant.Spec.Ingress != nil && tenant.Spec.Ingress.Provider != "" {
+		return tenant.Spec.Ingress.Provider
+	}
+	return defaultName
+}
+
+// ForTenant returns the Provider tenant should use, preferring its own
+// Spec.Ingress.Provider and falling back to defaultName.
+func ForTenant(tenant *tenantv1alpha1.Tenant, defaultName string) (Provider, error) {
+	return New(resolve(tenant, defaultName))
+}
+
+// All returns one instance of every supported Provider, in no particular
+// order. TenantReconciler.SetupWithManager uses this to register watches for
+// every provider's object kinds up front, since which provider an individual
+// tenant picks isn't known until reconcile time.
+func All() []Provider {
+	return []Provider{
+		&nginxProvider{},
+		&traefikProvider{},
+		&apisixProvider{},
+		&kourierProvider{},
+	}
+}
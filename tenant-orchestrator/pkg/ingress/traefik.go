@@ -0,0 +1,214 @@
+package ingress
+
+import (
+	"fmt"
+	"strings"
+
+	tenantv1alpha1 "github.com/rezenkai/multi-saas-crm/tenant-orchestrator/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// traefikProvider targets Traefik's traefik.io/v1alpha1 CRDs. That API isn't
+// vendored here (this operator otherwise has no dependency on a specific
+// ingress controller's Go types), so routes/middlewares/TLS options are built
+// as unstructured.Unstructured with their GVK set explicitly rather than
+// through generated structs.
+type traefikProvider struct{}
+
+var (
+	traefikGroupVersion = schema.GroupVersion{Group: "traefik.io", Version: "v1alpha1"}
+	ingressRouteGVK     = traefikGroupVersion.WithKind("IngressRoute")
+	middlewareGVK       = traefikGroupVersion.WithKind("Middleware")
+	tlsOptionGVK        = traefikGroupVersion.WithKind("TLSOption")
+)
+
+// Name returns "traefik-crd" rather than the bare "traefik" a Tenant's
+// Ingress.Provider might name the controller after, to make unambiguous in
+// the value itself that routing is rendered as Traefik's CRDs (IngressRoute
+// etc.) rather than upstream's IngressRoute-via-annotations or a plain
+// networking.k8s.io/v1 Ingress Traefik happens to also watch.
+func (p *traefikProvider) Name() string { return "traefik-crd" }
+
+func (p *traefikProvider) Build(tenant *tenantv1alpha1.Tenant) ([]client.Object, error) {
+	if len(tenant.Spec.Domains) == 0 {
+		return nil, nil
+	}
+	ns := fmt.Sprintf("tenant-%s", tenant.Name)
+
+	// redirectMiddleware forces HTTP to HTTPS, mirroring nginxProvider's
+	// ssl-redirect annotation but as its own routable object, the way
+	// Traefik composes cross-cutting behavior onto routes.
+	redirectMiddleware := newUnstructured(middlewareGVK, fmt.Sprintf("%s-redirect-https", tenant.Name), ns)
+	if err := unstructured.SetNestedMap(redirectMiddleware.Object, map[string]interface{}{
+		"redirectScheme": map[string]interface{}{
+			"scheme":    "https",
+			"permanent": true,
+		},
+	}, "spec"); err != nil {
+		return nil, fmt.Errorf("failed to build redirect Middleware: %w", err)
+	}
+
+	tlsOption := newUnstructured(tlsOptionGVK, fmt.Sprintf("%s-tls", tenant.Name), ns)
+	if err := unstructured.SetNestedField(tlsOption.Object, "strict", "spec", "sniStrict"); err != nil {
+		return nil, fmt.Errorf("failed to build TLSOption: %w", err)
+	}
+
+	rendered, refs, err := buildMiddlewareChain(tenant, ns)
+	if err != nil {
+		return nil, err
+	}
+	middlewareRefs := append([]interface{}{
+		map[string]interface{}{"name": redirectMiddleware.GetName()},
+	}, refs...)
+
+	var matchHosts []string
+	for _, domain := range tenant.Spec.Domains {
+		matchHosts = append(matchHosts, fmt.Sprintf("Host(`%s`)", domain))
+	}
+	route := newUnstructured(ingressRouteGVK, fmt.Sprintf("%s-route", tenant.Name), ns)
+	routes := []interface{}{
+		map[string]interface{}{
+			"match": joinOr(matchHosts),
+			"kind":  "Rule",
+			"services": []interface{}{
+				map[string]interface{}{
+					"name": fmt.Sprintf("%s-gateway-svc", tenant.Name),
+					"port": int64(80),
+				},
+			},
+			"middlewares": middlewareRefs,
+		},
+	}
+	if err := unstructured.SetNestedSlice(route.Object, routes, "spec", "routes"); err != nil {
+		return nil, fmt.Errorf("failed to build IngressRoute routes: %w", err)
+	}
+	if err := unstructured.SetNestedMap(route.Object, map[string]interface{}{
+		"secretName": fmt.Sprintf("%s-tls", tenant.Name),
+		"options":    map[string]interface{}{"name": tlsOption.GetName()},
+	}, "spec", "tls"); err != nil {
+		return nil, fmt.Errorf("failed to build IngressRoute tls: %w", err)
+	}
+
+	objs := []client.Object{redirectMiddleware, tlsOption}
+	objs = append(objs, rendered...)
+	objs = append(objs, route)
+	return objs, nil
+}
+
+// buildMiddlewareChain renders tenant.Spec.Middlewares into the Middleware
+// objects Build must create (one per entry with a Type, none for an
+// ExternalRef) plus the ordered "middlewares" list entries an IngressRoute
+// route references them by, in the same order the tenant declared them.
+func buildMiddlewareChain(tenant *tenantv1alpha1.Tenant, ns string) ([]client.Object, []interface{}, error) {
+	var objs []client.Object
+	var refs []interface{}
+	for _, m := range tenant.Spec.Middlewares {
+		if m.ExternalRef != nil {
+			refNs := m.ExternalRef.Namespace
+			if refNs == "" || refNs == ns {
+				refs = append(refs, map[string]interface{}{"name": m.ExternalRef.Name})
+			} else {
+				refs = append(refs, map[string]interface{}{"name": normalizeMiddlewareName(m.ExternalRef.Name, refNs)})
+			}
+			continue
+		}
+		mw := newUnstructured(middlewareGVK, fmt.Sprintf("%s-%s", tenant.Name, m.Name), ns)
+		spec, err := middlewareSpec(m)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to build %q Middleware: %w", m.Name, err)
+		}
+		if err := unstructured.SetNestedMap(mw.Object, spec, "spec"); err != nil {
+			return nil, nil, fmt.Errorf("failed to build %q Middleware: %w", m.Name, err)
+		}
+		objs = append(objs, mw)
+		refs = append(refs, map[string]interface{}{"name": mw.GetName()})
+	}
+	return objs, refs, nil
+}
+
+// middlewareSpec renders m's Traefik spec.<kind> block from whichever
+// typed field m.Type selects.
+func middlewareSpec(m tenantv1alpha1.TenantMiddlewareSpec) (map[string]interface{}, error) {
+	switch m.Type {
+	case "rateLimit":
+		if m.RateLimit == nil {
+			return nil, fmt.Errorf("type rateLimit requires rateLimit to be set")
+		}
+		rateLimit := map[string]interface{}{"average": m.RateLimit.Average}
+		if m.RateLimit.Burst > 0 {
+			rateLimit["burst"] = m.RateLimit.Burst
+		}
+		return map[string]interface{}{"rateLimit": rateLimit}, nil
+	case "ipAllowList":
+		if m.IPAllowList == nil {
+			return nil, fmt.Errorf("type ipAllowList requires ipAllowList to be set")
+		}
+		sourceRange := make([]interface{}, len(m.IPAllowList.SourceRange))
+		for i, cidr := range m.IPAllowList.SourceRange {
+			sourceRange[i] = cidr
+		}
+		return map[string]interface{}{"ipAllowList": map[string]interface{}{"sourceRange": sourceRange}}, nil
+	case "basicAuth":
+		if m.BasicAuth == nil {
+			return nil, fmt.Errorf("type basicAuth requires basicAuth to be set")
+		}
+		return map[string]interface{}{"basicAuth": map[string]interface{}{"secret": m.BasicAuth.SecretName}}, nil
+	case "headers":
+		if m.Headers == nil {
+			return nil, fmt.Errorf("type headers requires headers to be set")
+		}
+		headers := map[string]interface{}{}
+		if len(m.Headers.CustomResponseHeaders) > 0 {
+			customHeaders := make(map[string]interface{}, len(m.Headers.CustomResponseHeaders))
+			for k, v := range m.Headers.CustomResponseHeaders {
+				customHeaders[k] = v
+			}
+			headers["customResponseHeaders"] = customHeaders
+		}
+		if m.Headers.FrameDeny {
+			headers["frameDeny"] = true
+		}
+		return map[string]interface{}{"headers": headers}, nil
+	case "redirectRegex":
+		if m.RedirectRegex == nil {
+			return nil, fmt.Errorf("type redirectRegex requires redirectRegex to be set")
+		}
+		return map[string]interface{}{"redirectRegex": map[string]interface{}{
+			"regex":       m.RedirectRegex.Regex,
+			"replacement": m.RedirectRegex.Replacement,
+			"permanent":   m.RedirectRegex.Permanent,
+		}}, nil
+	default:
+		return nil, fmt.Errorf("unsupported middleware type %q", m.Type)
+	}
+}
+
+// normalizeMiddlewareName builds the "name-namespace@kubernetescrd"
+// cross-provider reference Traefik's Kubernetes CRD provider requires when a
+// route references a Middleware outside its own namespace. That format
+// itself uses "-" as the separator between name, namespace, and provider, so
+// a literal "-" inside name would be misparsed as the name/namespace
+// boundary; Traefik's docs specify doubling it there to escape it.
+func normalizeMiddlewareName(name, namespace string) string {
+	escaped := strings.ReplaceAll(name, "-", "--")
+	return fmt.Sprintf("%s-%s@kubernetescrd", escaped, namespace)
+}
+
+func (p *traefikProvider) Owns(bldr *builder.Builder) *builder.Builder {
+	return bldr.
+		Owns(newGVKOnly(ingressRouteGVK)).
+		Owns(newGVKOnly(middlewareGVK)).
+		Owns(newGVKOnly(tlsOptionGVK))
+}
+
+// joinOr renders Traefik's `||`-separated Host() matcher expression.
+func joinOr(exprs []string) string {
+	out := exprs[0]
+	for _, e := range exprs[1:] {
+		out += " || " + e
+	}
+	return out
+}
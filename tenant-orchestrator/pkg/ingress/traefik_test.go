@@ -0,0 +1,20 @@
+package ingress
+
+import "testing"
+
+func TestNormalizeMiddlewareName(t *testing.T) {
+	cases := []struct {
+		name      string
+		namespace string
+		want      string
+	}{
+		{name: "stripprefix", namespace: "tenant-acme", want: "stripprefix-tenant-acme@kubernetescrd"},
+		{name: "strip-prefix", namespace: "tenant-acme", want: "strip--prefix-tenant-acme@kubernetescrd"},
+		{name: "a-b-c", namespace: "ns", want: "a--b--c-ns@kubernetescrd"},
+	}
+	for _, tc := range cases {
+		if got := normalizeMiddlewareName(tc.name, tc.namespace); got != tc.want {
+			t.Errorf("normalizeMiddlewareName(%q, %q) = %q, want %q", tc.name, tc.namespace, got, tc.want)
+		}
+	}
+}
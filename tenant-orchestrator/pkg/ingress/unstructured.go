@@ -0,0 +1,25 @@
+package ingress
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// newUnstructured returns an empty object of gvk, named and namespaced, ready
+// to have its "spec" filled in with unstructured.SetNested*.
+func newUnstructured(gvk schema.GroupVersionKind, name, namespace string) *unstructured.Unstructured {
+	u := &unstructured.Unstructured{}
+	u.SetGroupVersionKind(gvk)
+	u.SetName(name)
+	u.SetNamespace(namespace)
+	return u
+}
+
+// newGVKOnly returns an Unstructured carrying only gvk, the minimum
+// builder.Owns needs to register a watch for a CRD kind this package doesn't
+// vendor generated types for.
+func newGVKOnly(gvk schema.GroupVersionKind) *unstructured.Unstructured {
+	u := &unstructured.Unstructured{}
+	u.SetGroupVersionKind(gvk)
+	return u
+}
@@ -0,0 +1,62 @@
+package secrets
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"math/big"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// alphanumeric is the default charset generated values are drawn from. It
+// avoids punctuation so values are always safe to embed in a shell env var or
+// connection string without quoting.
+const alphanumeric = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789"
+
+// localProvider is the default Provider: it generates a crypto/rand value
+// and returns a plain corev1.Secret for the caller to create/update, owned
+// by the Tenant like every other child object this operator creates.
+type localProvider struct{}
+
+func (p *localProvider) Name() string { return "local" }
+
+func (p *localProvider) Materialize(ctx context.Context, req Request, regenerate bool) (client.Object, string, error) {
+	if !regenerate {
+		return nil, "", nil
+	}
+	length := req.Length
+	if length == 0 {
+		length = defaultLength(req.Kind)
+	}
+	value, err := randomString(length)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to generate %s: %w", req.Kind, err)
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      req.Name,
+			Namespace: req.Namespace,
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{req.Key: []byte(value)},
+	}
+	return secret, HashValue([]byte(value)), nil
+}
+
+// randomString returns a crypto/rand-backed string of length drawn from
+// alphanumeric.
+func randomString(length int) (string, error) {
+	out := make([]byte, length)
+	max := big.NewInt(int64(len(alphanumeric)))
+	for i := range out {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		out[i] = alphanumeric[n.Int64()]
+	}
+	return string(out), nil
+}
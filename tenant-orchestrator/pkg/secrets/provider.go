@@ -0,0 +1,96 @@
+// Package secrets provides the pluggable mechanisms TenantReconciler uses to
+// materialize and rotate a tenant's generated credentials (DB password, JWT
+// signing key, admin bootstrap token, gateway API keys), so the operator
+// isn't hardcoded to writing a single literal constant into one Secret.
+package secrets
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Kind identifies which credential a Request materializes, so a Provider can
+// pick a sensible default length/charset when Request.Length is zero.
+type Kind string
+
+const (
+	KindDatabasePassword    Kind = "db-password"
+	KindJWTSigningKey       Kind = "jwt-signing-key"
+	KindAdminBootstrapToken Kind = "admin-bootstrap-token"
+	KindGatewayAPIKey       Kind = "gateway-api-key"
+)
+
+// DefaultProviderName is used when a Tenant leaves Spec.SecretRotation unset
+// or doesn't name a Provider.
+const DefaultProviderName = "local"
+
+// Request describes one credential a Provider should materialize.
+type Request struct {
+	Kind Kind
+	// Name is the Secret (or ExternalSecret) object name.
+	Name string
+	// Namespace is the tenant's namespace.
+	Namespace string
+	// Key is the data key the credential value is stored under, e.g.
+	// "password".
+	Key string
+	// Length is the generated value's length in characters. Zero uses a
+	// sane default for Kind.
+	Length int
+}
+
+// Provider materializes a Request's credential somewhere a workload can read
+// it as Kubernetes Secret data, directly or via an operator that syncs one
+// in from an external store.
+type Provider interface {
+	Name() string
+	// Materialize ensures req's credential exists, returning the object to
+	// create (a Secret, ExternalSecret, ...; nil if nothing needs creating)
+	// and a hash identifying the current value, so callers can detect drift
+	// the way deploymentEqual compares image/replica count. Regenerate
+	// forces a new value even if one already exists, for rotation.
+	Materialize(ctx context.Context, req Request, regenerate bool) (obj client.Object, hash string, err error)
+}
+
+// New returns the Provider implementation named name.
+func New(name string) (Provider, error) {
+	if name == "" {
+		name = DefaultProviderName
+	}
+	switch name {
+	case "local":
+		return &localProvider{}, nil
+	case "external-secrets":
+		return &esoProvider{}, nil
+	case "vault":
+		return newVaultProvider()
+	default:
+		return nil, fmt.Errorf("unsupported secret provider %q", name)
+	}
+}
+
+// defaultLength returns the generated value length for kind when
+// Request.Length is zero.
+func defaultLength(kind Kind) int {
+	switch kind {
+	case KindJWTSigningKey:
+		return 64
+	case KindAdminBootstrapToken, KindGatewayAPIKey:
+		return 40
+	default:
+		return 32
+	}
+}
+
+// HashValue returns the digest Materialize implementations report back as a
+// credential's hash, and that reconcilers fold into a
+// "secrets.multi-saas-crm.io/credentials-hash" annotation to roll workloads
+// on rotation.
+func HashValue(value []byte) string {
+	sum := sha256.Sum256(value)
+	return hex.EncodeToString(sum[:])
+}